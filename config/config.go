@@ -2,7 +2,9 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"log"
+	"regexp"
 	"time"
 
 	"github.com/spf13/viper"
@@ -10,33 +12,53 @@ import (
 
 // App config struct
 type Config struct {
-	Server   ServerConfig
-	Postgres PostgresConfig
-	Redis    RedisConfig
-	MongoDB  MongoDB
-	Cookie   Cookie
-	Store    Store
-	Session  Session
-	Metrics  Metrics
-	Logger   Logger
-	AWS      AWS
-	Jaeger   Jaeger
+	Server     ServerConfig
+	Postgres   PostgresConfig
+	Redis      RedisConfig
+	MongoDB    MongoDB
+	Cookie     Cookie
+	Store      Store
+	Session    Session
+	Metrics    Metrics
+	Logger     Logger
+	AWS        AWS
+	Jaeger     Jaeger
+	News       NewsConfig
+	Pagination PaginationConfig
+	ImageCheck ImageCheckConfig
+	Mailer     MailerConfig
+	Tenancy    TenancyConfig
 }
 
 // Server config struct
 type ServerConfig struct {
-	AppVersion        string
-	Port              string
-	PprofPort         string
-	Mode              string
-	JwtSecretKey      string
-	CookieName        string
-	ReadTimeout       time.Duration
-	WriteTimeout      time.Duration
-	SSL               bool
-	CtxDefaultTimeout time.Duration
-	CSRF              bool
-	Debug             bool
+	AppVersion             string
+	Port                   string
+	PprofPort              string
+	Mode                   string
+	JwtSecretKey           string
+	CookieName             string
+	ReadTimeout            time.Duration
+	WriteTimeout           time.Duration
+	SSL                    bool
+	CtxDefaultTimeout      time.Duration
+	CSRF                   bool
+	Debug                  bool
+	EnforceJSONContentType bool
+	MaxResultWindow        int
+	CacheStatusHeader      bool
+	PreviewSecretKey       string
+	PreviewTokenTTL        time.Duration
+	// Response JSON key naming, snake_case (default) or camelCase
+	JSONNaming string
+	// Wrap successful JSON responses into {"data": ...}
+	JSONEnvelope bool
+	// Reject unknown query params on list endpoints, per request with strict=true otherwise
+	StrictQueryParams bool
+	// Header carrying RFC3339 deadline set by upstream gateway, empty ignores upstream deadlines
+	RequestDeadlineHeader string
+	// Longest request budget accepted from upstream deadline, zero uses default bound
+	MaxRequestDeadline time.Duration
 }
 
 // Logger config
@@ -57,19 +79,70 @@ type PostgresConfig struct {
 	PostgresqlDbname   string
 	PostgresqlSSLMode  bool
 	PgDriver           string
+	SlowQueryThreshold time.Duration
+	// Debug only, explain list queries and warn when planner cost is above threshold
+	ExplainListQueries   bool
+	ExplainCostThreshold float64
+	// Debug only, log bound query parameters, honored only with Server.Debug
+	LogQueryParams bool
+	// Max concurrent news repository statements, zero is unlimited
+	MaxConcurrentQueries int
+	// Wait for free statement slot before failing with 503, zero uses default timeout
+	QueryAcquireTimeout time.Duration
+	// Max items of list argument in one query, e.g. ids of batch update, longer lists give 400 and should be sent in chunks.
+	// Zero uses default of 1000
+	MaxListParams int
 }
 
 // Redis config
 type RedisConfig struct {
-	RedisAddr      string
-	RedisPassword  string
-	RedisDB        string
-	RedisDefaultdb string
-	MinIdleConns   int
-	PoolSize       int
-	PoolTimeout    int
-	Password       string
-	DB             int
+	RedisAddr        string
+	RedisPassword    string
+	RedisDB          string
+	RedisDefaultdb   string
+	MinIdleConns     int
+	PoolSize         int
+	PoolTimeout      int
+	Password         string
+	DB               int
+	TTLJitterPercent int
+	// TTL of in memory cache layer, zero disables it
+	LocalCacheTTL time.Duration
+	// Max entries of in memory cache layer
+	LocalCacheSize int
+	// Redis channel used to evict keys from in memory caches of other instances
+	InvalidationChannel string
+	// Cache news and users in redis, can be toggled at runtime by admin
+	CacheEnabled bool
+	// How long news by id is served stale past its freshness while refreshed in background, zero disables it
+	StaleWhileRevalidate time.Duration
+	// Namespace put before every news, user and session key so environments can share one redis,
+	// colon separated segments ending with colon, e.g. "staging:" or "eu:prod:"
+	KeyPrefix string
+	// Encode cached values with json-iterator instead of encoding/json, cached JSON is the same either way
+	FastCacheCodec bool
+}
+
+// Colon separated segments of letters, digits, dot, dash or underscore, ending with colon
+var redisKeyPrefixPattern = regexp.MustCompile(`^[A-Za-z0-9._-]+(:[A-Za-z0-9._-]+)*:$`)
+
+// Check redis key prefix follows namespace convention of RedisConfig.KeyPrefix
+func ValidateRedisKeyPrefix(prefix string) error {
+	if prefix == "" {
+		return errors.New("redis key prefix is empty")
+	}
+	if !redisKeyPrefixPattern.MatchString(prefix) {
+		return fmt.Errorf("redis key prefix %q must be colon separated segments ending with colon, e.g. \"staging:\"", prefix)
+	}
+	return nil
+}
+
+// Redis key prefix of environment, empty for nil config
+func (c *Config) RedisKeyPrefix() string {
+	if c == nil {
+		return ""
+	}
+	return c.Redis.KeyPrefix
 }
 
 // MongoDB config
@@ -92,6 +165,91 @@ type Session struct {
 	Expire int
 }
 
+// News config
+type NewsConfig struct {
+	SimilarTitleThreshold float64
+	// Max distinct tags per news, zero uses default limit
+	MaxTags int
+	// Status of create response returning existing news with same content, 200 or 409
+	DuplicateContentStatus int
+	// Max news metadata JSON size in bytes, zero uses default limit
+	MaxMetadataSize int
+	// Reading speed used for reading time estimate, zero uses default speed
+	ReadingWordsPerMinute int
+	// How fast trending score of news decays with age, zero uses default gravity
+	TrendingGravity float64
+	// Search with empty title lists news like news list does instead of failing with 400
+	EmptySearchListsAll bool
+	// Max characters of news excerpt in list summaries, zero uses default length
+	ExcerptLength int
+	// Edit lock lifetime when request gives none, zero uses default ttl
+	EditLockTTL time.Duration
+	// Replace invalid UTF-8 in title and content instead of failing with 400
+	SanitizeInvalidUTF8 bool
+	// Cache news list pages keyed by full query, lists lag behind writes for up to ttl, zero disables it
+	ListCacheTTL time.Duration
+	// Pause between background reconciliations of stored comment counts, zero disables the job
+	CommentCountInterval time.Duration
+	// Locale of news title and content as created, translations of other locales fall back to it, empty is "en"
+	DefaultLocale string
+	// Json names of news fields update must leave as they are, empty uses default author_id, created_at and tenant_id
+	ImmutableFields []string
+	// Author name of news whose author no longer exists, empty leaves author null
+	MissingAuthorName string
+	// Author exports streamed at the same time, more are rejected with 429, zero uses default limit
+	MaxConcurrentExports int
+	// Pause between moves of views counted in Redis to news table, zero uses default interval
+	ViewsFlushInterval time.Duration
+}
+
+// Background check of news image urls
+type ImageCheckConfig struct {
+	Enabled bool
+	// Pause between full passes over news images
+	Interval time.Duration
+	// Timeout of single HEAD request
+	Timeout     time.Duration
+	Concurrency int
+	// Max HEAD requests started per second, zero is unlimited
+	RequestsPerSecond float64
+}
+
+// SMTP mailer of author notifications
+type MailerConfig struct {
+	Enabled  bool
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	// Public site url news links in emails point to
+	BaseURL string
+	// Max messages waiting to be sent, zero uses default size
+	QueueSize int
+	Workers   int
+	// Retries of transient SMTP errors, zero sends once
+	MaxRetries int
+	// Pause before first retry, doubled on every next one, zero uses default backoff
+	RetryBackoff time.Duration
+}
+
+// Multi tenant deployment, news of one tenant are hidden from users of other tenants
+type TenancyConfig struct {
+	// Every news request must come from authenticated user with tenant
+	Enabled bool
+}
+
+// Pagination defaults applied to list queries
+type PaginationConfig struct {
+	DefaultSize      int
+	MaxSize          int
+	DefaultOrderBy   string
+	DefaultDirection string
+	// Composed order of public feed used when request has no orderBy, takes precedence over DefaultOrderBy.
+	// Comma separated sort keys, "-" prefix for descending, e.g. "-featured,-created_at" for featured first then newest
+	DefaultSort string
+}
+
 // Metrics config
 type Metrics struct {
 	URL         string
@@ -110,6 +268,10 @@ type AWS struct {
 	MinioSecretKey string
 	UseSSL         bool
 	MinioEndpoint  string
+	// Max image upload size in bytes, zero uses default limit
+	MaxUploadSize int64
+	// Deadline of storing uploaded image, zero uses default timeout
+	UploadTimeout time.Duration
 }
 
 // AWS S3
@@ -146,5 +308,9 @@ func ParseConfig(v *viper.Viper) (*Config, error) {
 		return nil, err
 	}
 
+	if err = ValidateRedisKeyPrefix(c.Redis.KeyPrefix); err != nil {
+		return nil, err
+	}
+
 	return &c, nil
 }
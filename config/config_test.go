@@ -0,0 +1,48 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRedisKeyPrefix(t *testing.T) {
+	t.Parallel()
+
+	for _, prefix := range []string{"local:", "staging:", "eu:prod:", "team_1.dev-2:"} {
+		require.NoError(t, ValidateRedisKeyPrefix(prefix), prefix)
+	}
+	for _, prefix := range []string{"", "prod", ":", "prod::", "eu prod:", "prod:*:"} {
+		require.Error(t, ValidateRedisKeyPrefix(prefix), prefix)
+	}
+}
+
+func TestParseConfig_RedisKeyPrefix(t *testing.T) {
+	t.Parallel()
+
+	parse := func(yml string) (*Config, error) {
+		v := viper.New()
+		v.SetConfigType("yaml")
+		require.NoError(t, v.ReadConfig(strings.NewReader(yml)))
+		return ParseConfig(v)
+	}
+
+	t.Run("Valid prefix", func(t *testing.T) {
+		cfg, err := parse("redis:\n  KeyPrefix: \"staging:\"\n")
+		require.NoError(t, err)
+		require.Equal(t, "staging:", cfg.RedisKeyPrefix())
+	})
+
+	t.Run("Missing prefix rejected", func(t *testing.T) {
+		_, err := parse("redis:\n  RedisAddr: localhost:6379\n")
+		require.Error(t, err)
+	})
+
+	t.Run("Prefix without separator rejected", func(t *testing.T) {
+		_, err := parse("redis:\n  KeyPrefix: staging\n")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "staging")
+	})
+}
@@ -2,10 +2,12 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"net/http"
+	"strings"
+	"time"
 
-	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
@@ -20,6 +22,13 @@ import (
 	"github.com/AleksK1NG/api-mc/pkg/utils"
 )
 
+const (
+	defaultMaxUploadSize = 5 << 20
+	defaultUploadTimeout = 30 * time.Second
+	// Room for multipart boundaries and headers around the file itself
+	multipartOverhead = 1 << 20
+)
+
 // Auth handlers
 type authHandlers struct {
 	cfg    *config.Config
@@ -166,7 +175,7 @@ func (h *authHandlers) Update() echo.HandlerFunc {
 		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "authHandlers.Update")
 		defer span.Finish()
 
-		uID, err := uuid.Parse(c.Param("user_id"))
+		uID, err := utils.ParseUUIDParam(c, "user_id")
 		if err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
@@ -205,7 +214,7 @@ func (h *authHandlers) GetUserByID() echo.HandlerFunc {
 		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "authHandlers.GetUserByID")
 		defer span.Finish()
 
-		uID, err := uuid.Parse(c.Param("user_id"))
+		uID, err := utils.ParseUUIDParam(c, "user_id")
 		if err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
@@ -236,7 +245,7 @@ func (h *authHandlers) Delete() echo.HandlerFunc {
 		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "authHandlers.Delete")
 		defer span.Finish()
 
-		uID, err := uuid.Parse(c.Param("user_id"))
+		uID, err := utils.ParseUUIDParam(c, "user_id")
 		if err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
@@ -381,6 +390,9 @@ func (h *authHandlers) GetCSRFToken() echo.HandlerFunc {
 // @Param bucket query string true "aws s3 bucket" Format(bucket)
 // @Param id path int true "user_id"
 // @Success 200 {string} string	"ok"
+// @Failure 413 {object} httpErrors.RestError
+// @Failure 415 {object} httpErrors.RestError
+// @Failure 504 {object} httpErrors.RestError
 // @Failure 500 {object} httpErrors.RestError
 // @Router /auth/{id}/avatar [post]
 func (h *authHandlers) UploadAvatar() echo.HandlerFunc {
@@ -389,14 +401,25 @@ func (h *authHandlers) UploadAvatar() echo.HandlerFunc {
 		defer span.Finish()
 
 		bucket := c.QueryParam("bucket")
-		uID, err := uuid.Parse(c.Param("user_id"))
+		uID, err := utils.ParseUUIDParam(c, "user_id")
 		if err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
 		}
 
+		maxSize := h.maxUploadSize()
+		c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, maxSize+multipartOverhead)
+
 		image, err := utils.ReadImage(c, "file")
 		if err != nil {
+			if strings.Contains(err.Error(), "request body too large") {
+				err = errors.Wrap(httpErrors.FileTooLarge, err.Error())
+			}
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+		if image.Size > maxSize {
+			err = errors.Wrapf(httpErrors.FileTooLarge, "authHandlers.UploadAvatar: %d bytes, max %d", image.Size, maxSize)
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
 		}
@@ -422,7 +445,10 @@ func (h *authHandlers) UploadAvatar() echo.HandlerFunc {
 
 		reader := bytes.NewReader(binaryImage.Bytes())
 
-		updatedUser, err := h.authUC.UploadAvatar(ctx, uID, models.UploadInput{
+		uploadCtx, cancel := context.WithTimeout(ctx, h.uploadTimeout())
+		defer cancel()
+
+		updatedUser, err := h.authUC.UploadAvatar(uploadCtx, uID, models.UploadInput{
 			File:        reader,
 			Name:        image.Filename,
 			Size:        image.Size,
@@ -430,6 +456,10 @@ func (h *authHandlers) UploadAvatar() echo.HandlerFunc {
 			BucketName:  bucket,
 		})
 		if err != nil {
+			// Use case hides storage error behind 500, report expired upload deadline as such
+			if errors.Is(uploadCtx.Err(), context.DeadlineExceeded) {
+				err = errors.Wrap(uploadCtx.Err(), "authHandlers.UploadAvatar")
+			}
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
 		}
@@ -437,3 +467,17 @@ func (h *authHandlers) UploadAvatar() echo.HandlerFunc {
 		return c.JSON(http.StatusOK, updatedUser)
 	}
 }
+
+func (h *authHandlers) maxUploadSize() int64 {
+	if h.cfg != nil && h.cfg.AWS.MaxUploadSize > 0 {
+		return h.cfg.AWS.MaxUploadSize
+	}
+	return defaultMaxUploadSize
+}
+
+func (h *authHandlers) uploadTimeout() time.Duration {
+	if h.cfg != nil && h.cfg.AWS.UploadTimeout > 0 {
+		return h.cfg.AWS.UploadTimeout
+	}
+	return defaultUploadTimeout
+}
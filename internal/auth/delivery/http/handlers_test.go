@@ -1,10 +1,16 @@
 package http
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"net/textproto"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -17,6 +23,7 @@ import (
 	"github.com/AleksK1NG/api-mc/internal/models"
 	mockSess "github.com/AleksK1NG/api-mc/internal/session/mock"
 	"github.com/AleksK1NG/api-mc/pkg/converter"
+	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
 	"github.com/AleksK1NG/api-mc/pkg/logger"
 	"github.com/AleksK1NG/api-mc/pkg/utils"
 )
@@ -208,3 +215,82 @@ func TestAuthHandlers_Logout(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, err)
 }
+
+func newAvatarRequest(t *testing.T, contentType string, content []byte) (*http.Request, *httptest.ResponseRecorder) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="file"; filename="avatar.png"`)
+	header.Set("Content-Type", contentType)
+	part, err := writer.CreatePart(header)
+	require.NoError(t, err)
+	_, err = part.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/avatar?bucket=avatars", body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	return req, httptest.NewRecorder()
+}
+
+func TestAuthHandlers_UploadAvatar(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		AWS:    config.AWS{MaxUploadSize: 1024, UploadTimeout: 20 * time.Millisecond},
+		Logger: config.Logger{Development: true},
+	}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockAuthUC := mock.NewMockUseCase(ctrl)
+	authHandlers := NewAuthHandlers(cfg, mockAuthUC, mockSess.NewMockUCSession(ctrl), apiLogger)
+
+	userID := uuid.New()
+	png := append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 100)...)
+
+	serve := func(req *http.Request, res *httptest.ResponseRecorder) {
+		e := echo.New()
+		c := e.NewContext(req, res)
+		c.SetParamNames("user_id")
+		c.SetParamValues(userID.String())
+		require.NoError(t, authHandlers.UploadAvatar()(c))
+	}
+
+	t.Run("Oversized file rejected", func(t *testing.T) {
+		oversized := append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 2048)...)
+		req, res := newAvatarRequest(t, "image/png", oversized)
+
+		serve(req, res)
+		require.Equal(t, http.StatusRequestEntityTooLarge, res.Code)
+	})
+
+	t.Run("Disallowed detected type rejected", func(t *testing.T) {
+		req, res := newAvatarRequest(t, "image/png", []byte("<html><body>not an image</body></html>"))
+
+		serve(req, res)
+		require.Equal(t, http.StatusUnsupportedMediaType, res.Code)
+	})
+
+	t.Run("Upload timeout", func(t *testing.T) {
+		req, res := newAvatarRequest(t, "image/png", png)
+		mockAuthUC.EXPECT().UploadAvatar(gomock.Any(), userID, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ uuid.UUID, _ models.UploadInput) (*models.User, error) {
+				<-ctx.Done()
+				return nil, httpErrors.NewInternalServerError(fmt.Errorf("put object: %w", ctx.Err()))
+			})
+
+		serve(req, res)
+		require.Equal(t, http.StatusGatewayTimeout, res.Code)
+	})
+
+	t.Run("Upload", func(t *testing.T) {
+		req, res := newAvatarRequest(t, "image/png", png)
+		mockAuthUC.EXPECT().UploadAvatar(gomock.Any(), userID, gomock.Any()).Return(&models.User{UserID: userID}, nil)
+
+		serve(req, res)
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+}
@@ -9,11 +9,12 @@ import (
 
 // Map auth routes
 func MapAuthRoutes(authGroup *echo.Group, h auth.Handlers, mw *middleware.MiddlewareManager) {
+	authGroup.Use(mw.JSONContentTypeMiddleware("/api/v1/auth/:user_id/avatar"))
 	authGroup.POST("/register", h.Register())
 	authGroup.POST("/login", h.Login())
 	authGroup.POST("/logout", h.Logout())
-	authGroup.GET("/find", h.FindByName())
-	authGroup.GET("/all", h.GetUsers())
+	authGroup.GET("/find", h.FindByName(), mw.StrictQueryMiddleware("name"))
+	authGroup.GET("/all", h.GetUsers(), mw.StrictQueryMiddleware())
 	authGroup.GET("/:user_id", h.GetUserByID())
 	// authGroup.Use(middleware.AuthJWTMiddleware(authUC, cfg))
 	authGroup.Use(mw.AuthSessionMiddleware)
@@ -9,19 +9,23 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
 
+	"github.com/AleksK1NG/api-mc/config"
 	"github.com/AleksK1NG/api-mc/internal/auth"
 	"github.com/AleksK1NG/api-mc/internal/models"
+	"github.com/AleksK1NG/api-mc/pkg/db/postgres"
 	"github.com/AleksK1NG/api-mc/pkg/utils"
 )
 
 // Auth Repository
 type authRepo struct {
 	db *sqlx.DB
+	// Max ids of one GetByIDs call, see postgres.CheckListSize
+	maxListParams int
 }
 
 // Auth Repository constructor
-func NewAuthRepository(db *sqlx.DB) auth.Repository {
-	return &authRepo{db: db}
+func NewAuthRepository(db *sqlx.DB, cfg *config.Config) auth.Repository {
+	return &authRepo{db: db, maxListParams: postgres.MaxListParams(cfg.Postgres.MaxListParams)}
 }
 
 // Create new user
@@ -88,6 +92,22 @@ func (r *authRepo) GetByID(ctx context.Context, userID uuid.UUID) (*models.User,
 	return user, nil
 }
 
+// Get users by ids in one query, unknown ids are skipped
+func (r *authRepo) GetByIDs(ctx context.Context, userIDs []uuid.UUID) ([]*models.User, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRepo.GetByIDs")
+	defer span.Finish()
+
+	if err := postgres.CheckListSize("authRepo.GetByIDs", len(userIDs), r.maxListParams); err != nil {
+		return nil, err
+	}
+
+	users := make([]*models.User, 0, len(userIDs))
+	if err := r.db.SelectContext(ctx, &users, getUsersByIDs, utils.UUIDArray(userIDs)); err != nil {
+		return nil, errors.Wrap(err, "authRepo.GetByIDs.SelectContext")
+	}
+	return users, nil
+}
+
 // Find users by name
 func (r *authRepo) FindByName(ctx context.Context, name string, query *utils.PaginationQuery) (*models.UsersList, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "authRepo.FindByName")
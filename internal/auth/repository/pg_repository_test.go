@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -10,7 +11,9 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 
+	"github.com/AleksK1NG/api-mc/config"
 	"github.com/AleksK1NG/api-mc/internal/models"
+	"github.com/AleksK1NG/api-mc/pkg/db/postgres"
 	"github.com/AleksK1NG/api-mc/pkg/utils"
 )
 
@@ -24,7 +27,7 @@ func TestAuthRepo_Register(t *testing.T) {
 	sqlxDB := sqlx.NewDb(db, "sqlmock")
 	defer sqlxDB.Close()
 
-	authRepo := NewAuthRepository(sqlxDB)
+	authRepo := NewAuthRepository(sqlxDB, &config.Config{})
 
 	t.Run("Register", func(t *testing.T) {
 		gender := "male"
@@ -64,7 +67,7 @@ func TestAuthRepo_GetByID(t *testing.T) {
 	sqlxDB := sqlx.NewDb(db, "sqlmock")
 	defer sqlxDB.Close()
 
-	authRepo := NewAuthRepository(sqlxDB)
+	authRepo := NewAuthRepository(sqlxDB, &config.Config{})
 
 	t.Run("GetByID", func(t *testing.T) {
 		uid := uuid.New()
@@ -91,6 +94,44 @@ func TestAuthRepo_GetByID(t *testing.T) {
 	})
 }
 
+func TestAuthRepo_GetByIDs(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	authRepo := NewAuthRepository(sqlxDB, &config.Config{})
+
+	firstUID := uuid.New()
+	secondUID := uuid.New()
+	unknownUID := uuid.New()
+	ids := []uuid.UUID{firstUID, secondUID, unknownUID}
+
+	rows := sqlmock.NewRows([]string{"user_id", "first_name", "last_name"}).
+		AddRow(firstUID, "Alex", "Bryksin").
+		AddRow(secondUID, "Jane", "Doe")
+	mock.ExpectQuery(getUsersByIDs).WithArgs(utils.UUIDArray(ids)).WillReturnRows(rows)
+
+	users, err := authRepo.GetByIDs(context.Background(), ids)
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	require.Equal(t, firstUID, users[0].UserID)
+	require.Equal(t, "Doe", users[1].LastName)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	t.Run("Beyond limit", func(t *testing.T) {
+		limitedRepo := NewAuthRepository(sqlxDB, &config.Config{Postgres: config.PostgresConfig{MaxListParams: 2}})
+
+		_, err := limitedRepo.GetByIDs(context.Background(), ids)
+		require.True(t, errors.Is(err, postgres.ErrBatchTooLarge))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestAuthRepo_Delete(t *testing.T) {
 	t.Parallel()
 
@@ -101,7 +142,7 @@ func TestAuthRepo_Delete(t *testing.T) {
 	sqlxDB := sqlx.NewDb(db, "sqlmock")
 	defer sqlxDB.Close()
 
-	authRepo := NewAuthRepository(sqlxDB)
+	authRepo := NewAuthRepository(sqlxDB, &config.Config{})
 
 	t.Run("Delete", func(t *testing.T) {
 
@@ -135,7 +176,7 @@ func TestAuthRepo_Update(t *testing.T) {
 	sqlxDB := sqlx.NewDb(db, "sqlmock")
 	defer sqlxDB.Close()
 
-	authRepo := NewAuthRepository(sqlxDB)
+	authRepo := NewAuthRepository(sqlxDB, &config.Config{})
 
 	t.Run("Update", func(t *testing.T) {
 		gender := "male"
@@ -175,7 +216,7 @@ func TestAuthRepo_FindByEmail(t *testing.T) {
 	sqlxDB := sqlx.NewDb(db, "sqlmock")
 	defer sqlxDB.Close()
 
-	authRepo := NewAuthRepository(sqlxDB)
+	authRepo := NewAuthRepository(sqlxDB, &config.Config{})
 
 	t.Run("FindByEmail", func(t *testing.T) {
 		uid := uuid.New()
@@ -210,7 +251,7 @@ func TestAuthRepo_GetUsers(t *testing.T) {
 	sqlxDB := sqlx.NewDb(db, "sqlmock")
 	defer sqlxDB.Close()
 
-	authRepo := NewAuthRepository(sqlxDB)
+	authRepo := NewAuthRepository(sqlxDB, &config.Config{})
 
 	t.Run("FindByEmail", func(t *testing.T) {
 		uid := uuid.New()
@@ -244,7 +285,7 @@ func TestAuthRepo_FindByName(t *testing.T) {
 	sqlxDB := sqlx.NewDb(db, "sqlmock")
 	defer sqlxDB.Close()
 
-	authRepo := NewAuthRepository(sqlxDB)
+	authRepo := NewAuthRepository(sqlxDB, &config.Config{})
 
 	t.Run("FindByName", func(t *testing.T) {
 		uid := uuid.New()
@@ -27,10 +27,15 @@ const (
 	deleteUserQuery = `DELETE FROM users WHERE user_id = $1`
 
 	getUserQuery = `SELECT user_id, first_name, last_name, email, role, about, avatar, phone_number, 
-       				 address, city, gender, postcode, birthday, created_at, updated_at, login_date  
+       				 address, city, gender, postcode, birthday, created_at, updated_at, login_date, tenant_id  
 					 FROM users 
 					 WHERE user_id = $1`
 
+	getUsersByIDs = `SELECT user_id, first_name, last_name, email, role, about, avatar, phone_number,
+       				 address, city, gender, postcode, birthday, created_at, updated_at, login_date
+					 FROM users
+					 WHERE user_id = ANY($1::uuid[])`
+
 	getTotalCount = `SELECT COUNT(user_id) FROM users 
 						WHERE first_name ILIKE '%' || $1 || '%' or last_name ILIKE '%' || $1 || '%'`
 
@@ -38,7 +43,7 @@ const (
 	              city, gender, postcode, birthday, created_at, updated_at, login_date 
 				  FROM users 
 				  WHERE first_name ILIKE '%' || $1 || '%' or last_name ILIKE '%' || $1 || '%'
-				  ORDER BY first_name, last_name
+				  ORDER BY first_name, last_name, user_id
 				  OFFSET $2 LIMIT $3
 				  `
 
@@ -47,7 +52,7 @@ const (
 	getUsers = `SELECT user_id, first_name, last_name, email, role, about, avatar, phone_number, 
        			 address, city, gender, postcode, birthday, created_at, updated_at, login_date
 				 FROM users 
-				 ORDER BY COALESCE(NULLIF($1, ''), first_name) OFFSET $2 LIMIT $3`
+				 ORDER BY COALESCE(NULLIF($1, ''), first_name), user_id OFFSET $2 LIMIT $3`
 
 	findUserByEmail = `SELECT user_id, first_name, last_name, email, role, about, avatar, phone_number, 
        			 		address, city, gender, postcode, birthday, created_at, updated_at, login_date, password
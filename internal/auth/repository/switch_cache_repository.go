@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/AleksK1NG/api-mc/internal/auth"
+	"github.com/AleksK1NG/api-mc/internal/models"
+	"github.com/AleksK1NG/api-mc/pkg/utils"
+)
+
+// Users cache which can be turned off at runtime, when off every lookup is a miss and writes are dropped.
+// Deletes always pass through, so users changed while off are not served stale once cache is back on
+type authSwitchCacheRepo struct {
+	redisRepo   auth.RedisRepository
+	cacheSwitch *utils.CacheSwitch
+}
+
+// Auth switchable cache repository constructor, wraps redis repository
+func NewAuthSwitchCacheRepo(redisRepo auth.RedisRepository, cacheSwitch *utils.CacheSwitch) auth.RedisRepository {
+	return &authSwitchCacheRepo{redisRepo: redisRepo, cacheSwitch: cacheSwitch}
+}
+
+func (a *authSwitchCacheRepo) GetByIDCtx(ctx context.Context, key string) (*models.User, error) {
+	if !a.cacheSwitch.Enabled() {
+		return nil, nil
+	}
+	return a.redisRepo.GetByIDCtx(ctx, key)
+}
+
+func (a *authSwitchCacheRepo) SetUserCtx(ctx context.Context, key string, seconds int, user *models.User) error {
+	if !a.cacheSwitch.Enabled() {
+		return nil
+	}
+	return a.redisRepo.SetUserCtx(ctx, key, seconds, user)
+}
+
+func (a *authSwitchCacheRepo) DeleteUserCtx(ctx context.Context, key string) error {
+	return a.redisRepo.DeleteUserCtx(ctx, key)
+}
@@ -29,11 +29,13 @@ type authUC struct {
 	redisRepo auth.RedisRepository
 	awsRepo   auth.AWSRepository
 	logger    logger.Logger
+	// Environment key prefix followed by auth base prefix
+	keyPrefix string
 }
 
 // Auth UseCase constructor
 func NewAuthUseCase(cfg *config.Config, authRepo auth.Repository, redisRepo auth.RedisRepository, awsRepo auth.AWSRepository, log logger.Logger) auth.UseCase {
-	return &authUC{cfg: cfg, authRepo: authRepo, redisRepo: redisRepo, awsRepo: awsRepo, logger: log}
+	return &authUC{cfg: cfg, authRepo: authRepo, redisRepo: redisRepo, awsRepo: awsRepo, logger: log, keyPrefix: cfg.RedisKeyPrefix() + basePrefix}
 }
 
 // Create new user
@@ -140,6 +142,8 @@ func (u *authUC) FindByName(ctx context.Context, name string, query *utils.Pagin
 	span, ctx := opentracing.StartSpanFromContext(ctx, "authUC.FindByName")
 	defer span.Finish()
 
+	query.Resolve(u.cfg.Pagination)
+
 	return u.authRepo.FindByName(ctx, name, query)
 }
 
@@ -148,6 +152,8 @@ func (u *authUC) GetUsers(ctx context.Context, pq *utils.PaginationQuery) (*mode
 	span, ctx := opentracing.StartSpanFromContext(ctx, "authUC.GetUsers")
 	defer span.Finish()
 
+	pq.Resolve(u.cfg.Pagination)
+
 	return u.authRepo.GetUsers(ctx, pq)
 }
 
@@ -204,7 +210,7 @@ func (u *authUC) UploadAvatar(ctx context.Context, userID uuid.UUID, file models
 }
 
 func (u *authUC) GenerateUserKey(userID string) string {
-	return fmt.Sprintf("%s: %s", basePrefix, userID)
+	return fmt.Sprintf("%s: %s", u.keyPrefix, userID)
 }
 
 func (u *authUC) generateAWSMinioURL(bucket string, key string) string {
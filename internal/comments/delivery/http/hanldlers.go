@@ -3,7 +3,6 @@ package http
 import (
 	"net/http"
 
-	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/opentracing/opentracing-go"
 
@@ -84,7 +83,7 @@ func (h *commentsHandlers) Update() echo.HandlerFunc {
 		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "commentsHandlers.Update")
 		defer span.Finish()
 
-		commID, err := uuid.Parse(c.Param("comment_id"))
+		commID, err := utils.ParseUUIDParam(c, "comment_id")
 		if err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
@@ -125,7 +124,7 @@ func (h *commentsHandlers) Delete() echo.HandlerFunc {
 		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "commentsHandlers.Delete")
 		defer span.Finish()
 
-		commID, err := uuid.Parse(c.Param("comment_id"))
+		commID, err := utils.ParseUUIDParam(c, "comment_id")
 		if err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
@@ -155,7 +154,7 @@ func (h *commentsHandlers) GetByID() echo.HandlerFunc {
 		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "commentsHandlers.GetByID")
 		defer span.Finish()
 
-		commID, err := uuid.Parse(c.Param("comment_id"))
+		commID, err := utils.ParseUUIDParam(c, "comment_id")
 		if err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
@@ -189,7 +188,7 @@ func (h *commentsHandlers) GetAllByNewsID() echo.HandlerFunc {
 		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "commentsHandlers.GetAllByNewsID")
 		defer span.Finish()
 
-		newsID, err := uuid.Parse(c.Param("news_id"))
+		newsID, err := utils.ParseUUIDParam(c, "news_id")
 		if err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
@@ -13,5 +13,5 @@ func MapCommentsRoutes(commGroup *echo.Group, h comments.Handlers, mw *middlewar
 	commGroup.DELETE("/:comment_id", h.Delete(), mw.AuthSessionMiddleware, mw.CSRF)
 	commGroup.PUT("/:comment_id", h.Update(), mw.AuthSessionMiddleware, mw.CSRF)
 	commGroup.GET("/:comment_id", h.GetByID())
-	commGroup.GET("/byNewsId/:news_id", h.GetAllByNewsID())
+	commGroup.GET("/byNewsId/:news_id", h.GetAllByNewsID(), mw.StrictQueryMiddleware())
 }
@@ -18,5 +18,5 @@ const (
 							FROM comments c
         					LEFT JOIN users u on c.author_id = u.user_id
         					WHERE c.news_id = $1 
-							ORDER BY updated_at OFFSET $2 LIMIT $3`
+							ORDER BY updated_at, comment_id OFFSET $2 LIMIT $3`
 )
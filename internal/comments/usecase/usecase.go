@@ -91,5 +91,7 @@ func (u *commentsUC) GetAllByNewsID(ctx context.Context, newsID uuid.UUID, query
 	span, ctx := opentracing.StartSpanFromContext(ctx, "commentsUC.GetAllByNewsID")
 	defer span.Finish()
 
+	query.Resolve(u.cfg.Pagination)
+
 	return u.commRepo.GetAllByNewsID(ctx, newsID, query)
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"github.com/stretchr/testify/require"
 
+	"github.com/AleksK1NG/api-mc/config"
 	"github.com/AleksK1NG/api-mc/internal/comments/mock"
 	"github.com/AleksK1NG/api-mc/internal/models"
 	"github.com/AleksK1NG/api-mc/pkg/logger"
@@ -147,7 +148,7 @@ func TestCommentsUC_GetAllByNewsID(t *testing.T) {
 
 	apiLogger := logger.NewApiLogger(nil)
 	mockCommRepo := mock.NewMockRepository(ctrl)
-	commUC := NewCommentsUseCase(nil, mockCommRepo, apiLogger)
+	commUC := NewCommentsUseCase(&config.Config{}, mockCommRepo, apiLogger)
 
 	newsUID := uuid.New()
 
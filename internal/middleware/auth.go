@@ -74,6 +74,40 @@ func (mw *MiddlewareManager) AuthSessionMiddleware(next echo.HandlerFunc) echo.H
 	}
 }
 
+// Auth sessions middleware letting anonymous request through, user is set only when session is valid
+func (mw *MiddlewareManager) OptionalAuthSessionMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if _, err := utils.GetUserFromCtx(c.Request().Context()); err == nil {
+			return next(c)
+		}
+
+		cookie, err := c.Cookie(mw.cfg.Session.Name)
+		if err != nil {
+			return next(c)
+		}
+
+		sess, err := mw.sessUC.GetSessionByID(c.Request().Context(), cookie.Value)
+		if err != nil {
+			mw.logger.Warnf("OptionalAuthSessionMiddleware.GetSessionByID RequestID: %s, Error: %s", utils.GetRequestID(c), err.Error())
+			return next(c)
+		}
+
+		user, err := mw.authUC.GetByID(c.Request().Context(), sess.UserID)
+		if err != nil {
+			mw.logger.Warnf("OptionalAuthSessionMiddleware.GetByID RequestID: %s, Error: %s", utils.GetRequestID(c), err.Error())
+			return next(c)
+		}
+
+		c.Set("sid", cookie.Value)
+		c.Set("uid", sess.SessionID)
+		c.Set("user", user)
+
+		ctx := context.WithValue(c.Request().Context(), utils.UserCtxKey{}, user)
+		c.SetRequest(c.Request().WithContext(ctx))
+		return next(c)
+	}
+}
+
 // JWT way of auth using cookie or Authorization header
 func (mw *MiddlewareManager) AuthJWTMiddleware(authUC auth.UseCase, cfg *config.Config) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
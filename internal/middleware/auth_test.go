@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/config"
+	mockAuth "github.com/AleksK1NG/api-mc/internal/auth/mock"
+	"github.com/AleksK1NG/api-mc/internal/models"
+	mockSess "github.com/AleksK1NG/api-mc/internal/session/mock"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+	"github.com/AleksK1NG/api-mc/pkg/utils"
+)
+
+func TestMiddlewareManager_OptionalAuthSessionMiddleware(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		Logger:  config.Logger{Development: true},
+		Session: config.Session{Name: "session-id"},
+	}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockSessUC := mockSess.NewMockUCSession(ctrl)
+	mockAuthUC := mockAuth.NewMockUseCase(ctrl)
+	mw := NewMiddlewareManager(mockSessUC, mockAuthUC, cfg, nil, apiLogger)
+
+	handler := func(c echo.Context) error {
+		user, err := utils.GetUserFromCtx(c.Request().Context())
+		if err != nil {
+			return c.String(http.StatusOK, "anonymous")
+		}
+		return c.String(http.StatusOK, user.UserID.String())
+	}
+
+	request := func(sessionID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/news", nil)
+		if sessionID != "" {
+			req.AddCookie(&http.Cookie{Name: cfg.Session.Name, Value: sessionID})
+		}
+		res := httptest.NewRecorder()
+		c := echo.New().NewContext(req, res)
+		require.NoError(t, mw.OptionalAuthSessionMiddleware(handler)(c))
+		return res
+	}
+
+	t.Run("Without session", func(t *testing.T) {
+		res := request("")
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Equal(t, "anonymous", res.Body.String())
+	})
+
+	t.Run("Invalid session", func(t *testing.T) {
+		mockSessUC.EXPECT().GetSessionByID(gomock.Any(), "expired").Return(nil, errors.New("session not found"))
+
+		res := request("expired")
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Equal(t, "anonymous", res.Body.String())
+	})
+
+	t.Run("Valid session", func(t *testing.T) {
+		user := &models.User{UserID: uuid.New()}
+		mockSessUC.EXPECT().GetSessionByID(gomock.Any(), "valid").Return(&models.Session{SessionID: "valid", UserID: user.UserID}, nil)
+		mockAuthUC.EXPECT().GetByID(gomock.Any(), user.UserID).Return(user, nil)
+
+		res := request("valid")
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Equal(t, user.UserID.String(), res.Body.String())
+	})
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"mime"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
+	"github.com/AleksK1NG/api-mc/pkg/utils"
+)
+
+// Require application/json body on POST, PUT and PATCH requests, routes from allowlist (by route path) are skipped
+func (mw *MiddlewareManager) JSONContentTypeMiddleware(allowlist ...string) echo.MiddlewareFunc {
+	skip := make(map[string]struct{}, len(allowlist))
+	for _, path := range allowlist {
+		skip[path] = struct{}{}
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !mw.cfg.Server.EnforceJSONContentType {
+				return next(c)
+			}
+
+			req := c.Request()
+			switch req.Method {
+			case http.MethodPost, http.MethodPut, http.MethodPatch:
+			default:
+				return next(c)
+			}
+			if req.ContentLength == 0 {
+				return next(c)
+			}
+			if _, ok := skip[c.Path()]; ok {
+				return next(c)
+			}
+
+			contentType := req.Header.Get(echo.HeaderContentType)
+			mediaType, _, err := mime.ParseMediaType(contentType)
+			if err != nil || mediaType != echo.MIMEApplicationJSON {
+				mw.logger.Errorf("JSONContentTypeMiddleware RequestID: %s, Content-Type: %s",
+					utils.GetRequestID(c),
+					contentType,
+				)
+				return c.JSON(http.StatusUnsupportedMediaType, httpErrors.NewRestError(
+					http.StatusUnsupportedMediaType,
+					httpErrors.ErrUnsupportedMediaType,
+					httpErrors.UnsupportedMediaType,
+				))
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/config"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+)
+
+func TestMiddlewareManager_JSONContentTypeMiddleware(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{
+		Server: config.ServerConfig{EnforceJSONContentType: true},
+		Logger: config.Logger{Development: true},
+	}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mw := NewMiddlewareManager(nil, nil, cfg, nil, apiLogger)
+
+	e := echo.New()
+	e.Use(mw.JSONContentTypeMiddleware("/upload"))
+	ok := func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	}
+	e.POST("/news", ok)
+	e.POST("/upload", ok)
+
+	t.Run("Wrong content type rejected", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/news", strings.NewReader("title=text"))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+		res := httptest.NewRecorder()
+
+		e.ServeHTTP(res, req)
+		require.Equal(t, http.StatusUnsupportedMediaType, res.Code)
+	})
+
+	t.Run("JSON body accepted", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/news", strings.NewReader(`{"title":"text"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+		res := httptest.NewRecorder()
+
+		e.ServeHTTP(res, req)
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Allowlisted route accepted", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader("--boundary--"))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEMultipartForm+"; boundary=boundary")
+		res := httptest.NewRecorder()
+
+		e.ServeHTTP(res, req)
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+}
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
+	"github.com/AleksK1NG/api-mc/pkg/utils"
+)
+
+const defaultMaxRequestDeadline = 30 * time.Second
+
+// Bound request context by RFC3339 deadline given by upstream in Server.RequestDeadlineHeader, so queries stop once
+// caller gave up. Deadline further than Server.MaxRequestDeadline is cut to it, passed deadline is answered with 504
+// right away. Passes through when header is not configured or not sent
+func (mw *MiddlewareManager) RequestDeadlineMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	maxDeadline := mw.cfg.Server.MaxRequestDeadline
+	if maxDeadline <= 0 {
+		maxDeadline = defaultMaxRequestDeadline
+	}
+
+	return func(c echo.Context) error {
+		header := mw.cfg.Server.RequestDeadlineHeader
+		if header == "" {
+			return next(c)
+		}
+		value := c.Request().Header.Get(header)
+		if value == "" {
+			return next(c)
+		}
+
+		deadline, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			mw.logger.Errorf("RequestDeadlineMiddleware RequestID: %s, Deadline: %s, Error: %v", utils.GetRequestID(c), value, err)
+			return c.JSON(http.StatusBadRequest, httpErrors.NewRestError(http.StatusBadRequest, httpErrors.InvalidDeadline.Error(), value))
+		}
+
+		now := time.Now()
+		if !deadline.After(now) {
+			mw.logger.Errorf("RequestDeadlineMiddleware RequestID: %s, Deadline: %s, Error: deadline already passed", utils.GetRequestID(c), value)
+			return c.JSON(http.StatusGatewayTimeout, httpErrors.NewRestError(http.StatusGatewayTimeout, httpErrors.GatewayTimeoutError.Error(), value))
+		}
+		if limit := now.Add(maxDeadline); deadline.After(limit) {
+			deadline = limit
+		}
+
+		ctx, cancel := context.WithDeadline(c.Request().Context(), deadline)
+		defer cancel()
+		c.SetRequest(c.Request().WithContext(ctx))
+		return next(c)
+	}
+}
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/config"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+)
+
+func TestMiddlewareManager_RequestDeadlineMiddleware(t *testing.T) {
+	t.Parallel()
+
+	const header = "X-Request-Deadline"
+	cfg := &config.Config{
+		Server: config.ServerConfig{RequestDeadlineHeader: header, MaxRequestDeadline: time.Minute},
+		Logger: config.Logger{Development: true},
+	}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mw := NewMiddlewareManager(nil, nil, cfg, nil, apiLogger)
+
+	var (
+		gotDeadline time.Time
+		hasDeadline bool
+	)
+	e := echo.New()
+	e.Use(mw.RequestDeadlineMiddleware)
+	e.GET("/news", func(c echo.Context) error {
+		gotDeadline, hasDeadline = c.Request().Context().Deadline()
+		return c.NoContent(http.StatusOK)
+	})
+
+	request := func(value string) *httptest.ResponseRecorder {
+		hasDeadline = false
+		req := httptest.NewRequest(http.MethodGet, "/news", nil)
+		if value != "" {
+			req.Header.Set(header, value)
+		}
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+		return res
+	}
+
+	t.Run("Future deadline", func(t *testing.T) {
+		deadline := time.Now().Add(5 * time.Second).UTC().Truncate(time.Millisecond)
+		res := request(deadline.Format(time.RFC3339Nano))
+		require.Equal(t, http.StatusOK, res.Code)
+		require.True(t, hasDeadline)
+		require.True(t, deadline.Equal(gotDeadline))
+	})
+
+	t.Run("Far deadline is bounded", func(t *testing.T) {
+		res := request(time.Now().Add(time.Hour).Format(time.RFC3339))
+		require.Equal(t, http.StatusOK, res.Code)
+		require.True(t, hasDeadline)
+		require.True(t, gotDeadline.Before(time.Now().Add(time.Minute+time.Second)))
+	})
+
+	t.Run("Passed deadline", func(t *testing.T) {
+		res := request(time.Now().Add(-time.Second).Format(time.RFC3339Nano))
+		require.Equal(t, http.StatusGatewayTimeout, res.Code)
+		require.False(t, hasDeadline)
+	})
+
+	t.Run("Malformed deadline", func(t *testing.T) {
+		res := request("in five seconds")
+		require.Equal(t, http.StatusBadRequest, res.Code)
+		require.Contains(t, res.Body.String(), "Invalid request deadline")
+		require.False(t, hasDeadline)
+	})
+
+	t.Run("No header", func(t *testing.T) {
+		res := request("")
+		require.Equal(t, http.StatusOK, res.Code)
+		require.False(t, hasDeadline)
+	})
+}
@@ -0,0 +1,127 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/AleksK1NG/api-mc/pkg/converter"
+	"github.com/AleksK1NG/api-mc/pkg/utils"
+)
+
+// Rewrite JSON responses to configured key naming and wrap successful ones into {"data": ...} envelope.
+// With camelCase naming request bodies are accepted in camelCase too. Non JSON responses are streamed as is
+func (mw *MiddlewareManager) JSONWireMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		camelCase := mw.cfg.Server.JSONNaming == converter.CamelCase
+		if !camelCase && !mw.cfg.Server.JSONEnvelope {
+			return next(c)
+		}
+
+		if camelCase {
+			if err := renameRequestKeys(c.Request(), converter.CamelToSnake); err != nil {
+				mw.logger.Errorf("JSONWireMiddleware RequestID: %s, renameRequestKeys: %v", utils.GetRequestID(c), err)
+			}
+		}
+
+		res := c.Response()
+		w := &jsonWireWriter{ResponseWriter: res.Writer}
+		res.Writer = w
+		defer func() { res.Writer = w.ResponseWriter }()
+
+		err := next(c)
+		if !w.buffering {
+			return err
+		}
+
+		body := w.buf.Bytes()
+		if camelCase {
+			if renamed, renameErr := converter.RenameJSONKeys(body, converter.SnakeToCamel); renameErr == nil {
+				body = renamed
+			} else {
+				mw.logger.Errorf("JSONWireMiddleware RequestID: %s, RenameJSONKeys: %v", utils.GetRequestID(c), renameErr)
+			}
+		}
+		if mw.cfg.Server.JSONEnvelope && w.status < http.StatusBadRequest {
+			body = append(append([]byte(`{"data":`), bytes.TrimSpace(body)...), '}')
+		}
+
+		w.Header().Del(echo.HeaderContentLength)
+		w.ResponseWriter.WriteHeader(w.status)
+		if _, writeErr := w.ResponseWriter.Write(append(body, '\n')); writeErr != nil {
+			mw.logger.Errorf("JSONWireMiddleware RequestID: %s, Write: %v", utils.GetRequestID(c), writeErr)
+		}
+		return err
+	}
+}
+
+// Response writer holding back JSON body until handler is done, other bodies pass through
+type jsonWireWriter struct {
+	http.ResponseWriter
+	buf         bytes.Buffer
+	status      int
+	buffering   bool
+	wroteHeader bool
+}
+
+func (w *jsonWireWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.status = code
+
+	mediaType, _, err := mime.ParseMediaType(w.Header().Get(echo.HeaderContentType))
+	if err == nil && mediaType == echo.MIMEApplicationJSON {
+		w.buffering = true
+		return
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *jsonWireWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.buffering {
+		return w.buf.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *jsonWireWriter) Flush() {
+	if w.buffering {
+		return
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func renameRequestKeys(req *http.Request, rename func(string) string) error {
+	mediaType, _, err := mime.ParseMediaType(req.Header.Get(echo.HeaderContentType))
+	if err != nil || mediaType != echo.MIMEApplicationJSON || req.Body == nil {
+		return nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if len(bytes.TrimSpace(body)) == 0 || !json.Valid(body) {
+		return nil
+	}
+
+	renamed, err := converter.RenameJSONKeys(body, rename)
+	if err != nil {
+		return err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(renamed))
+	req.ContentLength = int64(len(renamed))
+	return nil
+}
@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/config"
+	"github.com/AleksK1NG/api-mc/internal/models"
+	"github.com/AleksK1NG/api-mc/pkg/converter"
+	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+)
+
+func TestMiddlewareManager_JSONWireMiddleware(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(server config.ServerConfig) *echo.Echo {
+		cfg := &config.Config{Server: server, Logger: config.Logger{Development: true}}
+		apiLogger := logger.NewApiLogger(cfg)
+		apiLogger.InitLogger()
+		mw := NewMiddlewareManager(nil, nil, cfg, nil, apiLogger)
+
+		e := echo.New()
+		e.Use(mw.JSONWireMiddleware)
+		e.GET("/news", func(c echo.Context) error {
+			return c.JSON(http.StatusOK, &models.NewsList{TotalCount: 1, HasMore: false, News: []*models.News{{Title: "title"}}})
+		})
+		e.GET("/missing", func(c echo.Context) error {
+			return c.JSON(http.StatusNotFound, httpErrors.NewNotFoundError(nil))
+		})
+		e.GET("/csv", func(c echo.Context) error {
+			return c.Blob(http.StatusOK, "text/csv", []byte("news_id,title\n"))
+		})
+		e.POST("/news", func(c echo.Context) error {
+			n := &models.News{}
+			if err := c.Bind(n); err != nil {
+				return err
+			}
+			return c.JSON(http.StatusCreated, n)
+		})
+		return e
+	}
+
+	request := func(e *echo.Echo, method, target, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		}
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+		return res
+	}
+
+	t.Run("Snake case by default", func(t *testing.T) {
+		e := newServer(config.ServerConfig{})
+
+		res := request(e, http.MethodGet, "/news", "")
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Contains(t, res.Body.String(), `"total_count":1`)
+		require.Contains(t, res.Body.String(), `"has_more":false`)
+		require.NotContains(t, res.Body.String(), `"data"`)
+	})
+
+	t.Run("Camel case", func(t *testing.T) {
+		e := newServer(config.ServerConfig{JSONNaming: converter.CamelCase})
+
+		res := request(e, http.MethodGet, "/news", "")
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Contains(t, res.Body.String(), `"totalCount":1`)
+		require.Contains(t, res.Body.String(), `"hasMore":false`)
+		require.NotContains(t, res.Body.String(), `total_count`)
+
+		res = request(e, http.MethodPost, "/news", `{"title":"camel title","imageUrl":"https://example.com/a.png"}`)
+		require.Equal(t, http.StatusCreated, res.Code)
+		require.Contains(t, res.Body.String(), `"imageUrl":"https://example.com/a.png"`)
+	})
+
+	t.Run("Envelope", func(t *testing.T) {
+		e := newServer(config.ServerConfig{JSONEnvelope: true})
+
+		res := request(e, http.MethodGet, "/news", "")
+		require.Equal(t, http.StatusOK, res.Code)
+		require.True(t, strings.HasPrefix(res.Body.String(), `{"data":{`))
+		require.Contains(t, res.Body.String(), `"total_count":1`)
+
+		res = request(e, http.MethodGet, "/missing", "")
+		require.Equal(t, http.StatusNotFound, res.Code)
+		require.NotContains(t, res.Body.String(), `"data"`)
+		require.Contains(t, res.Body.String(), `"status":404`)
+	})
+
+	t.Run("Non JSON passes through", func(t *testing.T) {
+		e := newServer(config.ServerConfig{JSONNaming: converter.CamelCase, JSONEnvelope: true})
+
+		res := request(e, http.MethodGet, "/csv", "")
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Equal(t, "news_id,title\n", res.Body.String())
+	})
+}
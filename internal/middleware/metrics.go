@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -8,20 +9,30 @@ import (
 	"github.com/AleksK1NG/api-mc/pkg/metric"
 )
 
+// Route label of requests matching no registered route, echo reports raw path for them
+const unmatchedRoute = "unmatched"
+
 // Prometheus metrics middleware
 func (mw *MiddlewareManager) MetricsMiddleware(metrics metric.Metrics) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			start := time.Now()
 			err := next(c)
-			var status int
+			status := c.Response().Status
 			if err != nil {
-				status = err.(*echo.HTTPError).Code
-			} else {
-				status = c.Response().Status
+				status = http.StatusInternalServerError
+				if httpErr, ok := err.(*echo.HTTPError); ok {
+					status = httpErr.Code
+				}
+			}
+			route := c.Path()
+			if route == "" || err == echo.ErrNotFound {
+				route = unmatchedRoute
 			}
-			metrics.ObserveResponseTime(status, c.Request().Method, c.Path(), time.Since(start).Seconds())
-			metrics.IncHits(status, c.Request().Method, c.Path())
+			elapsed := time.Since(start).Seconds()
+			metrics.ObserveResponseTime(status, c.Request().Method, route, elapsed)
+			metrics.IncHits(status, c.Request().Method, route)
+			metrics.ObserveRequest(route, c.Request().Method, status, elapsed)
 			return err
 		}
 	}
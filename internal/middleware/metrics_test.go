@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/config"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+	"github.com/AleksK1NG/api-mc/pkg/metric"
+)
+
+func TestMiddlewareManager_MetricsMiddleware(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mw := NewMiddlewareManager(nil, nil, cfg, nil, apiLogger)
+
+	registry := prometheus.NewRegistry()
+	metrics, err := metric.NewPrometheusMetrics("test", registry)
+	require.NoError(t, err)
+
+	// Observations of request duration histogram with given route and status
+	observations := func(route, status string) uint64 {
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		for _, family := range families {
+			if family.GetName() != "http_request_duration_seconds" {
+				continue
+			}
+			for _, m := range family.GetMetric() {
+				labels := make(map[string]string)
+				for _, label := range m.GetLabel() {
+					labels[label.GetName()] = label.GetValue()
+				}
+				if labels["route"] == route && labels["status"] == status && labels["method"] == http.MethodGet {
+					return m.GetHistogram().GetSampleCount()
+				}
+			}
+		}
+		return 0
+	}
+
+	e := echo.New()
+	e.Use(mw.MetricsMiddleware(metrics))
+	e.GET("/api/v1/news/:news_id", func(c echo.Context) error {
+		if c.Param("news_id") == "missing" {
+			return c.JSON(http.StatusNotFound, nil)
+		}
+		return c.JSON(http.StatusOK, nil)
+	})
+
+	request := func(target string) {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		e.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	t.Run("Route pattern label", func(t *testing.T) {
+		request("/api/v1/news/first")
+		request("/api/v1/news/second")
+		request("/api/v1/news/missing")
+
+		require.Equal(t, uint64(2), observations("/api/v1/news/:news_id", "200"))
+		require.Equal(t, uint64(1), observations("/api/v1/news/:news_id", "404"))
+		require.Equal(t, 2, testutil.CollectAndCount(metrics.RequestDuration))
+		require.Equal(t, float64(2), testutil.ToFloat64(metrics.Responses.WithLabelValues("2xx")))
+		require.Equal(t, float64(1), testutil.ToFloat64(metrics.Responses.WithLabelValues("4xx")))
+	})
+
+	t.Run("Unmatched path", func(t *testing.T) {
+		request("/some/random/path")
+
+		require.Equal(t, uint64(1), observations(unmatchedRoute, "404"))
+		require.Equal(t, uint64(0), observations("/some/random/path", "404"))
+		require.Equal(t, float64(2), testutil.ToFloat64(metrics.Responses.WithLabelValues("4xx")))
+	})
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
+	"github.com/AleksK1NG/api-mc/pkg/utils"
+)
+
+const strictQueryParam = "strict"
+
+// Params every list endpoint accepts, pagination, timezone and strict switch itself
+var commonQueryParams = []string{"page", "size", "orderBy", "direction", "tz", strictQueryParam}
+
+// Reject list requests with query params outside of allowed ones, so typos are not silently ignored.
+// Active when Server.StrictQueryParams is set or request has strict=true
+func (mw *MiddlewareManager) StrictQueryMiddleware(allowed ...string) echo.MiddlewareFunc {
+	known := make(map[string]struct{}, len(commonQueryParams)+len(allowed))
+	for _, name := range append(commonQueryParams, allowed...) {
+		known[name] = struct{}{}
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !mw.cfg.Server.StrictQueryParams && c.QueryParam(strictQueryParam) != "true" {
+				return next(c)
+			}
+
+			unknown := make([]string, 0)
+			for name := range c.QueryParams() {
+				if _, ok := known[name]; !ok {
+					unknown = append(unknown, name)
+				}
+			}
+			if len(unknown) == 0 {
+				return next(c)
+			}
+			sort.Strings(unknown)
+
+			mw.logger.Errorf("StrictQueryMiddleware RequestID: %s, Path: %s, Unknown: %v", utils.GetRequestID(c), c.Path(), unknown)
+			message := fmt.Sprintf("%s: %s", httpErrors.UnknownQueryParams.Error(), strings.Join(unknown, ", "))
+			return c.JSON(http.StatusBadRequest, httpErrors.NewRestError(http.StatusBadRequest, message, unknown))
+		}
+	}
+}
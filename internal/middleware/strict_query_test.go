@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/config"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+)
+
+func TestMiddlewareManager_StrictQueryMiddleware(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(strict bool) *echo.Echo {
+		cfg := &config.Config{Server: config.ServerConfig{StrictQueryParams: strict}, Logger: config.Logger{Development: true}}
+		apiLogger := logger.NewApiLogger(cfg)
+		apiLogger.InitLogger()
+		mw := NewMiddlewareManager(nil, nil, cfg, nil, apiLogger)
+
+		e := echo.New()
+		e.GET("/news/search", func(c echo.Context) error {
+			return c.NoContent(http.StatusOK)
+		}, mw.StrictQueryMiddleware("title"))
+		return e
+	}
+
+	request := func(e *echo.Echo, target string) *httptest.ResponseRecorder {
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, httptest.NewRequest(http.MethodGet, target, nil))
+		return res
+	}
+
+	t.Run("Recognized params pass", func(t *testing.T) {
+		res := request(newServer(true), "/news/search?title=go&page=1&size=10&orderBy=title&direction=asc&tz=UTC")
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Unknown params rejected", func(t *testing.T) {
+		res := request(newServer(true), "/news/search?title=go&catagory=golang&sise=10")
+		require.Equal(t, http.StatusBadRequest, res.Code)
+		require.Contains(t, res.Body.String(), `"error":"Unknown query params: catagory, sise"`)
+	})
+
+	t.Run("Lenient by default", func(t *testing.T) {
+		res := request(newServer(false), "/news/search?title=go&catagory=golang")
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Strict per request", func(t *testing.T) {
+		res := request(newServer(false), "/news/search?title=go&catagory=golang&strict=true")
+		require.Equal(t, http.StatusBadRequest, res.Code)
+		require.Contains(t, res.Body.String(), "catagory")
+	})
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
+	"github.com/AleksK1NG/api-mc/pkg/utils"
+)
+
+// Scope request to tenant of authenticated user when Tenancy is enabled, passes through otherwise.
+// Request without session is rejected with 401, user without tenant with 403
+func (mw *MiddlewareManager) TenantMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	scoped := mw.AuthSessionMiddleware(func(c echo.Context) error {
+		user, err := utils.GetUserFromCtx(c.Request().Context())
+		if err != nil {
+			return c.JSON(http.StatusUnauthorized, httpErrors.NewUnauthorizedError(httpErrors.Unauthorized))
+		}
+		if user.TenantID == nil {
+			mw.logger.Errorf("TenantMiddleware RequestID: %s, UserID: %s, Error: user has no tenant", utils.GetRequestID(c), user.UserID)
+			return c.JSON(http.StatusForbidden, httpErrors.NewForbiddenError("user has no tenant"))
+		}
+
+		ctx := context.WithValue(c.Request().Context(), utils.TenantCtxKey{}, *user.TenantID)
+		c.SetRequest(c.Request().WithContext(ctx))
+		return next(c)
+	})
+
+	return func(c echo.Context) error {
+		if !mw.cfg.Tenancy.Enabled {
+			return next(c)
+		}
+		return scoped(c)
+	}
+}
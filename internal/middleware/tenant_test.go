@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/config"
+	mockAuth "github.com/AleksK1NG/api-mc/internal/auth/mock"
+	"github.com/AleksK1NG/api-mc/internal/models"
+	mockSess "github.com/AleksK1NG/api-mc/internal/session/mock"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+	"github.com/AleksK1NG/api-mc/pkg/utils"
+)
+
+func TestMiddlewareManager_TenantMiddleware(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		Logger:  config.Logger{Development: true},
+		Session: config.Session{Name: "session-id"},
+		Tenancy: config.TenancyConfig{Enabled: true},
+	}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockSessUC := mockSess.NewMockUCSession(ctrl)
+	mockAuthUC := mockAuth.NewMockUseCase(ctrl)
+	mw := NewMiddlewareManager(mockSessUC, mockAuthUC, cfg, nil, apiLogger)
+
+	handler := func(c echo.Context) error {
+		tenantID, ok := utils.GetTenantFromCtx(c.Request().Context())
+		if !ok {
+			return c.String(http.StatusOK, "unscoped")
+		}
+		return c.String(http.StatusOK, tenantID.String())
+	}
+
+	request := func(mw *MiddlewareManager, sessionID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/news", nil)
+		if sessionID != "" {
+			req.AddCookie(&http.Cookie{Name: cfg.Session.Name, Value: sessionID})
+		}
+		res := httptest.NewRecorder()
+		c := echo.New().NewContext(req, res)
+		require.NoError(t, mw.TenantMiddleware(handler)(c))
+		return res
+	}
+
+	expectUser := func(sessionID string, user *models.User) {
+		mockSessUC.EXPECT().GetSessionByID(gomock.Any(), sessionID).Return(&models.Session{SessionID: sessionID, UserID: user.UserID}, nil)
+		mockAuthUC.EXPECT().GetByID(gomock.Any(), user.UserID).Return(user, nil)
+	}
+
+	t.Run("Disabled passes through", func(t *testing.T) {
+		disabled := NewMiddlewareManager(nil, nil, &config.Config{}, nil, apiLogger)
+
+		res := request(disabled, "")
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Equal(t, "unscoped", res.Body.String())
+	})
+
+	t.Run("Anonymous request", func(t *testing.T) {
+		res := request(mw, "")
+		require.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+
+	t.Run("User without tenant", func(t *testing.T) {
+		expectUser("no-tenant", &models.User{UserID: uuid.New()})
+
+		res := request(mw, "no-tenant")
+		require.Equal(t, http.StatusForbidden, res.Code)
+	})
+
+	t.Run("Tenant of user", func(t *testing.T) {
+		tenantID := uuid.New()
+		expectUser("tenant", &models.User{UserID: uuid.New(), TenantID: &tenantID})
+
+		res := request(mw, "tenant")
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Equal(t, tenantID.String(), res.Body.String())
+	})
+}
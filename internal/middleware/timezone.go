@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+	// Embed IANA database, so zones are validated the same way regardless of host tzdata
+	_ "time/tzdata"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
+	"github.com/AleksK1NG/api-mc/pkg/utils"
+)
+
+const timezoneHeader = "Accept-Timezone"
+
+// Resolve response timezone from tz query param or Accept-Timezone header, UTC by default
+func (mw *MiddlewareManager) TimezoneMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		name := c.QueryParam("tz")
+		if name == "" {
+			name = c.Request().Header.Get(timezoneHeader)
+		}
+		if name == "" {
+			c.Set(utils.TimezoneCtxKey, time.UTC)
+			return next(c)
+		}
+
+		loc, err := time.LoadLocation(name)
+		if err != nil || name == "Local" {
+			mw.logger.Errorf("TimezoneMiddleware RequestID: %s, Timezone: %s, Error: %v",
+				utils.GetRequestID(c),
+				name,
+				err,
+			)
+			return c.JSON(http.StatusBadRequest, httpErrors.NewRestError(http.StatusBadRequest, httpErrors.InvalidTimezone.Error(), name))
+		}
+
+		c.Set(utils.TimezoneCtxKey, loc)
+		return next(c)
+	}
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/config"
+	"github.com/AleksK1NG/api-mc/internal/models"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+	"github.com/AleksK1NG/api-mc/pkg/utils"
+)
+
+func TestMiddlewareManager_TimezoneMiddleware(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mw := NewMiddlewareManager(nil, nil, cfg, nil, apiLogger)
+
+	createdAt := time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)
+
+	e := echo.New()
+	e.Use(mw.TimezoneMiddleware)
+	e.GET("/news", func(c echo.Context) error {
+		n := &models.News{CreatedAt: createdAt, UpdatedAt: createdAt}
+		return c.JSON(http.StatusOK, n.InLocation(utils.GetTimezone(c)))
+	})
+
+	request := func(target string, header string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		if header != "" {
+			req.Header.Set(timezoneHeader, header)
+		}
+		res := httptest.NewRecorder()
+		e.ServeHTTP(res, req)
+		return res
+	}
+
+	t.Run("UTC by default", func(t *testing.T) {
+		res := request("/news", "")
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Contains(t, res.Body.String(), `"created_at":"2021-02-03T04:05:06Z"`)
+	})
+
+	t.Run("Query param", func(t *testing.T) {
+		res := request("/news?tz=Asia/Tokyo", "")
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Contains(t, res.Body.String(), `"created_at":"2021-02-03T13:05:06+09:00"`)
+		require.Contains(t, res.Body.String(), `"updated_at":"2021-02-03T13:05:06+09:00"`)
+	})
+
+	t.Run("Header", func(t *testing.T) {
+		res := request("/news", "America/New_York")
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Contains(t, res.Body.String(), `"created_at":"2021-02-02T23:05:06-05:00"`)
+	})
+
+	t.Run("Invalid zone rejected", func(t *testing.T) {
+		res := request("/news?tz=Mars/Olympus_Mons", "")
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
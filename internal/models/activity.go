@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Actions recorded in news activity feed
+const (
+	NewsActionCreate = "create"
+	NewsActionUpdate = "update"
+	NewsActionDelete = "delete"
+)
+
+// Check is action one of recorded news actions
+func IsNewsAction(action string) bool {
+	return action == NewsActionCreate || action == NewsActionUpdate || action == NewsActionDelete
+}
+
+// News create, update or delete made by actor, title is as it was at the time of event
+type NewsEvent struct {
+	EventID uuid.UUID `json:"event_id" db:"event_id"`
+	Action  string    `json:"action" db:"action"`
+	NewsID  uuid.UUID `json:"news_id" db:"news_id"`
+	Title   string    `json:"title" db:"title"`
+	// Null when actor no longer exists
+	ActorID   *uuid.UUID `json:"actor_id" db:"actor_id"`
+	Actor     *string    `json:"actor" db:"actor"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+// Page of news activity, newest event first
+type NewsEventList struct {
+	TotalCount int          `json:"total_count"`
+	TotalPages int          `json:"total_pages"`
+	Page       int          `json:"page"`
+	Size       int          `json:"size"`
+	HasMore    bool         `json:"has_more"`
+	Events     []*NewsEvent `json:"events"`
+}
+
+// Present timestamps of all events in list in given location
+func (l *NewsEventList) InLocation(loc *time.Location) *NewsEventList {
+	if l == nil {
+		return nil
+	}
+	for _, e := range l.Events {
+		e.CreatedAt = e.CreatedAt.In(loc)
+	}
+	return l
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+var snakeCaseKey = regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`)
+
+// Keys of JSON object, omitempty fields of value should be set to appear
+func jsonKeys(t *testing.T, v interface{}) map[string]struct{} {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	var object map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(data, &object))
+
+	keys := make(map[string]struct{}, len(object))
+	for k := range object {
+		keys[k] = struct{}{}
+	}
+	return keys
+}
+
+func TestJSONContract(t *testing.T) {
+	t.Parallel()
+
+	var swagger struct {
+		Definitions map[string]struct {
+			Properties map[string]interface{} `json:"properties"`
+		} `json:"definitions"`
+	}
+	data, err := ioutil.ReadFile("../../docs/swagger.json")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &swagger))
+
+	now := time.Now()
+	text := "text"
+	news := &News{
+		NewsID: uuid.New(), AuthorID: uuid.New(), Title: text, Content: text, ImageURL: &text, Category: &text,
+		Status: NewsStatusPublished, Slug: &text, CreatedAt: now, UpdatedAt: now, PinnedAt: &now, PinnedUntil: &now,
+		DeletedAt: &now, SimilarNews: []*NewsRef{{}},
+	}
+
+	postcode := 1
+	user := &User{
+		UserID: uuid.New(), FirstName: text, LastName: text, Email: text, Password: text, Role: &text, About: &text,
+		Avatar: &text, PhoneNumber: &text, Address: &text, City: &text, Country: &text, Gender: &text, Postcode: &postcode,
+		Birthday: &now, CreatedAt: now, UpdatedAt: now, LoginDate: now,
+	}
+
+	dtos := map[string]interface{}{
+		"models.News":         news,
+		"models.NewsList":     &NewsList{News: []*News{news}},
+		"models.Comment":      &Comment{},
+		"models.CommentBase":  &CommentBase{},
+		"models.CommentsList": &CommentsList{},
+		"models.User":         user,
+		"models.UsersList":    &UsersList{Users: []*User{user}},
+	}
+
+	for name, dto := range dtos {
+		keys := jsonKeys(t, dto)
+
+		for key := range keys {
+			require.Regexp(t, snakeCaseKey, key, "%s key %q is not snake_case", name, key)
+		}
+
+		definition, ok := swagger.Definitions[name]
+		require.True(t, ok, "%s is not documented", name)
+		for property := range definition.Properties {
+			if _, ok := keys[property]; !ok {
+				t.Errorf("%s documented property %q is missing on the wire", name, property)
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Arbitrary JSON object attached to news by integrations, stored as jsonb and passed through as is
+type Metadata json.RawMessage
+
+// Scan implements sql.Scanner
+func (m *Metadata) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*m = nil
+	case string:
+		*m = Metadata(v)
+	case []byte:
+		*m = append(Metadata(nil), v...)
+	default:
+		return fmt.Errorf("Metadata.Scan: unsupported type %T", src)
+	}
+	return nil
+}
+
+// Value implements driver.Valuer
+func (m Metadata) Value() (driver.Value, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+	return string(m), nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (m Metadata) MarshalJSON() ([]byte, error) {
+	if len(m) == 0 {
+		return []byte("null"), nil
+	}
+	return m, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (m *Metadata) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*m = nil
+		return nil
+	}
+	*m = append((*m)[0:0], data...)
+	return nil
+}
+
+// Check is metadata a JSON object
+func (m Metadata) IsObject() bool {
+	var object map[string]json.RawMessage
+	return json.Unmarshal(m, &object) == nil && object != nil
+}
+
+// News metadata, replaced as a whole
+type NewsMetadata struct {
+	NewsID   uuid.UUID `json:"news_id"`
+	Metadata Metadata  `json:"metadata"`
+}
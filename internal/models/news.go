@@ -1,6 +1,8 @@
 package models
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,14 +10,225 @@ import (
 
 // News base model
 type News struct {
-	NewsID    uuid.UUID `json:"news_id" db:"news_id" validate:"omitempty,uuid"`
-	AuthorID  uuid.UUID `json:"author_id,omitempty" db:"author_id" validate:"required"`
-	Title     string    `json:"title" db:"title" validate:"required,gte=10"`
-	Content   string    `json:"content" db:"content" validate:"required,gte=20"`
-	ImageURL  *string   `json:"image_url,omitempty" db:"image_url" validate:"omitempty,lte=512,url"`
-	Category  *string   `json:"category,omitempty" db:"category" validate:"omitempty,lte=10"`
+	NewsID   uuid.UUID `json:"news_id" db:"news_id" validate:"omitempty,uuid"`
+	AuthorID uuid.UUID `json:"author_id,omitempty" db:"author_id" validate:"required"`
+	Title    string    `json:"title" db:"title" validate:"required,gte=10"`
+	Content  string    `json:"content" db:"content" validate:"required,gte=20"`
+	ImageURL *string   `json:"image_url,omitempty" db:"image_url" validate:"omitempty,lte=512,url"`
+	Category *string   `json:"category,omitempty" db:"category" validate:"omitempty,lte=10"`
+	Status   string    `json:"status,omitempty" db:"status" validate:"omitempty,oneof=draft published archived"`
+	// Unique url friendly key, optional, used by conditional create
+	Slug      *string   `json:"slug,omitempty" db:"slug" validate:"omitempty,lte=250"`
 	CreatedAt time.Time `json:"created_at,omitempty" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at,omitempty" db:"updated_at"`
+	// Set while news is pinned to featured list, pin without PinnedUntil never expires
+	PinnedAt    *time.Time `json:"pinned_at,omitempty" db:"pinned_at"`
+	PinnedUntil *time.Time `json:"pinned_until,omitempty" db:"pinned_until"`
+	// Set once news is deleted, deleted news are kept as tombstones for delta sync
+	DeletedAt *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
+	// Hash of normalized content, unique among not deleted news
+	ContentHash *string `json:"-" db:"content_hash"`
+	// Integration data, set only through news metadata endpoint
+	Metadata Metadata `json:"metadata,omitempty" db:"metadata"`
+	// Set by background image check when image url no longer resolves
+	ImageBroken bool `json:"image_broken,omitempty" db:"image_broken"`
+	// Times news was read by id
+	Views int64 `json:"views,omitempty" db:"views"`
+	// Words of content, kept in sync on every content write
+	WordCount int `json:"word_count" db:"word_count"`
+	// Comments of news, kept by comments trigger and reconciled by comment count job
+	CommentCount int `json:"comment_count" db:"comment_count"`
+	// Tenant owning news in multi tenant deployment, nil otherwise
+	TenantID *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id"`
+	// Existing news with very similar title, soft warning returned on create
+	SimilarNews []*NewsRef `json:"similar_news,omitempty" db:"-"`
+	// Edit lock held by other user, soft warning returned on update
+	EditLock *NewsEditLock `json:"edit_lock,omitempty" db:"-"`
+}
+
+// Present news timestamps in given location, stored values stay in UTC
+func (n *News) InLocation(loc *time.Location) *News {
+	if n == nil {
+		return nil
+	}
+	n.CreatedAt = n.CreatedAt.In(loc)
+	n.UpdatedAt = n.UpdatedAt.In(loc)
+	if n.PinnedAt != nil {
+		pinnedAt := n.PinnedAt.In(loc)
+		n.PinnedAt = &pinnedAt
+	}
+	if n.PinnedUntil != nil {
+		pinnedUntil := n.PinnedUntil.In(loc)
+		n.PinnedUntil = &pinnedUntil
+	}
+	if n.DeletedAt != nil {
+		deletedAt := n.DeletedAt.In(loc)
+		n.DeletedAt = &deletedAt
+	}
+	return n
+}
+
+// Check is news pinned at given moment, expired pins are not pinned anymore
+func (n *News) IsPinned(now time.Time) bool {
+	return n.PinnedAt != nil && (n.PinnedUntil == nil || n.PinnedUntil.After(now))
+}
+
+// News statuses
+const (
+	NewsStatusDraft     = "draft"
+	NewsStatusPublished = "published"
+	NewsStatusArchived  = "archived"
+)
+
+// Create refused since not deleted news with same content exists, Existing is nil when caller may not see it
+type DuplicateNewsError struct {
+	ExistingID uuid.UUID
+	Existing   *News
+}
+
+func (e *DuplicateNewsError) Error() string {
+	return fmt.Sprintf("news with same content already exists: %s", e.ExistingID)
+}
+
+// Reference to news whose body is not shown, title is set only when caller may see it
+type NewsRef struct {
+	NewsID uuid.UUID `json:"news_id" db:"news_id"`
+	Title  string    `json:"title,omitempty" db:"title"`
+}
+
+// Known news statuses in display order
+var NewsStatuses = []string{NewsStatusDraft, NewsStatusPublished, NewsStatusArchived}
+
+// Allowed news status transitions, from status to list of target statuses
+var newsStatusTransitions = map[string][]string{
+	NewsStatusDraft:     {NewsStatusPublished, NewsStatusArchived},
+	NewsStatusPublished: {NewsStatusArchived},
+	NewsStatusArchived:  {NewsStatusDraft, NewsStatusPublished},
+}
+
+// Check is status one of known news statuses
+func IsValidNewsStatus(status string) bool {
+	_, ok := newsStatusTransitions[status]
+	return ok
+}
+
+// Check is news allowed to move from one status to another
+func CanTransitionNewsStatus(from string, to string) bool {
+	for _, status := range newsStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Change status of many news request
+type NewsStatusBatch struct {
+	IDs    []uuid.UUID `json:"ids" validate:"required,min=1"`
+	Status string      `json:"status" validate:"required"`
+}
+
+// Reassign many orphaned news to a new author request
+type NewsReassignAuthor struct {
+	IDs      []uuid.UUID `json:"ids" validate:"required,min=1"`
+	AuthorID uuid.UUID   `json:"author_id" validate:"required"`
+}
+
+// Batch update of many news response
+type NewsBatchResult struct {
+	Affected int         `json:"affected"`
+	IDs      []uuid.UUID `json:"ids"`
+	DryRun   bool        `json:"dry_run"`
+}
+
+// Purge of soft deleted news response
+type NewsPurgeResult struct {
+	Purged int `json:"purged"`
+}
+
+// Comment count reconciliation response
+type NewsCommentCountResult struct {
+	Corrected int `json:"corrected"`
+}
+
+// Locale specific variant of news title and content
+type NewsTranslation struct {
+	NewsID    uuid.UUID `json:"news_id" db:"news_id"`
+	Locale    string    `json:"locale" db:"locale"`
+	Title     string    `json:"title" db:"title" validate:"required,gte=10"`
+	Content   string    `json:"content" db:"content" validate:"required,gte=20"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Where user left off reading news, position is read fraction of content from 0 to 1
+type ReadingPosition struct {
+	UserID    uuid.UUID `json:"user_id" db:"user_id"`
+	NewsID    uuid.UUID `json:"news_id" db:"news_id"`
+	Position  float64   `json:"position" db:"position" validate:"min=0,max=1"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Search result count response
+type NewsSearchCount struct {
+	TotalCount int `json:"total_count"`
+}
+
+// Pin news to featured list request, empty PinnedUntil pins forever
+type NewsPin struct {
+	PinnedUntil *time.Time `json:"pinned_until"`
+}
+
+// News tags, tags are normalized and unique per news
+type NewsTags struct {
+	NewsID uuid.UUID `json:"news_id"`
+	Tags   []string  `json:"tags"`
+}
+
+// Add tag to many news request
+type NewsTagBatch struct {
+	IDs []uuid.UUID `json:"ids" validate:"required,min=1"`
+	Tag string      `json:"tag" validate:"required"`
+}
+
+// Add tag to many news response, news already having the tag are not counted
+type NewsTagBatchResult struct {
+	Tag      string `json:"tag"`
+	Affected int    `json:"affected"`
+}
+
+// News image url checked by background image check
+type NewsImage struct {
+	NewsID   uuid.UUID `db:"news_id"`
+	ImageURL string    `db:"image_url"`
+}
+
+// Curated "see also" link request
+type NewsRelation struct {
+	RelatedID uuid.UUID `json:"related_id" validate:"required"`
+}
+
+// Fields news search matches
+type SearchScope string
+
+// News search scopes, title matches substring of title, content and all match full text vector
+const (
+	SearchScopeTitle   SearchScope = "title"
+	SearchScopeContent SearchScope = "content"
+	SearchScopeAll     SearchScope = "all"
+)
+
+// Advisory edit lock of news, Locked is false when nobody holds it
+type NewsEditLock struct {
+	NewsID    uuid.UUID  `json:"news_id"`
+	Locked    bool       `json:"locked"`
+	UserID    *uuid.UUID `json:"user_id,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// Signed news preview token
+type NewsPreviewToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
 }
 
 // All News response
@@ -26,16 +239,181 @@ type NewsList struct {
 	Size       int     `json:"size"`
 	HasMore    bool    `json:"has_more"`
 	News       []*News `json:"news"`
+	// Head of following page, present when requested with prefetch
+	NextPreview []*News `json:"next_preview,omitempty"`
+	// Total count, pages and has more are estimated from table statistics
+	ApproximateCount bool `json:"approximate_count,omitempty"`
+}
+
+// Ids of news list page, news themselves are not loaded
+type NewsIDList struct {
+	TotalCount int         `json:"total_count"`
+	TotalPages int         `json:"total_pages"`
+	Page       int         `json:"page"`
+	Size       int         `json:"size"`
+	HasMore    bool        `json:"has_more"`
+	IDs        []uuid.UUID `json:"ids"`
+}
+
+// Serialize list with news always present as array, empty page is [] and never null
+func (l NewsList) MarshalJSON() ([]byte, error) {
+	type newsList NewsList
+	if l.News == nil {
+		l.News = make([]*News, 0)
+	}
+	return json.Marshal(newsList(l))
+}
+
+// Compact news for feeds, content is replaced by short plain text excerpt
+type NewsSummaryDTO struct {
+	NewsID   uuid.UUID `json:"news_id"`
+	Title    string    `json:"title"`
+	Excerpt  string    `json:"excerpt"`
+	Category *string   `json:"category,omitempty"`
+	AuthorID uuid.UUID `json:"author_id"`
+	// Author display name, empty when author account is gone
+	Author    string    `json:"author"`
+	ImageURL  *string   `json:"image_url,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Page of news summaries
+type NewsSummaryList struct {
+	TotalCount int               `json:"total_count"`
+	TotalPages int               `json:"total_pages"`
+	Page       int               `json:"page"`
+	Size       int               `json:"size"`
+	HasMore    bool              `json:"has_more"`
+	News       []*NewsSummaryDTO `json:"news"`
+	// Head of following page, present when requested with prefetch
+	NextPreview []*NewsSummaryDTO `json:"next_preview,omitempty"`
+}
+
+// Serialize list with news always present as array, empty page is [] and never null
+func (l NewsSummaryList) MarshalJSON() ([]byte, error) {
+	type newsSummaryList NewsSummaryList
+	if l.News == nil {
+		l.News = make([]*NewsSummaryDTO, 0)
+	}
+	return json.Marshal(newsSummaryList(l))
+}
+
+// Present timestamps of all summaries in list in given location
+func (l *NewsSummaryList) InLocation(loc *time.Location) *NewsSummaryList {
+	if l == nil {
+		return nil
+	}
+	for _, n := range append(l.News, l.NextPreview...) {
+		n.CreatedAt = n.CreatedAt.In(loc)
+	}
+	return l
+}
+
+// Raw cached news entry for diagnostics
+type NewsCacheEntry struct {
+	Key string `json:"key"`
+	Hit bool   `json:"hit"`
+	// Seconds until entry expires, -1 for entry without expiry
+	TTLSeconds *int64 `json:"ttl_seconds,omitempty"`
+	// Cached JSON as stored, Raw is used instead when stored value is not valid JSON
+	Value json.RawMessage `json:"value,omitempty"`
+	Raw   string          `json:"raw,omitempty"`
+}
+
+// Present timestamps of all news in list in given location
+func (l *NewsList) InLocation(loc *time.Location) *NewsList {
+	if l == nil {
+		return nil
+	}
+	for _, n := range append(l.News, l.NextPreview...) {
+		n.InLocation(loc)
+	}
+	return l
+}
+
+// Change of news since sync point. News deleted or no longer published come as tombstones without news
+type NewsChange struct {
+	NewsID    uuid.UUID `json:"news_id"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Deleted   bool      `json:"deleted"`
+	News      *News     `json:"news,omitempty"`
+}
+
+// Page of news changes ordered by update time
+type NewsChangesList struct {
+	TotalCount int           `json:"total_count"`
+	TotalPages int           `json:"total_pages"`
+	Page       int           `json:"page"`
+	Size       int           `json:"size"`
+	HasMore    bool          `json:"has_more"`
+	Changes    []*NewsChange `json:"changes"`
+}
+
+// Present timestamps of all changes in list in given location
+func (l *NewsChangesList) InLocation(loc *time.Location) *NewsChangesList {
+	if l == nil {
+		return nil
+	}
+	for _, c := range l.Changes {
+		c.UpdatedAt = c.UpdatedAt.In(loc)
+		c.News.InLocation(loc)
+	}
+	return l
 }
 
 // News base
 type NewsBase struct {
-	NewsID    uuid.UUID `json:"news_id" db:"news_id" validate:"omitempty,uuid"`
-	AuthorID  uuid.UUID `json:"author_id" db:"author_id" validate:"omitempty,uuid"`
-	Title     string    `json:"title" db:"title" validate:"required,gte=10"`
-	Content   string    `json:"content" db:"content" validate:"required,gte=20"`
-	ImageURL  *string   `json:"image_url,omitempty" db:"image_url" validate:"omitempty,lte=512,url"`
-	Category  *string   `json:"category,omitempty" db:"category" validate:"omitempty,lte=10"`
-	Author    string    `json:"author" db:"author"`
-	UpdatedAt time.Time `json:"updated_at,omitempty" db:"updated_at"`
+	NewsID   uuid.UUID `json:"news_id" db:"news_id" validate:"omitempty,uuid"`
+	AuthorID uuid.UUID `json:"author_id" db:"author_id" validate:"omitempty,uuid"`
+	Title    string    `json:"title" db:"title" validate:"required,gte=10"`
+	Content  string    `json:"content" db:"content" validate:"required,gte=20"`
+	ImageURL *string   `json:"image_url,omitempty" db:"image_url" validate:"omitempty,lte=512,url"`
+	Category *string   `json:"category,omitempty" db:"category" validate:"omitempty,lte=10"`
+	Status   string    `json:"status,omitempty" db:"status"`
+	Slug     *string   `json:"slug,omitempty" db:"slug"`
+	Metadata Metadata  `json:"metadata,omitempty" db:"metadata"`
+	// Null when author no longer exists, unless News.MissingAuthorName is set
+	Author    *string    `json:"author" db:"author"`
+	AvatarURL *string    `json:"avatar_url" db:"avatar_url"`
+	UpdatedAt time.Time  `json:"updated_at,omitempty" db:"updated_at"`
+	TenantID  *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id"`
+	// Estimated from content word count when news is returned, not stored
+	ReadingTimeMinutes int `json:"reading_time_minutes" db:"-"`
+	// Locale of title and content when news is read in locale, not stored
+	Locale string `json:"locale,omitempty" db:"-"`
+}
+
+// Present news timestamps in given location
+func (n *NewsBase) InLocation(loc *time.Location) *NewsBase {
+	if n == nil {
+		return nil
+	}
+	n.UpdatedAt = n.UpdatedAt.In(loc)
+	return n
+}
+
+// News count per month
+type TimelineBucket struct {
+	Month time.Time `json:"month" db:"month"`
+	Count int       `json:"count" db:"count"`
+}
+
+// News count of one status
+type NewsStatusCount struct {
+	Status string `json:"status" db:"status"`
+	Count  int    `json:"count" db:"count"`
+}
+
+// Published news count of author within leaderboard window
+type AuthorStat struct {
+	AuthorID       uuid.UUID `json:"author_id" db:"author_id"`
+	Author         string    `json:"author" db:"author"`
+	AvatarURL      *string   `json:"avatar_url" db:"avatar_url"`
+	PublishedCount int       `json:"published_count" db:"published_count"`
+}
+
+// Previous and next news in publish order
+type NewsNeighbors struct {
+	Prev *News `json:"prev"`
+	Next *News `json:"next"`
 }
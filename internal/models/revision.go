@@ -0,0 +1,75 @@
+package models
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// News fields tracked by revisions
+const (
+	NewsFieldTitle    = "title"
+	NewsFieldContent  = "content"
+	NewsFieldImageURL = "image_url"
+	NewsFieldCategory = "category"
+	NewsFieldStatus   = "status"
+)
+
+// Names of news fields changed by revision, stored as comma separated text
+type ChangedFields []string
+
+// Scan implements sql.Scanner
+func (f *ChangedFields) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("ChangedFields.Scan: unsupported type %T", src)
+	}
+	*f = make(ChangedFields, 0)
+	if s != "" {
+		*f = strings.Split(s, ",")
+	}
+	return nil
+}
+
+// Value implements driver.Valuer
+func (f ChangedFields) Value() (driver.Value, error) {
+	return strings.Join(f, ","), nil
+}
+
+// News revision summary, one per recorded change
+type NewsRevision struct {
+	RevisionID    uuid.UUID     `json:"revision_id" db:"revision_id"`
+	NewsID        uuid.UUID     `json:"news_id" db:"news_id"`
+	ActorID       *uuid.UUID    `json:"actor_id" db:"actor_id"`
+	Actor         string        `json:"actor" db:"actor"`
+	ChangedFields ChangedFields `json:"changed_fields" db:"changed_fields"`
+	CreatedAt     time.Time     `json:"created_at" db:"created_at"`
+}
+
+// Full news snapshot stored with revision
+type NewsRevisionSnapshot struct {
+	NewsRevision
+	Title    string  `json:"title" db:"title"`
+	Content  string  `json:"content" db:"content"`
+	ImageURL *string `json:"image_url,omitempty" db:"image_url"`
+	Category *string `json:"category,omitempty" db:"category"`
+	Status   string  `json:"status" db:"status"`
+}
+
+// Present revision timestamp in given location
+func (r *NewsRevision) InLocation(loc *time.Location) *NewsRevision {
+	if r == nil {
+		return nil
+	}
+	r.CreatedAt = r.CreatedAt.In(loc)
+	return r
+}
@@ -28,6 +28,7 @@ type User struct {
 	CreatedAt   time.Time  `json:"created_at,omitempty" db:"created_at" redis:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at,omitempty" db:"updated_at" redis:"updated_at"`
 	LoginDate   time.Time  `json:"login_date" db:"login_date" redis:"login_date"`
+	TenantID    *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id" redis:"tenant_id"`
 }
 
 // Hash user password with bcrypt
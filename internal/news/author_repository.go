@@ -0,0 +1,15 @@
+//go:generate mockgen -source author_repository.go -destination mock/author_repository_mock.go -package mock
+package news
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/AleksK1NG/api-mc/internal/models"
+)
+
+// Authors lookup news use case needs from users, auth.Repository satisfies it
+type AuthorRepository interface {
+	GetByIDs(ctx context.Context, userIDs []uuid.UUID) ([]*models.User, error)
+}
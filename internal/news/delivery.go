@@ -9,5 +9,55 @@ type Handlers interface {
 	GetByID() echo.HandlerFunc
 	Delete() echo.HandlerFunc
 	GetNews() echo.HandlerFunc
+	GetNewsIDs() echo.HandlerFunc
 	SearchByTitle() echo.HandlerFunc
+	CountSearchByTitle() echo.HandlerFunc
+	GetTimeline() echo.HandlerFunc
+	GetNeighbors() echo.HandlerFunc
+	UpdateStatusBatch() echo.HandlerFunc
+	GetMyDrafts() echo.HandlerFunc
+	GetEditedByMe() echo.HandlerFunc
+	ExportAuthor() echo.HandlerFunc
+	GetOrphaned() echo.HandlerFunc
+	ReassignAuthor() echo.HandlerFunc
+	Export() echo.HandlerFunc
+	GetFeed() echo.HandlerFunc
+	GetRecentlyUpdated() echo.HandlerFunc
+	GetWithoutComments() echo.HandlerFunc
+	CreatePreviewToken() echo.HandlerFunc
+	GetPreview() echo.HandlerFunc
+	Pin() echo.HandlerFunc
+	Unpin() echo.HandlerFunc
+	GetFeatured() echo.HandlerFunc
+	GetRevisions() echo.HandlerFunc
+	GetRevision() echo.HandlerFunc
+	RevertTo() echo.HandlerFunc
+	GetAuthorLeaderboard() echo.HandlerFunc
+	CountByStatus() echo.HandlerFunc
+	GetAuthorStatusCounts() echo.HandlerFunc
+	GetBySlugs() echo.HandlerFunc
+	SetMetadata() echo.HandlerFunc
+	GetMetadata() echo.HandlerFunc
+	GetCacheEntry() echo.HandlerFunc
+	SetTags() echo.HandlerFunc
+	GetTags() echo.HandlerFunc
+	AddTagToMany() echo.HandlerFunc
+	SetTranslation() echo.HandlerFunc
+	GetTranslation() echo.HandlerFunc
+	SaveReadingPosition() echo.HandlerFunc
+	GetReadingPosition() echo.HandlerFunc
+	GetChangedSince() echo.HandlerFunc
+	GetActivityFeed() echo.HandlerFunc
+	PurgeDeleted() echo.HandlerFunc
+	RecomputeCommentCounts() echo.HandlerFunc
+	GetBrokenImages() echo.HandlerFunc
+	GetLatestPerCategory() echo.HandlerFunc
+	GetLatestUpdatePerAuthor() echo.HandlerFunc
+	AddRelation() echo.HandlerFunc
+	RemoveRelation() echo.HandlerFunc
+	GetCuratedRelated() echo.HandlerFunc
+	GetTrending() echo.HandlerFunc
+	AcquireEditLock() echo.HandlerFunc
+	ReleaseEditLock() echo.HandlerFunc
+	GetEditLock() echo.HandlerFunc
 }
@@ -1,30 +1,66 @@
 package http
 
 import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
 
 	"github.com/AleksK1NG/api-mc/config"
 	"github.com/AleksK1NG/api-mc/internal/models"
 	"github.com/AleksK1NG/api-mc/internal/news"
+	"github.com/AleksK1NG/api-mc/pkg/converter"
+	"github.com/AleksK1NG/api-mc/pkg/feed"
 	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
 	"github.com/AleksK1NG/api-mc/pkg/logger"
 	"github.com/AleksK1NG/api-mc/pkg/utils"
 )
 
+const (
+	exportFormatJSON     = "json"
+	exportFormatMarkdown = "markdown"
+	mimeTextMarkdown     = "text/markdown; charset=UTF-8"
+	mimeTextCSV          = "text/csv"
+	csvFlushRows         = 100
+	headerXCache         = "X-Cache"
+	headerRange          = "Range"
+	headerContentRange   = "Content-Range"
+	headerAcceptRanges   = "Accept-Ranges"
+	contentRangeUnit     = "chars"
+	headerAcceptLanguage = "Accept-Language"
+	headerContentLang    = "Content-Language"
+	mimeApplicationZip   = "application/zip"
+
+	defaultMaxConcurrentExports = 4
+)
+
+var newsCSVHeader = []string{"news_id", "author_id", "title", "content", "image_url", "category", "status", "created_at", "updated_at"}
+
 // News handlers
 type newsHandlers struct {
 	cfg    *config.Config
 	newsUC news.UseCase
 	logger logger.Logger
+	// Slots of author exports running at the same time
+	exports chan struct{}
 }
 
 // NewNewsHandlers News handlers constructor
 func NewNewsHandlers(cfg *config.Config, newsUC news.UseCase, logger logger.Logger) news.Handlers {
-	return &newsHandlers{cfg: cfg, newsUC: newsUC, logger: logger}
+	maxExports := defaultMaxConcurrentExports
+	if cfg != nil && cfg.News.MaxConcurrentExports > 0 {
+		maxExports = cfg.News.MaxConcurrentExports
+	}
+	return &newsHandlers{cfg: cfg, newsUC: newsUC, logger: logger, exports: make(chan struct{}, maxExports)}
 }
 
 // Create godoc
@@ -33,26 +69,56 @@ func NewNewsHandlers(cfg *config.Config, newsUC news.UseCase, logger logger.Logg
 // @Tags News
 // @Accept json
 // @Produce json
+// @Param if_not_exists query bool false "return existing news with same slug with 200 instead of creating"
 // @Success 201 {object} models.News
+// @Success 200 {object} models.News
+// @Success 409 {object} models.News "existing news with same content, only news_id when news is not visible to caller"
 // @Router /news/create [post]
 func (h newsHandlers) Create() echo.HandlerFunc {
 	return func(c echo.Context) error {
 		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.Create")
 		defer span.Finish()
 
+		ifNotExists, err := parseBoolQuery(c, "if_not_exists")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
 		n := &models.News{}
-		if err := c.Bind(n); err != nil {
+		if err = c.Bind(n); err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
 		}
 
+		if ifNotExists {
+			news, created, err := h.newsUC.CreateIfNotExists(ctx, n)
+			if err != nil {
+				var duplicate *models.DuplicateNewsError
+				if errors.As(err, &duplicate) {
+					return h.duplicateContent(c, duplicate)
+				}
+				utils.LogResponseError(c, h.logger, err)
+				return c.JSON(httpErrors.ErrorResponse(err))
+			}
+			status := http.StatusOK
+			if created {
+				status = http.StatusCreated
+			}
+			return c.JSON(status, news.InLocation(utils.GetTimezone(c)))
+		}
+
 		createdNews, err := h.newsUC.Create(ctx, n)
 		if err != nil {
+			var duplicate *models.DuplicateNewsError
+			if errors.As(err, &duplicate) {
+				return h.duplicateContent(c, duplicate)
+			}
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
 		}
 
-		return c.JSON(http.StatusCreated, createdNews)
+		return c.JSON(http.StatusCreated, createdNews.InLocation(utils.GetTimezone(c)))
 	}
 }
 
@@ -64,13 +130,14 @@ func (h newsHandlers) Create() echo.HandlerFunc {
 // @Produce json
 // @Param id path int true "news_id"
 // @Success 200 {object} models.News
+// @Failure 400 {object} httpErrors.RestError
 // @Router /news/{id} [put]
 func (h newsHandlers) Update() echo.HandlerFunc {
 	return func(c echo.Context) error {
 		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.Update")
 		defer span.Finish()
 
-		newsUUID, err := uuid.Parse(c.Param("news_id"))
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
 		if err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
@@ -81,6 +148,12 @@ func (h newsHandlers) Update() echo.HandlerFunc {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
 		}
+		// News id in path identifies updated news, body can only repeat it
+		if n.NewsID != uuid.Nil && n.NewsID != newsUUID {
+			err = httpErrors.NewRestError(http.StatusBadRequest, httpErrors.ImmutableField.Error()+": news_id", httpErrors.ImmutableField)
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
 		n.NewsID = newsUUID
 
 		updatedNews, err := h.newsUC.Update(ctx, n)
@@ -89,7 +162,7 @@ func (h newsHandlers) Update() echo.HandlerFunc {
 			return c.JSON(httpErrors.ErrorResponse(err))
 		}
 
-		return c.JSON(http.StatusOK, updatedNews)
+		return c.JSON(http.StatusOK, updatedNews.InLocation(utils.GetTimezone(c)))
 	}
 }
 
@@ -100,26 +173,177 @@ func (h newsHandlers) Update() echo.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param id path int true "news_id"
+// @Param offset query int false "first content character of partial response" Format(offset)
+// @Param length query int false "number of content characters of partial response" Format(length)
+// @Param Range header string false "content characters range like chars=0-999 or chars=1000-"
+// @Param locale query string false "locale of title and content, takes precedence over Accept-Language" Format(locale)
+// @Param Accept-Language header string false "preferred locales, default locale is used when news has no translation"
 // @Success 200 {object} models.News
+// @Success 206 {object} models.News
+// @Failure 400 {object} httpErrors.RestError
 // @Router /news/{id} [get]
 func (h newsHandlers) GetByID() echo.HandlerFunc {
 	return func(c echo.Context) error {
 		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetByID")
 		defer span.Finish()
 
-		newsUUID, err := uuid.Parse(c.Param("news_id"))
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
 		if err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
 		}
 
-		newsByID, err := h.newsUC.GetNewsByID(ctx, newsUUID)
+		offset, length, partial, err := parseContentRange(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		var cacheStatus *string
+		if h.cfg.Server.CacheStatusHeader {
+			ctx, cacheStatus = utils.WithCacheStatus(ctx)
+		}
+
+		var newsByID *models.NewsBase
+		if locale := requestedLocale(c); locale != "" {
+			newsByID, err = h.newsUC.GetNewsByIDInLocale(ctx, newsUUID, locale)
+		} else {
+			newsByID, err = h.newsUC.GetNewsByID(ctx, newsUUID)
+		}
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		if cacheStatus != nil && *cacheStatus != "" {
+			c.Response().Header().Set(headerXCache, *cacheStatus)
+		}
+		c.Response().Header().Set(headerAcceptRanges, contentRangeUnit)
+		c.Response().Header().Add(echo.HeaderVary, headerAcceptLanguage)
+		if newsByID.Locale != "" {
+			c.Response().Header().Set(headerContentLang, newsByID.Locale)
+		}
+
+		if !partial {
+			return c.JSON(http.StatusOK, newsByID.InLocation(utils.GetTimezone(c)))
+		}
+
+		content, start, end, total, ok := utils.SliceRunes(newsByID.Content, offset, length)
+		if !ok {
+			c.Response().Header().Set(headerContentRange, fmt.Sprintf("%s */%d", contentRangeUnit, total))
+			err = httpErrors.NewRestError(http.StatusRequestedRangeNotSatisfiable, http.StatusText(http.StatusRequestedRangeNotSatisfiable), nil)
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+		partialNews := *newsByID
+		partialNews.Content = content
+
+		c.Response().Header().Set(headerContentRange, fmt.Sprintf("%s %d-%d/%d", contentRangeUnit, start, end-1, total))
+		return c.JSON(http.StatusPartialContent, partialNews.InLocation(utils.GetTimezone(c)))
+	}
+}
+
+// Locale of locale query param, or most preferred one of Accept-Language header
+func requestedLocale(c echo.Context) string {
+	if locale := c.QueryParam("locale"); locale != "" {
+		return locale
+	}
+	return utils.PreferredLocale(c.Request().Header.Get(headerAcceptLanguage))
+}
+
+// GetBySlugs godoc
+// @Summary Get news by slugs
+// @Description Get many news by slugs in one request, news are returned in order of slugs and unknown slugs are skipped
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param slug query []string true "news slugs, up to 100" collectionFormat(multi)
+// @Success 200 {array} models.NewsBase
+// @Router /news/by-slugs [get]
+func (h newsHandlers) GetBySlugs() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetBySlugs")
+		defer span.Finish()
+
+		news, err := h.newsUC.GetBySlugs(ctx, c.QueryParams()["slug"])
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		loc := utils.GetTimezone(c)
+		for _, n := range news {
+			n.InLocation(loc)
+		}
+		return c.JSON(http.StatusOK, news)
+	}
+}
+
+// GetLatestPerCategory godoc
+// @Summary Get latest news per category
+// @Description Get latest published news of every given category, categories without news are left out
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param category query []string true "categories, up to 20" collectionFormat(multi)
+// @Success 200 {object} map[string]models.News
+// @Router /news/latest-per-category [get]
+func (h newsHandlers) GetLatestPerCategory() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetLatestPerCategory")
+		defer span.Finish()
+
+		latest, err := h.newsUC.GetLatestPerCategory(ctx, c.QueryParams()["category"])
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		loc := utils.GetTimezone(c)
+		for _, n := range latest {
+			n.InLocation(loc)
+		}
+		return c.JSON(http.StatusOK, latest)
+	}
+}
+
+// GetLatestUpdatePerAuthor godoc
+// @Summary Get latest updated news per author
+// @Description Get most recently updated published news of every given author, authors without news are left out
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param author_id query []string true "author uuids, up to 20" collectionFormat(multi)
+// @Success 200 {object} map[string]models.News
+// @Router /news/latest-per-author [get]
+func (h newsHandlers) GetLatestUpdatePerAuthor() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetLatestUpdatePerAuthor")
+		defer span.Finish()
+
+		raw := c.QueryParams()["author_id"]
+		authorIDs := make([]uuid.UUID, 0, len(raw))
+		for _, value := range raw {
+			id, err := uuid.Parse(value)
+			if err != nil {
+				restErr := httpErrors.NewRestError(http.StatusBadRequest, "Invalid author_id param", httpErrors.InvalidUUIDParam)
+				utils.LogResponseError(c, h.logger, restErr)
+				return c.JSON(httpErrors.ErrorResponse(restErr))
+			}
+			authorIDs = append(authorIDs, id)
+		}
+
+		latest, err := h.newsUC.GetLatestUpdatePerAuthor(ctx, authorIDs)
 		if err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
 		}
 
-		return c.JSON(http.StatusOK, newsByID)
+		loc := utils.GetTimezone(c)
+		for _, n := range latest {
+			n.InLocation(loc)
+		}
+		return c.JSON(http.StatusOK, latest)
 	}
 }
 
@@ -137,7 +361,7 @@ func (h newsHandlers) Delete() echo.HandlerFunc {
 		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.Delete")
 		defer span.Finish()
 
-		newsUUID, err := uuid.Parse(c.Param("news_id"))
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
 		if err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
@@ -154,14 +378,25 @@ func (h newsHandlers) Delete() echo.HandlerFunc {
 
 // GetNews godoc
 // @Summary Get all news
-// @Description Get all news with pagination
+// @Description Get news summaries with excerpt instead of content with pagination, full news as csv when requested with Accept: text/csv
 // @Tags News
 // @Accept json
-// @Produce json
+// @Produce json,text/csv
 // @Param page query int false "page number" Format(page)
 // @Param size query int false "number of elements per page" Format(size)
-// @Param orderBy query int false "filter name" Format(orderBy)
-// @Success 200 {object} models.NewsList
+// @Param orderBy query string false "created_at, updated_at, title or featured, configured default order when empty" Format(orderBy)
+// @Param direction query string false "asc or desc" Format(direction)
+// @Param category query string false "category" Format(category)
+// @Param categories query []string false "any of categories, up to 20" collectionFormat(multi)
+// @Param author_id query string false "author uuid" Format(author_id)
+// @Param created_after query string false "RFC3339 time" Format(created_after)
+// @Param created_before query string false "RFC3339 time" Format(created_before)
+// @Param metadata_filter query string false "JSON object news metadata must contain" Format(metadata_filter)
+// @Param min_words query int false "at least words of content" Format(min_words)
+// @Param max_words query int false "at most words of content" Format(max_words)
+// @Param prefetch_next query bool false "also return first few news of next page as next_preview" Format(prefetch_next)
+// @Param exact_count query bool false "false estimates total count of unfiltered list from table statistics, flagged with approximate_count" Format(exact_count)
+// @Success 200 {object} models.NewsSummaryList
 // @Router /news [get]
 func (h newsHandlers) GetNews() echo.HandlerFunc {
 	return func(c echo.Context) error {
@@ -174,26 +409,73 @@ func (h newsHandlers) GetNews() echo.HandlerFunc {
 			return c.JSON(httpErrors.ErrorResponse(err))
 		}
 
-		newsList, err := h.newsUC.GetNews(ctx, pq)
+		if acceptsCSV(c) {
+			return h.writeNewsCSV(c, func(fn func(n *models.News) error) error {
+				return h.newsUC.StreamNews(ctx, pq, c.QueryParams(), fn)
+			})
+		}
+
+		newsList, err := h.newsUC.GetNews(ctx, pq, c.QueryParams())
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		summaries, err := h.newsUC.Summarize(ctx, newsList)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, summaries.InLocation(utils.GetTimezone(c)))
+	}
+}
+
+// GetNewsIDs godoc
+// @Summary Get news ids
+// @Description Get only ids of news list page, accepts every filter and order of news list
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
+// @Param orderBy query string false "created_at, updated_at, title or featured, configured default order when empty" Format(orderBy)
+// @Param direction query string false "asc or desc" Format(direction)
+// @Success 200 {object} models.NewsIDList
+// @Router /news/ids [get]
+func (h newsHandlers) GetNewsIDs() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetNewsIDs")
+		defer span.Finish()
+
+		pq, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		ids, err := h.newsUC.GetNewsIDs(ctx, pq, c.QueryParams())
 		if err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
 		}
 
-		return c.JSON(http.StatusOK, newsList)
+		return c.JSON(http.StatusOK, ids)
 	}
 }
 
 // SearchByTitle godoc
 // @Summary Search by title
-// @Description Search news by title
+// @Description Search news by title, or by content words with scope content or all, returning summaries, full news as csv when requested with Accept: text/csv
 // @Tags News
 // @Accept json
-// @Produce json
+// @Produce json,text/csv
+// @Param title query string true "search text" Format(title)
+// @Param scope query string false "title (default), content or all" Format(scope)
 // @Param page query int false "page number" Format(page)
 // @Param size query int false "number of elements per page" Format(size)
 // @Param orderBy query int false "filter name" Format(orderBy)
-// @Success 200 {object} models.NewsList
+// @Success 200 {object} models.NewsSummaryList
 // @Router /news/search [get]
 func (h newsHandlers) SearchByTitle() echo.HandlerFunc {
 	return func(c echo.Context) error {
@@ -206,13 +488,1830 @@ func (h newsHandlers) SearchByTitle() echo.HandlerFunc {
 			return c.JSON(httpErrors.ErrorResponse(err))
 		}
 
-		newsList, err := h.newsUC.SearchByTitle(ctx, c.QueryParam("title"), pq)
+		if acceptsCSV(c) {
+			return h.writeNewsCSV(c, func(fn func(n *models.News) error) error {
+				return h.newsUC.StreamSearchByTitle(ctx, c.QueryParam("title"), c.QueryParam("scope"), pq, fn)
+			})
+		}
+
+		newsList, err := h.newsUC.SearchByTitle(ctx, c.QueryParam("title"), c.QueryParam("scope"), pq)
+
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		summaries, err := h.newsUC.Summarize(ctx, newsList)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, summaries.InLocation(utils.GetTimezone(c)))
+	}
+}
+
+// CountSearchByTitle godoc
+// @Summary Count search results
+// @Description Count news search by title would find, without fetching them
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param title query string true "search text" Format(title)
+// @Param scope query string false "title (default), content or all" Format(scope)
+// @Success 200 {object} models.NewsSearchCount
+// @Failure 400 {object} httpErrors.RestError
+// @Router /news/search/count [get]
+func (h newsHandlers) CountSearchByTitle() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.CountSearchByTitle")
+		defer span.Finish()
+
+		count, err := h.newsUC.CountSearchByTitle(ctx, c.QueryParam("title"), c.QueryParam("scope"))
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, count)
+	}
+}
+
+// GetTimeline godoc
+// @Summary Get news timeline
+// @Description Get news count grouped by month
+// @Tags News
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.TimelineBucket
+// @Router /news/timeline [get]
+func (h newsHandlers) GetTimeline() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetTimeline")
+		defer span.Finish()
+
+		timeline, err := h.newsUC.GetTimeline(ctx)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, timeline)
+	}
+}
+
+// GetNeighbors godoc
+// @Summary Get previous and next news
+// @Description Get previous and next news in publish order, null at the ends of the feed
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Success 200 {object} models.NewsNeighbors
+// @Router /news/{id}/neighbors [get]
+func (h newsHandlers) GetNeighbors() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetNeighbors")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		prev, next, err := h.newsUC.GetNeighbors(ctx, newsUUID)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		loc := utils.GetTimezone(c)
+		return c.JSON(http.StatusOK, &models.NewsNeighbors{Prev: prev.InLocation(loc), Next: next.InLocation(loc)})
+	}
+}
+
+// Pin godoc
+// @Summary Pin news
+// @Description Pin news to featured list, pin is dropped automatically after pinned_until, empty pinned_until pins forever
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Success 200 {string} string	"ok"
+// @Router /news/{id}/pin [post]
+func (h newsHandlers) Pin() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.Pin")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		pin := &models.NewsPin{}
+		if err = utils.ReadRequest(c, pin); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		if err = h.newsUC.Pin(ctx, newsUUID, pin.PinnedUntil); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+// AcquireEditLock godoc
+// @Summary Lock news for editing
+// @Description Take advisory edit lock of news, holder renews it by locking again, lock held by other user gives 409
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Param ttl query int false "lock lifetime in seconds, configured by default, up to 3600" Format(ttl)
+// @Success 200 {object} models.NewsEditLock
+// @Router /news/{id}/lock [post]
+func (h newsHandlers) AcquireEditLock() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.AcquireEditLock")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		var ttlSeconds int
+		if ttlQuery := c.QueryParam("ttl"); ttlQuery != "" {
+			if ttlSeconds, err = strconv.Atoi(ttlQuery); err != nil {
+				err = httpErrors.NewRestError(http.StatusBadRequest, httpErrors.ErrBadQueryParams, httpErrors.BadQueryParams)
+				utils.LogResponseError(c, h.logger, err)
+				return c.JSON(httpErrors.ErrorResponse(err))
+			}
+		}
+
+		lock, err := h.newsUC.AcquireEditLock(ctx, newsUUID, time.Duration(ttlSeconds)*time.Second)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, lock)
+	}
+}
+
+// ReleaseEditLock godoc
+// @Summary Unlock news
+// @Description Release own edit lock of news
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Success 200 {string} string	"ok"
+// @Router /news/{id}/lock [delete]
+func (h newsHandlers) ReleaseEditLock() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.ReleaseEditLock")
+		defer span.Finish()
 
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
 		if err != nil {
 			utils.LogResponseError(c, h.logger, err)
 			return c.JSON(httpErrors.ErrorResponse(err))
 		}
 
-		return c.JSON(http.StatusOK, newsList)
+		if err = h.newsUC.ReleaseEditLock(ctx, newsUUID); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+// GetEditLock godoc
+// @Summary Get news edit lock
+// @Description Get who holds edit lock of news and until when
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Success 200 {object} models.NewsEditLock
+// @Router /news/{id}/lock [get]
+func (h newsHandlers) GetEditLock() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetEditLock")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		lock, err := h.newsUC.GetEditLock(ctx, newsUUID)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		if lock.ExpiresAt != nil {
+			expiresAt := lock.ExpiresAt.In(utils.GetTimezone(c))
+			lock.ExpiresAt = &expiresAt
+		}
+		return c.JSON(http.StatusOK, lock)
+	}
+}
+
+// AddRelation godoc
+// @Summary Add related news
+// @Description Add curated "see also" link from news to other news, self links and duplicates are rejected
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Success 201 {string} string	"created"
+// @Router /news/{id}/relations [post]
+func (h newsHandlers) AddRelation() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.AddRelation")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		relation := &models.NewsRelation{}
+		if err = utils.ReadRequest(c, relation); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		if err = h.newsUC.AddRelation(ctx, newsUUID, relation.RelatedID); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.NoContent(http.StatusCreated)
+	}
+}
+
+// RemoveRelation godoc
+// @Summary Remove related news
+// @Description Remove curated "see also" link between news
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Param related_id path int true "related news id"
+// @Success 200 {string} string	"ok"
+// @Router /news/{id}/relations/{related_id} [delete]
+func (h newsHandlers) RemoveRelation() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.RemoveRelation")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+		relatedUUID, err := utils.ParseUUIDParam(c, "related_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		if err = h.newsUC.RemoveRelation(ctx, newsUUID, relatedUUID); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+// GetCuratedRelated godoc
+// @Summary Get related news
+// @Description Get published news curated as related to given news, in order links were added
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Success 200 {array} models.News
+// @Router /news/{id}/relations [get]
+func (h newsHandlers) GetCuratedRelated() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetCuratedRelated")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		related, err := h.newsUC.GetCuratedRelated(ctx, newsUUID)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		loc := utils.GetTimezone(c)
+		for _, n := range related {
+			n.InLocation(loc)
+		}
+		return c.JSON(http.StatusOK, related)
+	}
+}
+
+// Unpin godoc
+// @Summary Unpin news
+// @Description Remove news from featured list
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Success 200 {string} string	"ok"
+// @Router /news/{id}/pin [delete]
+func (h newsHandlers) Unpin() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.Unpin")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		if err = h.newsUC.Unpin(ctx, newsUUID); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+// GetFeatured godoc
+// @Summary Get featured news
+// @Description Get currently pinned news, most recently pinned first
+// @Tags News
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.News
+// @Router /news/featured [get]
+func (h newsHandlers) GetFeatured() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetFeatured")
+		defer span.Finish()
+
+		featured, err := h.newsUC.GetFeatured(ctx)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		loc := utils.GetTimezone(c)
+		for _, n := range featured {
+			n.InLocation(loc)
+		}
+
+		return c.JSON(http.StatusOK, featured)
+	}
+}
+
+// GetRevisions godoc
+// @Summary Get news revisions
+// @Description Get revision history of news in chronological order, only author can see it
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Success 200 {array} models.NewsRevision
+// @Router /news/{id}/revisions [get]
+func (h newsHandlers) GetRevisions() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetRevisions")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		revisions, err := h.newsUC.GetRevisions(ctx, newsUUID)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		loc := utils.GetTimezone(c)
+		for _, r := range revisions {
+			r.InLocation(loc)
+		}
+
+		return c.JSON(http.StatusOK, revisions)
+	}
+}
+
+// GetRevision godoc
+// @Summary Get news revision
+// @Description Get full news snapshot stored with revision, only author can see it
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param revision_id path int true "revision_id"
+// @Success 200 {object} models.NewsRevisionSnapshot
+// @Router /news/revisions/{revision_id} [get]
+func (h newsHandlers) GetRevision() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetRevision")
+		defer span.Finish()
+
+		revisionUUID, err := utils.ParseUUIDParam(c, "revision_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		revision, err := h.newsUC.GetRevision(ctx, revisionUUID)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		revision.InLocation(utils.GetTimezone(c))
+		return c.JSON(http.StatusOK, revision)
+	}
+}
+
+// RevertTo godoc
+// @Summary Revert news to revision
+// @Description Apply snapshot of past revision as new update, revert is recorded as new revision
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Param revision_id path int true "revision_id"
+// @Success 200 {object} models.News
+// @Router /news/{id}/revisions/{revision_id}/revert [post]
+func (h newsHandlers) RevertTo() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.RevertTo")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		revisionUUID, err := utils.ParseUUIDParam(c, "revision_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		reverted, err := h.newsUC.RevertTo(ctx, newsUUID, revisionUUID)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, reverted.InLocation(utils.GetTimezone(c)))
+	}
+}
+
+// GetAuthorLeaderboard godoc
+// @Summary Get author leaderboard
+// @Description Get authors with most published news within window, ties ordered by author name
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param window query string false "window like 7d or 72h, 30d by default" Format(window)
+// @Param limit query int false "number of authors, 10 by default" Format(limit)
+// @Success 200 {array} models.AuthorStat
+// @Router /news/leaderboard [get]
+func (h newsHandlers) GetAuthorLeaderboard() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetAuthorLeaderboard")
+		defer span.Finish()
+
+		window, err := parseWindow(c.QueryParam("window"))
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		var limit int
+		if limitQuery := c.QueryParam("limit"); limitQuery != "" {
+			if limit, err = strconv.Atoi(limitQuery); err != nil {
+				err = httpErrors.NewRestError(http.StatusBadRequest, httpErrors.ErrBadQueryParams, httpErrors.BadQueryParams)
+				utils.LogResponseError(c, h.logger, err)
+				return c.JSON(httpErrors.ErrorResponse(err))
+			}
+		}
+
+		stats, err := h.newsUC.GetAuthorLeaderboard(ctx, window, limit)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, stats)
+	}
+}
+
+// GetTrending godoc
+// @Summary Get trending news
+// @Description Get published news ordered by trending score, views decayed by age
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param limit query int false "number of news, 10 by default, up to 50" Format(limit)
+// @Success 200 {array} models.News
+// @Router /news/trending [get]
+func (h newsHandlers) GetTrending() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetTrending")
+		defer span.Finish()
+
+		var limit int
+		if limitQuery := c.QueryParam("limit"); limitQuery != "" {
+			var err error
+			if limit, err = strconv.Atoi(limitQuery); err != nil {
+				err = httpErrors.NewRestError(http.StatusBadRequest, httpErrors.ErrBadQueryParams, httpErrors.BadQueryParams)
+				utils.LogResponseError(c, h.logger, err)
+				return c.JSON(httpErrors.ErrorResponse(err))
+			}
+		}
+
+		trending, err := h.newsUC.GetTrending(ctx, limit)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		loc := utils.GetTimezone(c)
+		for _, n := range trending {
+			n.InLocation(loc)
+		}
+		return c.JSON(http.StatusOK, trending)
+	}
+}
+
+// CountByStatus godoc
+// @Summary Get news count per status
+// @Description Get number of news in every status, statuses without news have zero count
+// @Tags News
+// @Accept json
+// @Produce json
+// @Success 200 {object} map[string]int
+// @Router /news/stats/status [get]
+func (h newsHandlers) CountByStatus() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.CountByStatus")
+		defer span.Finish()
+
+		counts, err := h.newsUC.CountByStatus(ctx)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, counts)
+	}
+}
+
+// GetAuthorStatusCounts godoc
+// @Summary Get author news count per status
+// @Description Get number of author news in every status for author profile, statuses without news have zero count
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param author_id path string true "author_id"
+// @Success 200 {object} map[string]int
+// @Router /news/authors/{author_id}/stats/status [get]
+func (h newsHandlers) GetAuthorStatusCounts() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetAuthorStatusCounts")
+		defer span.Finish()
+
+		authorID, err := utils.ParseUUIDParam(c, "author_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		counts, err := h.newsUC.GetAuthorStatusCounts(ctx, authorID)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, counts)
+	}
+}
+
+// UpdateStatusBatch godoc
+// @Summary Change status of many news
+// @Description Change status of many news at once, news not allowed to move to given status are skipped, ids of news of other tenant give 403, more ids than Postgres.MaxListParams give 400 and should be sent in chunks
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param dry_run query bool false "only return ids which would be changed" Format(dry_run)
+// @Success 200 {object} models.NewsBatchResult
+// @Failure 403 {object} httpErrors.RestError
+// @Router /news/status/batch [post]
+func (h newsHandlers) UpdateStatusBatch() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.UpdateStatusBatch")
+		defer span.Finish()
+
+		dryRun, err := parseDryRun(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		batch := &models.NewsStatusBatch{}
+		if err = utils.ReadRequest(c, batch); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		result, err := h.newsUC.UpdateStatusBatch(ctx, batch.IDs, batch.Status, dryRun)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}
+}
+
+// GetMyDrafts godoc
+// @Summary Get my drafts
+// @Description Get draft news of current user with pagination
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
+// @Success 200 {object} models.NewsList
+// @Router /news/my/drafts [get]
+func (h newsHandlers) GetMyDrafts() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetMyDrafts")
+		defer span.Finish()
+
+		pq, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		newsList, err := h.newsUC.GetMyDrafts(ctx, pq)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, newsList.InLocation(utils.GetTimezone(c)))
+	}
+}
+
+// GetEditedByMe godoc
+// @Summary Get news edited by me
+// @Description Get news current user has updated, each news once, most recently edited first
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
+// @Success 200 {object} models.NewsList
+// @Router /news/edited-by/me [get]
+func (h newsHandlers) GetEditedByMe() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetEditedByMe")
+		defer span.Finish()
+
+		pq, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		newsList, err := h.newsUC.GetEditedByMe(ctx, pq)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, newsList.InLocation(utils.GetTimezone(c)))
+	}
+}
+
+// GetOrphaned godoc
+// @Summary Get orphaned news
+// @Description Get news whose author no longer exists, admin only
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
+// @Success 200 {object} models.NewsList
+// @Router /news/orphaned [get]
+func (h newsHandlers) GetOrphaned() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetOrphaned")
+		defer span.Finish()
+
+		pq, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		newsList, err := h.newsUC.GetOrphaned(ctx, pq)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, newsList.InLocation(utils.GetTimezone(c)))
+	}
+}
+
+// GetBrokenImages godoc
+// @Summary Get news with broken image
+// @Description Get news whose image url did not resolve on last image check, admin only
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
+// @Success 200 {object} models.NewsList
+// @Router /news/broken-images [get]
+func (h newsHandlers) GetBrokenImages() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetBrokenImages")
+		defer span.Finish()
+
+		pq, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		newsList, err := h.newsUC.GetBrokenImages(ctx, pq)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, newsList.InLocation(utils.GetTimezone(c)))
+	}
+}
+
+// ReassignAuthor godoc
+// @Summary Reassign orphaned news
+// @Description Reassign orphaned news to a new author, news which still have an existing author are skipped, ids of news of other tenant give 403, more ids than Postgres.MaxListParams give 400, admin only
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param dry_run query bool false "only return ids which would be reassigned" Format(dry_run)
+// @Success 200 {object} models.NewsBatchResult
+// @Failure 403 {object} httpErrors.RestError
+// @Router /news/orphaned/reassign [post]
+func (h newsHandlers) ReassignAuthor() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.ReassignAuthor")
+		defer span.Finish()
+
+		dryRun, err := parseDryRun(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		reassign := &models.NewsReassignAuthor{}
+		if err = utils.ReadRequest(c, reassign); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		result, err := h.newsUC.ReassignAuthor(ctx, reassign.IDs, reassign.AuthorID, dryRun)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}
+}
+
+// Export godoc
+// @Summary Export news
+// @Description Export news by id as json or markdown document
+// @Tags News
+// @Accept json
+// @Produce json,text/markdown
+// @Param format query string false "export format, json or markdown" Format(format)
+// @Success 200 {object} models.NewsBase
+// @Router /news/{id}/export [get]
+func (h newsHandlers) Export() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.Export")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		format := c.QueryParam("format")
+		if format != "" && format != exportFormatJSON && format != exportFormatMarkdown {
+			err = httpErrors.NewRestError(http.StatusBadRequest, httpErrors.ErrBadQueryParams, httpErrors.BadQueryParams)
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		newsByID, err := h.newsUC.GetNewsByID(ctx, newsUUID)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		newsByID.InLocation(utils.GetTimezone(c))
+		if format != exportFormatMarkdown {
+			return c.JSON(http.StatusOK, newsByID)
+		}
+
+		md, err := newsToMarkdown(newsByID)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.Blob(http.StatusOK, mimeTextMarkdown, []byte(md))
+	}
+}
+
+// Render news title, metadata and content as markdown document
+func newsToMarkdown(n *models.NewsBase) (string, error) {
+	content, err := converter.HTMLToMarkdown(n.Content)
+	if err != nil {
+		return "", errors.Wrap(err, "newsHandlers.newsToMarkdown.HTMLToMarkdown")
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# %s\n\n", n.Title))
+	if n.Author != nil {
+		sb.WriteString(fmt.Sprintf("- Author: %s\n", *n.Author))
+	}
+	if n.Category != nil && *n.Category != "" {
+		sb.WriteString(fmt.Sprintf("- Category: %s\n", *n.Category))
+	}
+	sb.WriteString(fmt.Sprintf("- Updated: %s\n", n.UpdatedAt.Format(time.RFC3339)))
+	if n.ImageURL != nil && *n.ImageURL != "" {
+		sb.WriteString(fmt.Sprintf("\n![%s](%s)\n", n.Title, *n.ImageURL))
+	}
+	sb.WriteString("\n")
+	sb.WriteString(content)
+	sb.WriteString("\n")
+
+	return sb.String(), nil
+}
+
+// ExportAuthor godoc
+// @Summary Export author news
+// @Description Stream zip archive with every news of author as json or markdown document, only for author and admin
+// @Tags News
+// @Produce application/zip
+// @Param id path string true "author uuid"
+// @Param format query string false "export format, json or markdown" Format(format)
+// @Success 200 {file} file
+// @Failure 403 {object} httpErrors.RestError
+// @Failure 429 {object} httpErrors.RestError
+// @Router /news/author/{id}/export [get]
+func (h newsHandlers) ExportAuthor() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.ExportAuthor")
+		defer span.Finish()
+
+		authorUUID, err := utils.ParseUUIDParam(c, "user_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		format := c.QueryParam("format")
+		if format == "" {
+			format = exportFormatJSON
+		}
+		if format != exportFormatJSON && format != exportFormatMarkdown {
+			err = httpErrors.NewRestError(http.StatusBadRequest, httpErrors.ErrBadQueryParams, httpErrors.BadQueryParams)
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		select {
+		case h.exports <- struct{}{}:
+			defer func() { <-h.exports }()
+		default:
+			err = httpErrors.NewRestError(http.StatusTooManyRequests, "Too many exports in progress", nil)
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return h.writeNewsZip(c, fmt.Sprintf("news-%s.zip", authorUUID), format, func(fn func(n *models.News) error) error {
+			return h.newsUC.StreamAuthorNews(ctx, authorUUID, fn)
+		})
+	}
+}
+
+// Write news streamed by stream as zip entries, every entry is rendered and flushed to client before next row is read
+func (h newsHandlers) writeNewsZip(c echo.Context, filename string, format string, stream func(fn func(n *models.News) error) error) error {
+	loc := utils.GetTimezone(c)
+	zw := zip.NewWriter(c.Response())
+
+	started := false
+	start := func() {
+		if started {
+			return
+		}
+		started = true
+		c.Response().Header().Set(echo.HeaderContentType, mimeApplicationZip)
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+		c.Response().WriteHeader(http.StatusOK)
+	}
+
+	err := stream(func(n *models.News) error {
+		start()
+		n.InLocation(loc)
+		entry, body, err := newsZipEntry(n, format)
+		if err != nil {
+			return err
+		}
+		w, err := zw.CreateHeader(entry)
+		if err != nil {
+			return err
+		}
+		if _, err = w.Write(body); err != nil {
+			return err
+		}
+		if err = zw.Flush(); err != nil {
+			return err
+		}
+		c.Response().Flush()
+		return nil
+	})
+	if err != nil {
+		utils.LogResponseError(c, h.logger, err)
+		if !started {
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+		return nil
+	}
+
+	start()
+	return zw.Close()
+}
+
+// Render zip entry of news in export format, named by news id so names never collide
+func newsZipEntry(n *models.News, format string) (*zip.FileHeader, []byte, error) {
+	entry := &zip.FileHeader{Method: zip.Deflate, Modified: n.UpdatedAt}
+	if format == exportFormatMarkdown {
+		md, err := newsToMarkdown(&models.NewsBase{
+			NewsID:    n.NewsID,
+			AuthorID:  n.AuthorID,
+			Title:     n.Title,
+			Content:   n.Content,
+			ImageURL:  n.ImageURL,
+			Category:  n.Category,
+			Status:    n.Status,
+			UpdatedAt: n.UpdatedAt,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		entry.Name = fmt.Sprintf("%s.md", n.NewsID)
+		return entry, []byte(md), nil
+	}
+
+	body, err := json.MarshalIndent(n, "", "  ")
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "newsHandlers.newsZipEntry.MarshalIndent")
+	}
+	entry.Name = fmt.Sprintf("%s.json", n.NewsID)
+	return entry, body, nil
+}
+
+// GetFeed godoc
+// @Summary Get news feed
+// @Description Get rss or atom feed of recently published news, optionally filtered by category
+// @Tags News
+// @Produce application/rss+xml,application/atom+xml
+// @Param category query string false "news category" Format(category)
+// @Param format query string false "feed format, rss or atom" Format(format)
+// @Success 200 {string} string
+// @Router /news/feed [get]
+func (h newsHandlers) GetFeed() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetFeed")
+		defer span.Finish()
+
+		format := c.QueryParam("format")
+		if format == "" {
+			format = feed.FormatRSS
+		}
+
+		baseURL := fmt.Sprintf("%s://%s", c.Scheme(), c.Request().Host)
+		rendered, err := h.newsUC.GetFeed(ctx, c.QueryParam("category"), format, baseURL)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		contentType := feed.MIMERSS
+		if format == feed.FormatAtom {
+			contentType = feed.MIMEAtom
+		}
+		return c.Blob(http.StatusOK, contentType, rendered)
+	}
+}
+
+// GetRecentlyUpdated godoc
+// @Summary Get recently updated news
+// @Description Get summaries of published news ordered by last update with pagination
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
+// @Success 200 {object} models.NewsSummaryList
+// @Router /news/recently-updated [get]
+func (h newsHandlers) GetRecentlyUpdated() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetRecentlyUpdated")
+		defer span.Finish()
+
+		pq, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		newsList, err := h.newsUC.GetRecentlyUpdated(ctx, pq)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		summaries, err := h.newsUC.Summarize(ctx, newsList)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, summaries.InLocation(utils.GetTimezone(c)))
+	}
+}
+
+// GetWithoutComments godoc
+// @Summary Get news without comments
+// @Description Get summaries of published news nobody has commented yet, newest first, with pagination
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
+// @Success 200 {object} models.NewsSummaryList
+// @Router /news/uncommented [get]
+func (h newsHandlers) GetWithoutComments() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetWithoutComments")
+		defer span.Finish()
+
+		pq, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		newsList, err := h.newsUC.GetWithoutComments(ctx, pq)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		summaries, err := h.newsUC.Summarize(ctx, newsList)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, summaries.InLocation(utils.GetTimezone(c)))
+	}
+}
+
+// CreatePreviewToken godoc
+// @Summary Create preview token
+// @Description Create signed expiring token to share preview of news, author only
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Success 201 {object} models.NewsPreviewToken
+// @Router /news/{id}/preview-token [post]
+func (h newsHandlers) CreatePreviewToken() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.CreatePreviewToken")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		token, err := h.newsUC.CreatePreviewToken(ctx, newsUUID)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusCreated, token)
+	}
+}
+
+// GetPreview godoc
+// @Summary Preview news
+// @Description Get news by id regardless of its status with signed preview token
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Param token query string true "preview token" Format(token)
+// @Success 200 {object} models.NewsBase
+// @Failure 403 {object} httpErrors.RestError
+// @Router /news/{id}/preview [get]
+func (h newsHandlers) GetPreview() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetPreview")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		newsByID, err := h.newsUC.GetPreview(ctx, newsUUID, c.QueryParam("token"))
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, newsByID.InLocation(utils.GetTimezone(c)))
+	}
+}
+
+// SetTags godoc
+// @Summary Set news tags
+// @Description Replace news tags, tags are normalized and deduplicated, too many tags give 422
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Success 200 {object} models.NewsTags
+// @Router /news/{id}/tags [put]
+func (h newsHandlers) SetTags() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.SetTags")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		req := &models.NewsTags{}
+		if err = c.Bind(req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		tags, err := h.newsUC.SetTags(ctx, newsUUID, req.Tags)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, tags)
+	}
+}
+
+// AddTagToMany godoc
+// @Summary Add tag to many news
+// @Description Add tag to many news in one transaction, news already having the tag are not counted, ids of news of other tenant give 403, more ids than Postgres.MaxListParams give 400 and should be sent in chunks
+// @Tags News
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.NewsTagBatchResult
+// @Failure 400 {object} httpErrors.RestError
+// @Failure 403 {object} httpErrors.RestError
+// @Router /news/tags/bulk [post]
+func (h newsHandlers) AddTagToMany() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.AddTagToMany")
+		defer span.Finish()
+
+		batch := &models.NewsTagBatch{}
+		if err := utils.ReadRequest(c, batch); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		result, err := h.newsUC.AddTagToMany(ctx, batch.IDs, batch.Tag)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}
+}
+
+// SetTranslation godoc
+// @Summary Set news translation
+// @Description Create or replace title and content of news in locale, only author of news may translate it
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Param locale path string true "BCP 47 locale like pt-BR"
+// @Success 200 {object} models.NewsTranslation
+// @Failure 400 {object} httpErrors.RestError
+// @Failure 403 {object} httpErrors.RestError
+// @Router /news/{id}/translations/{locale} [put]
+func (h newsHandlers) SetTranslation() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.SetTranslation")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		req := &models.NewsTranslation{}
+		if err = c.Bind(req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		translation, err := h.newsUC.SetTranslation(ctx, newsUUID, c.Param("locale"), req)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, translation)
+	}
+}
+
+// GetTranslation godoc
+// @Summary Get news translation
+// @Description Get title and content of news in locale, 404 when news has no translation in locale
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Param locale path string true "BCP 47 locale like pt-BR"
+// @Success 200 {object} models.NewsTranslation
+// @Failure 400 {object} httpErrors.RestError
+// @Failure 404 {object} httpErrors.RestError
+// @Router /news/{id}/translations/{locale} [get]
+func (h newsHandlers) GetTranslation() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetTranslation")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		translation, err := h.newsUC.GetTranslation(ctx, newsUUID, c.Param("locale"))
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, translation)
+	}
+}
+
+// SaveReadingPosition godoc
+// @Summary Save reading position
+// @Description Save where current user left off reading news, position is read fraction of content from 0 to 1
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Success 200 {object} models.ReadingPosition
+// @Failure 400 {object} httpErrors.RestError
+// @Router /news/{id}/reading-position [put]
+func (h newsHandlers) SaveReadingPosition() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.SaveReadingPosition")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		req := &models.ReadingPosition{}
+		if err = c.Bind(req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		position, err := h.newsUC.SaveReadingPosition(ctx, newsUUID, req.Position)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, position)
+	}
+}
+
+// GetReadingPosition godoc
+// @Summary Get reading position
+// @Description Get where current user left off reading news, 404 when user has not read it
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Success 200 {object} models.ReadingPosition
+// @Failure 404 {object} httpErrors.RestError
+// @Router /news/{id}/reading-position [get]
+func (h newsHandlers) GetReadingPosition() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetReadingPosition")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		position, err := h.newsUC.GetReadingPosition(ctx, newsUUID)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, position)
+	}
+}
+
+// SetMetadata godoc
+// @Summary Set news metadata
+// @Description Replace news metadata with JSON object, null clears it
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Success 200 {object} models.NewsMetadata
+// @Router /news/{id}/metadata [put]
+func (h newsHandlers) SetMetadata() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.SetMetadata")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		req := &models.NewsMetadata{}
+		if err = c.Bind(req); err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		metadata, err := h.newsUC.SetMetadata(ctx, newsUUID, req.Metadata)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, metadata)
+	}
+}
+
+// GetMetadata godoc
+// @Summary Get news metadata
+// @Description Get news metadata, null when not set
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Success 200 {object} models.NewsMetadata
+// @Router /news/{id}/metadata [get]
+func (h newsHandlers) GetMetadata() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetMetadata")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		metadata, err := h.newsUC.GetMetadata(ctx, newsUUID)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, metadata)
+	}
+}
+
+// GetCacheEntry godoc
+// @Summary Get cached news entry
+// @Description Get raw cached news JSON with remaining ttl in seconds, hit is false on miss. Admin only, database is not queried
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Success 200 {object} models.NewsCacheEntry
+// @Router /news/{id}/cache [get]
+func (h newsHandlers) GetCacheEntry() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetCacheEntry")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		entry, err := h.newsUC.GetCacheEntry(ctx, newsUUID)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, entry)
+	}
+}
+
+// GetTags godoc
+// @Summary Get news tags
+// @Description Get news tags ordered by name
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "news_id"
+// @Success 200 {object} models.NewsTags
+// @Router /news/{id}/tags [get]
+func (h newsHandlers) GetTags() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetTags")
+		defer span.Finish()
+
+		newsUUID, err := utils.ParseUUIDParam(c, "news_id")
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		tags, err := h.newsUC.GetTags(ctx, newsUUID)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, tags)
+	}
+}
+
+// GetChangedSince godoc
+// @Summary Get news changes
+// @Description Get news changed after since ordered by update time for delta sync, deleted and unpublished news come as tombstones
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param since query string true "RFC3339 time of previous sync"
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
+// @Success 200 {object} models.NewsChangesList
+// @Router /news/changes [get]
+func (h newsHandlers) GetChangedSince() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetChangedSince")
+		defer span.Finish()
+
+		since, err := time.Parse(time.RFC3339, c.QueryParam("since"))
+		if err != nil {
+			err = httpErrors.NewRestError(http.StatusBadRequest, httpErrors.ErrBadQueryParams, httpErrors.BadQueryParams)
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		pq, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		changes, err := h.newsUC.GetChangedSince(ctx, since, pq)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, changes.InLocation(utils.GetTimezone(c)))
+	}
+}
+
+// GetActivityFeed godoc
+// @Summary Get activity feed
+// @Description Get recent create, update and delete events of published news with actor, newest first
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param action query string false "create, update or delete, every action when empty" Format(action)
+// @Param page query int false "page number" Format(page)
+// @Param size query int false "number of elements per page" Format(size)
+// @Success 200 {object} models.NewsEventList
+// @Router /news/activity [get]
+func (h newsHandlers) GetActivityFeed() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.GetActivityFeed")
+		defer span.Finish()
+
+		pq, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		feed, err := h.newsUC.GetActivityFeed(ctx, c.QueryParam("action"), pq)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, feed.InLocation(utils.GetTimezone(c)))
+	}
+}
+
+// PurgeDeleted godoc
+// @Summary Purge deleted news
+// @Description Physically delete news soft deleted more than older_than ago, admin only
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param older_than query string true "age of deletion, days as 30d or go duration"
+// @Success 200 {object} models.NewsPurgeResult
+// @Router /news/deleted [delete]
+func (h newsHandlers) PurgeDeleted() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.PurgeDeleted")
+		defer span.Finish()
+
+		olderThan, err := parseWindow(c.QueryParam("older_than"))
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		purged, err := h.newsUC.PurgeDeleted(ctx, olderThan)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, &models.NewsPurgeResult{Purged: purged})
+	}
+}
+
+// RecomputeCommentCounts godoc
+// @Summary Recompute comment counts
+// @Description Fix stored comment counts which drifted from comments table, admin only
+// @Tags News
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.NewsCommentCountResult
+// @Router /news/comment-counts/recompute [post]
+func (h newsHandlers) RecomputeCommentCounts() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		span, ctx := opentracing.StartSpanFromContext(utils.GetRequestCtx(c), "newsHandlers.RecomputeCommentCounts")
+		defer span.Finish()
+
+		corrected, err := h.newsUC.RecomputeCommentCounts(ctx)
+		if err != nil {
+			utils.LogResponseError(c, h.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		return c.JSON(http.StatusOK, &models.NewsCommentCountResult{Corrected: corrected})
+	}
+}
+
+// Parse dry_run query param of bulk operations, false by default
+func parseDryRun(c echo.Context) (bool, error) {
+	return parseBoolQuery(c, "dry_run")
+}
+
+// Parse boolean query param, false when not given
+func parseBoolQuery(c echo.Context, name string) (bool, error) {
+	query := c.QueryParam(name)
+	if query == "" {
+		return false, nil
+	}
+	value, err := strconv.ParseBool(query)
+	if err != nil {
+		return false, httpErrors.NewRestError(http.StatusBadRequest, httpErrors.ErrBadQueryParams, httpErrors.BadQueryParams)
+	}
+	return value, nil
+}
+
+// Status of create response with existing news of same content, 409 unless configured to 200
+// Respond with existing news of duplicate, or with its id only when caller may not see it
+func (h newsHandlers) duplicateContent(c echo.Context, duplicate *models.DuplicateNewsError) error {
+	if duplicate.Existing == nil {
+		return c.JSON(h.duplicateContentStatus(), models.NewsRef{NewsID: duplicate.ExistingID})
+	}
+	return c.JSON(h.duplicateContentStatus(), duplicate.Existing.InLocation(utils.GetTimezone(c)))
+}
+
+func (h newsHandlers) duplicateContentStatus() int {
+	if h.cfg.News.DuplicateContentStatus == http.StatusOK {
+		return http.StatusOK
+	}
+	return http.StatusConflict
+}
+
+// Parse requested content range from offset and length params or from Range header in chars unit,
+// length is -1 when range is open ended. Range headers in other units are ignored
+func parseContentRange(c echo.Context) (offset int, length int, partial bool, err error) {
+	badRange := httpErrors.NewRestError(http.StatusBadRequest, httpErrors.ErrBadQueryParams, httpErrors.BadQueryParams)
+	offsetQuery, lengthQuery := c.QueryParam("offset"), c.QueryParam("length")
+	if offsetQuery != "" || lengthQuery != "" {
+		length = -1
+		if offsetQuery != "" {
+			if offset, err = strconv.Atoi(offsetQuery); err != nil || offset < 0 {
+				return 0, 0, false, badRange
+			}
+		}
+		if lengthQuery != "" {
+			if length, err = strconv.Atoi(lengthQuery); err != nil || length <= 0 {
+				return 0, 0, false, badRange
+			}
+		}
+		return offset, length, true, nil
+	}
+
+	spec := c.Request().Header.Get(headerRange)
+	if !strings.HasPrefix(spec, contentRangeUnit+"=") {
+		return 0, 0, false, nil
+	}
+	bounds := strings.SplitN(strings.TrimPrefix(spec, contentRangeUnit+"="), "-", 2)
+	if len(bounds) != 2 {
+		return 0, 0, false, badRange
+	}
+	if offset, err = strconv.Atoi(strings.TrimSpace(bounds[0])); err != nil || offset < 0 {
+		return 0, 0, false, badRange
+	}
+	if strings.TrimSpace(bounds[1]) == "" {
+		return offset, -1, true, nil
+	}
+	last, err := strconv.Atoi(strings.TrimSpace(bounds[1]))
+	if err != nil || last < offset {
+		return 0, 0, false, badRange
+	}
+	if length = last - offset + 1; length <= 0 {
+		// Range up to max int overflows, it covers the rest of text anyway
+		length = -1
+	}
+	return offset, length, true, nil
+}
+
+// Parse leaderboard window, days are given as "7d", anything else as go duration, empty window is zero
+func parseWindow(windowQuery string) (time.Duration, error) {
+	if windowQuery == "" {
+		return 0, nil
+	}
+	if strings.HasSuffix(windowQuery, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(windowQuery, "d"))
+		if err == nil {
+			return time.Duration(days) * 24 * time.Hour, nil
+		}
+	}
+	window, err := time.ParseDuration(windowQuery)
+	if err != nil {
+		return 0, httpErrors.NewRestError(http.StatusBadRequest, httpErrors.ErrBadQueryParams, httpErrors.BadQueryParams)
+	}
+	return window, nil
+}
+
+// Check is csv response requested by Accept header
+func acceptsCSV(c echo.Context) bool {
+	return strings.Contains(c.Request().Header.Get(echo.HeaderAccept), mimeTextCSV)
+}
+
+// Write news streamed by stream as csv, rows are flushed to client in batches so memory stays bounded
+func (h newsHandlers) writeNewsCSV(c echo.Context, stream func(fn func(n *models.News) error) error) error {
+	loc := utils.GetTimezone(c)
+	w := csv.NewWriter(c.Response())
+
+	started := false
+	start := func() error {
+		if started {
+			return nil
+		}
+		started = true
+		c.Response().Header().Set(echo.HeaderContentType, mimeTextCSV+"; charset=UTF-8")
+		c.Response().WriteHeader(http.StatusOK)
+		return w.Write(newsCSVHeader)
+	}
+
+	rows := 0
+	err := stream(func(n *models.News) error {
+		if err := start(); err != nil {
+			return err
+		}
+		if err := w.Write(newsCSVRecord(n.InLocation(loc))); err != nil {
+			return err
+		}
+		rows++
+		if rows%csvFlushRows == 0 {
+			w.Flush()
+			c.Response().Flush()
+		}
+		return w.Error()
+	})
+	if err == nil {
+		err = start()
+	}
+	if err != nil {
+		utils.LogResponseError(c, h.logger, err)
+		if !started {
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+		return nil
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+func newsCSVRecord(n *models.News) []string {
+	var imageURL, category string
+	if n.ImageURL != nil {
+		imageURL = *n.ImageURL
+	}
+	if n.Category != nil {
+		category = *n.Category
+	}
+	return []string{
+		n.NewsID.String(),
+		n.AuthorID.String(),
+		n.Title,
+		n.Content,
+		imageURL,
+		category,
+		n.Status,
+		n.CreatedAt.Format(time.RFC3339),
+		n.UpdatedAt.Format(time.RFC3339),
 	}
 }
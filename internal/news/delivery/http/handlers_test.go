@@ -1,20 +1,34 @@
 package http
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis/v8"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/opentracing/opentracing-go"
 	"github.com/stretchr/testify/require"
 
+	"github.com/AleksK1NG/api-mc/config"
 	"github.com/AleksK1NG/api-mc/internal/models"
 	"github.com/AleksK1NG/api-mc/internal/news/mock"
+	newsRepository "github.com/AleksK1NG/api-mc/internal/news/repository"
+	newsUseCase "github.com/AleksK1NG/api-mc/internal/news/usecase"
 	"github.com/AleksK1NG/api-mc/pkg/converter"
 	"github.com/AleksK1NG/api-mc/pkg/logger"
 	"github.com/AleksK1NG/api-mc/pkg/utils"
@@ -71,6 +85,142 @@ func TestNewsHandlers_Create(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestNewsHandlers_Create_MalformedUUID(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockNewsUC := mock.NewMockUseCase(ctrl)
+	newsHandlers := NewNewsHandlers(cfg, mockNewsUC, apiLogger)
+
+	handlerFunc := newsHandlers.Create()
+
+	body := `{"author_id": "not-a-uuid", "title": "TestNewsHandlers_Create title", "content": "TestNewsHandlers_Create title content some text content"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/news/create", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	res := httptest.NewRecorder()
+	e := echo.New()
+	ctx := e.NewContext(req, res)
+
+	err := handlerFunc(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func TestNewsHandlers_Create_IfNotExists(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockNewsUC := mock.NewMockUseCase(ctrl)
+	newsHandlers := NewNewsHandlers(cfg, mockNewsUC, apiLogger)
+
+	handlerFunc := newsHandlers.Create()
+
+	slug := "sync-job-title"
+	news := &models.News{
+		Title:   "Sync job title",
+		Content: "Sync job content some text content",
+		Slug:    &slug,
+	}
+
+	createRequest := func(t *testing.T) (echo.Context, *httptest.ResponseRecorder) {
+		buf, err := converter.AnyToBytesBuffer(news)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/news?if_not_exists=true", strings.NewReader(buf.String()))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		return echo.New().NewContext(req, res), res
+	}
+
+	t.Run("Created", func(t *testing.T) {
+		ctx, res := createRequest(t)
+		mockNewsUC.EXPECT().CreateIfNotExists(gomock.Any(), gomock.Any()).Return(&models.News{NewsID: uuid.New(), Slug: &slug}, true, nil)
+
+		require.NoError(t, handlerFunc(ctx))
+		require.Equal(t, http.StatusCreated, res.Code)
+	})
+
+	t.Run("Already exists", func(t *testing.T) {
+		ctx, res := createRequest(t)
+		existingID := uuid.New()
+		mockNewsUC.EXPECT().CreateIfNotExists(gomock.Any(), gomock.Any()).Return(&models.News{NewsID: existingID, Slug: &slug}, false, nil)
+
+		require.NoError(t, handlerFunc(ctx))
+		require.Equal(t, http.StatusOK, res.Code)
+
+		var got models.News
+		require.NoError(t, json.Unmarshal(res.Body.Bytes(), &got))
+		require.Equal(t, existingID, got.NewsID)
+	})
+
+	t.Run("Invalid param", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/news?if_not_exists=maybe", strings.NewReader("{}"))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+
+		require.NoError(t, handlerFunc(echo.New().NewContext(req, res)))
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func TestNewsHandlers_Create_DuplicateContent(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsUC := mock.NewMockUseCase(ctrl)
+
+	existing := &models.News{NewsID: uuid.New(), Title: "Existing title", Content: "Existing content long enough"}
+	body, err := converter.AnyToBytesBuffer(&models.News{Title: "Title long text string", Content: existing.Content})
+	require.NoError(t, err)
+
+	create := func(cfg *config.Config) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/news/create", strings.NewReader(body.String()))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		mockNewsUC.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, &models.DuplicateNewsError{ExistingID: existing.NewsID, Existing: existing})
+		require.NoError(t, NewNewsHandlers(cfg, mockNewsUC, apiLogger).Create()(echo.New().NewContext(req, res)))
+		return res
+	}
+
+	t.Run("Conflict by default", func(t *testing.T) {
+		res := create(&config.Config{})
+		require.Equal(t, http.StatusConflict, res.Code)
+
+		var n models.News
+		require.NoError(t, json.Unmarshal(res.Body.Bytes(), &n))
+		require.Equal(t, existing.NewsID, n.NewsID)
+	})
+
+	t.Run("Configured 200", func(t *testing.T) {
+		res := create(&config.Config{News: config.NewsConfig{DuplicateContentStatus: http.StatusOK}})
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Hidden existing news", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/news/create", strings.NewReader(body.String()))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		mockNewsUC.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, &models.DuplicateNewsError{ExistingID: existing.NewsID})
+
+		require.NoError(t, NewNewsHandlers(&config.Config{}, mockNewsUC, apiLogger).Create()(echo.New().NewContext(req, res)))
+		require.Equal(t, http.StatusConflict, res.Code)
+		require.JSONEq(t, `{"news_id":"`+existing.NewsID.String()+`"}`, res.Body.String())
+	})
+}
+
 func TestNewsHandlers_Update(t *testing.T) {
 	t.Parallel()
 
@@ -124,6 +274,34 @@ func TestNewsHandlers_Update(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestNewsHandlers_Update_NewsIDMismatch(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockNewsUC := mock.NewMockUseCase(ctrl)
+	newsHandlers := NewNewsHandlers(cfg, mockNewsUC, apiLogger)
+
+	newsID := uuid.New()
+	body := fmt.Sprintf(`{"news_id": "%s", "title": "Updated news title"}`, uuid.New())
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/news/"+newsID.String(), strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	res := httptest.NewRecorder()
+	ctx := echo.New().NewContext(req, res)
+	ctx.SetParamNames("news_id")
+	ctx.SetParamValues(newsID.String())
+
+	mockNewsUC.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+
+	require.NoError(t, newsHandlers.Update()(ctx))
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.Contains(t, res.Body.String(), "news_id")
+}
+
 func TestNewsHandlers_GetByID(t *testing.T) {
 	t.Parallel()
 
@@ -132,7 +310,7 @@ func TestNewsHandlers_GetByID(t *testing.T) {
 
 	apiLogger := logger.NewApiLogger(nil)
 	mockNewsUC := mock.NewMockUseCase(ctrl)
-	newsHandlers := NewNewsHandlers(nil, mockNewsUC, apiLogger)
+	newsHandlers := NewNewsHandlers(&config.Config{}, mockNewsUC, apiLogger)
 
 	handlerFunc := newsHandlers.GetByID()
 
@@ -166,3 +344,665 @@ func TestNewsHandlers_GetByID(t *testing.T) {
 	err := handlerFunc(ctx)
 	require.NoError(t, err)
 }
+
+func TestNewsHandlers_GetByID_Locale(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNewsUC := mock.NewMockUseCase(ctrl)
+	newsHandlers := NewNewsHandlers(&config.Config{}, mockNewsUC, logger.NewApiLogger(nil))
+	newsID := uuid.New()
+
+	request := func(target string, acceptLanguage string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		if acceptLanguage != "" {
+			req.Header.Set(headerAcceptLanguage, acceptLanguage)
+		}
+		res := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, res)
+		ctx.SetParamNames("news_id")
+		ctx.SetParamValues(newsID.String())
+		require.NoError(t, newsHandlers.GetByID()(ctx))
+		return res
+	}
+
+	t.Run("Accept-Language", func(t *testing.T) {
+		mockNewsUC.EXPECT().GetNewsByIDInLocale(gomock.Any(), newsID, "de-AT").
+			Return(&models.NewsBase{NewsID: newsID, Title: "Titel", Locale: "de-AT"}, nil)
+
+		res := request("/api/v1/news/"+newsID.String(), "en;q=0.5, de-AT")
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Equal(t, "de-AT", res.Header().Get(headerContentLang))
+		require.Contains(t, res.Header().Values(echo.HeaderVary), headerAcceptLanguage)
+		require.Contains(t, res.Body.String(), `"locale":"de-AT"`)
+	})
+
+	t.Run("Query param takes precedence", func(t *testing.T) {
+		mockNewsUC.EXPECT().GetNewsByIDInLocale(gomock.Any(), newsID, "fr").
+			Return(&models.NewsBase{NewsID: newsID, Locale: "en"}, nil)
+
+		res := request("/api/v1/news/"+newsID.String()+"?locale=fr", "de")
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Equal(t, "en", res.Header().Get(headerContentLang))
+	})
+
+	t.Run("No locale requested", func(t *testing.T) {
+		mockNewsUC.EXPECT().GetNewsByID(gomock.Any(), newsID).Return(&models.NewsBase{NewsID: newsID}, nil)
+
+		res := request("/api/v1/news/"+newsID.String(), "")
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Empty(t, res.Header().Get(headerContentLang))
+	})
+}
+
+func TestNewsHandlers_GetByID_ContentRange(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockNewsUC := mock.NewMockUseCase(ctrl)
+	newsHandlers := NewNewsHandlers(cfg, mockNewsUC, apiLogger)
+
+	newsID := uuid.New()
+	content := "0123456789abcdefghij"
+
+	get := func(target string, rangeHeader string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		if rangeHeader != "" {
+			req.Header.Set(headerRange, rangeHeader)
+		}
+		res := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, res)
+		ctx.SetParamNames("news_id")
+		ctx.SetParamValues(newsID.String())
+		require.NoError(t, newsHandlers.GetByID()(ctx))
+		return res
+	}
+	expectNews := func() {
+		mockNewsUC.EXPECT().GetNewsByID(gomock.Any(), newsID).Return(&models.NewsBase{NewsID: newsID, Content: content}, nil)
+	}
+	readContent := func(res *httptest.ResponseRecorder) string {
+		var n models.NewsBase
+		require.NoError(t, json.Unmarshal(res.Body.Bytes(), &n))
+		return n.Content
+	}
+
+	t.Run("Range header", func(t *testing.T) {
+		expectNews()
+		res := get("/api/v1/news/"+newsID.String(), "chars=5-9")
+		require.Equal(t, http.StatusPartialContent, res.Code)
+		require.Equal(t, "chars 5-9/20", res.Header().Get(headerContentRange))
+		require.Equal(t, "56789", readContent(res))
+	})
+
+	t.Run("Offset and length", func(t *testing.T) {
+		expectNews()
+		res := get("/api/v1/news/"+newsID.String()+"?offset=10&length=3", "")
+		require.Equal(t, http.StatusPartialContent, res.Code)
+		require.Equal(t, "chars 10-12/20", res.Header().Get(headerContentRange))
+		require.Equal(t, "abc", readContent(res))
+	})
+
+	t.Run("Clamped range", func(t *testing.T) {
+		expectNews()
+		res := get("/api/v1/news/"+newsID.String()+"?offset=15&length=100", "")
+		require.Equal(t, http.StatusPartialContent, res.Code)
+		require.Equal(t, "chars 15-19/20", res.Header().Get(headerContentRange))
+		require.Equal(t, "fghij", readContent(res))
+	})
+
+	t.Run("Max int length", func(t *testing.T) {
+		expectNews()
+		res := get("/api/v1/news/"+newsID.String()+"?offset=1&length=9223372036854775807", "")
+		require.Equal(t, http.StatusPartialContent, res.Code)
+		require.Equal(t, "chars 1-19/20", res.Header().Get(headerContentRange))
+
+		expectNews()
+		res = get("/api/v1/news/"+newsID.String(), "chars=0-9223372036854775807")
+		require.Equal(t, http.StatusPartialContent, res.Code)
+		require.Equal(t, "chars 0-19/20", res.Header().Get(headerContentRange))
+	})
+
+	t.Run("Invalid range", func(t *testing.T) {
+		res := get("/api/v1/news/"+newsID.String()+"?offset=-1", "")
+		require.Equal(t, http.StatusBadRequest, res.Code)
+
+		res = get("/api/v1/news/"+newsID.String(), "chars=9-5")
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("Offset past end", func(t *testing.T) {
+		expectNews()
+		res := get("/api/v1/news/"+newsID.String()+"?offset=20", "")
+		require.Equal(t, http.StatusRequestedRangeNotSatisfiable, res.Code)
+		require.Equal(t, "chars */20", res.Header().Get(headerContentRange))
+	})
+
+	t.Run("Other range unit is ignored", func(t *testing.T) {
+		expectNews()
+		res := get("/api/v1/news/"+newsID.String(), "bytes=0-5")
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Equal(t, content, readContent(res))
+	})
+}
+
+func TestNewsHandlers_GetByID_InvalidUUID(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		Logger: config.Logger{
+			Development: true,
+		},
+	}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockNewsUC := mock.NewMockUseCase(ctrl)
+	newsHandlers := NewNewsHandlers(cfg, mockNewsUC, apiLogger)
+
+	handlerFunc := newsHandlers.GetByID()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/news/not-a-uuid", nil)
+	res := httptest.NewRecorder()
+	e := echo.New()
+	ctx := e.NewContext(req, res)
+	ctx.SetParamNames("news_id")
+	ctx.SetParamValues("not-a-uuid")
+
+	err := handlerFunc(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.Contains(t, res.Body.String(), "news_id")
+}
+
+func TestNewsHandlers_Export(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		Logger: config.Logger{
+			Development: true,
+		},
+	}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockNewsUC := mock.NewMockUseCase(ctrl)
+	newsHandlers := NewNewsHandlers(cfg, mockNewsUC, apiLogger)
+
+	handlerFunc := newsHandlers.Export()
+
+	newsUID := uuid.New()
+	category := "golang"
+	author := "Alex Bryksin"
+	newsBase := &models.NewsBase{
+		NewsID:    newsUID,
+		Title:     "Export news title",
+		Content:   "<p>First <strong>bold</strong> paragraph</p><ul><li>one</li><li>two</li></ul><p>See <a href=\"https://example.com\">link</a></p>",
+		Category:  &category,
+		Author:    &author,
+		UpdatedAt: time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC),
+	}
+
+	newExportCtx := func(format string) (echo.Context, *httptest.ResponseRecorder) {
+		target := "/api/v1/news/" + newsUID.String() + "/export"
+		if format != "" {
+			target += "?format=" + format
+		}
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		res := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, res)
+		ctx.SetParamNames("news_id")
+		ctx.SetParamValues(newsUID.String())
+		return ctx, res
+	}
+
+	t.Run("Markdown", func(t *testing.T) {
+		ctx, res := newExportCtx("markdown")
+		mockNewsUC.EXPECT().GetNewsByID(gomock.Any(), gomock.Eq(newsUID)).Return(newsBase, nil)
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Contains(t, res.Header().Get(echo.HeaderContentType), "text/markdown")
+
+		expected := "# Export news title\n\n" +
+			"- Author: Alex Bryksin\n" +
+			"- Category: golang\n" +
+			"- Updated: 2021-02-03T04:05:06Z\n\n" +
+			"First **bold** paragraph\n\n" +
+			"- one\n" +
+			"- two\n\n" +
+			"See [link](https://example.com)\n"
+		require.Equal(t, expected, res.Body.String())
+	})
+
+	t.Run("JSON by default", func(t *testing.T) {
+		ctx, res := newExportCtx("")
+		mockNewsUC.EXPECT().GetNewsByID(gomock.Any(), gomock.Eq(newsUID)).Return(newsBase, nil)
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Contains(t, res.Header().Get(echo.HeaderContentType), echo.MIMEApplicationJSON)
+		require.Contains(t, res.Body.String(), newsUID.String())
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctx, res := newExportCtx("markdown")
+		mockNewsUC.EXPECT().GetNewsByID(gomock.Any(), gomock.Eq(newsUID)).Return(nil, sql.ErrNoRows)
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("Unknown format", func(t *testing.T) {
+		ctx, res := newExportCtx("pdf")
+
+		err := handlerFunc(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func TestNewsHandlers_GetNews_CSV(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsUC := mock.NewMockUseCase(ctrl)
+	newsHandlers := NewNewsHandlers(nil, mockNewsUC, apiLogger)
+
+	createdAt := time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)
+	category := "go, news"
+	rows := []*models.News{
+		{NewsID: uuid.New(), Title: "Commas, in title", Content: "Line one\nline two", Category: &category, CreatedAt: createdAt, UpdatedAt: createdAt},
+		{NewsID: uuid.New(), Title: `Say "hello"`, Content: "plain", CreatedAt: createdAt, UpdatedAt: createdAt},
+	}
+	streamRows := func(fn func(n *models.News) error) error {
+		for _, n := range rows {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	t.Run("Quoting", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/news?page=1&size=10", nil)
+		req.Header.Set(echo.HeaderAccept, "text/csv")
+		res := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, res)
+
+		mockNewsUC.EXPECT().StreamNews(gomock.Any(), &utils.PaginationQuery{Page: 1, Size: 10}, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ *utils.PaginationQuery, _ url.Values, fn func(n *models.News) error) error {
+				return streamRows(fn)
+			})
+
+		err := newsHandlers.GetNews()(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Contains(t, res.Header().Get(echo.HeaderContentType), "text/csv")
+
+		body := res.Body.String()
+		require.Contains(t, body, `"Commas, in title","Line one`+"\n"+`line two",,"go, news"`)
+		require.Contains(t, body, `"Say ""hello"""`)
+
+		records, err := csv.NewReader(strings.NewReader(body)).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		require.Equal(t, "news_id", records[0][0])
+		require.Equal(t, "Commas, in title", records[1][2])
+		require.Equal(t, "Line one\nline two", records[1][3])
+		require.Equal(t, "go, news", records[1][5])
+		require.Equal(t, `Say "hello"`, records[2][2])
+		require.Equal(t, "2021-02-03T04:05:06Z", records[2][7])
+	})
+
+	t.Run("Search filter respected", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/news/search?title=hello&scope=all&page=2&size=5", nil)
+		req.Header.Set(echo.HeaderAccept, "text/csv")
+		res := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, res)
+
+		mockNewsUC.EXPECT().StreamSearchByTitle(gomock.Any(), "hello", "all", &utils.PaginationQuery{Page: 2, Size: 5}, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ string, _ *utils.PaginationQuery, fn func(n *models.News) error) error {
+				return fn(rows[1])
+			})
+
+		err := newsHandlers.SearchByTitle()(ctx)
+		require.NoError(t, err)
+
+		records, err := csv.NewReader(strings.NewReader(res.Body.String())).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		require.Equal(t, `Say "hello"`, records[1][2])
+	})
+
+	t.Run("Empty list has header", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/news", nil)
+		req.Header.Set(echo.HeaderAccept, "text/csv")
+		res := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, res)
+
+		mockNewsUC.EXPECT().StreamNews(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		err := newsHandlers.GetNews()(ctx)
+		require.NoError(t, err)
+		require.Equal(t, strings.Join(newsCSVHeader, ",")+"\n", res.Body.String())
+	})
+}
+
+func TestNewsHandlers_GetNews_Empty(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsUC := mock.NewMockUseCase(ctrl)
+	newsHandlers := NewNewsHandlers(nil, mockNewsUC, apiLogger)
+
+	t.Run("Empty filter result", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/news?page=1&size=10&category=unknown", nil)
+		res := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, res)
+
+		newsList := &models.NewsList{Page: 1, Size: 10}
+		mockNewsUC.EXPECT().GetNews(gomock.Any(), &utils.PaginationQuery{Page: 1, Size: 10}, gomock.Any()).Return(newsList, nil)
+		mockNewsUC.EXPECT().Summarize(gomock.Any(), newsList).Return(&models.NewsSummaryList{Page: 1, Size: 10}, nil)
+
+		err := newsHandlers.GetNews()(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.Code)
+		require.JSONEq(t, `{"total_count":0,"total_pages":0,"page":1,"size":10,"has_more":false,"news":[]}`, res.Body.String())
+	})
+}
+
+func TestNewsHandlers_GetNews_Summaries(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	mockAuthorRepo := mock.NewMockAuthorRepository(ctrl)
+	newsUC := newsUseCase.NewNewsUseCase(&config.Config{News: config.NewsConfig{ExcerptLength: 20}}, mockNewsRepo, mockRedisRepo, mockAuthorRepo, apiLogger)
+	newsHandlers := NewNewsHandlers(nil, newsUC, apiLogger)
+
+	authorID := uuid.New()
+	newsList := &models.NewsList{TotalCount: 1, TotalPages: 1, Page: 1, Size: 10, News: []*models.News{{
+		NewsID:   uuid.New(),
+		AuthorID: authorID,
+		Title:    "Summary title",
+		Content:  "<p>Full body of the news that should not be sent in feeds</p>",
+	}}}
+	mockNewsRepo.EXPECT().GetNews(gomock.Any(), gomock.Any(), gomock.Any()).Return(newsList, nil)
+	mockAuthorRepo.EXPECT().GetByIDs(gomock.Any(), []uuid.UUID{authorID}).
+		Return([]*models.User{{UserID: authorID, FirstName: "Jane", LastName: "Doe"}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/news?page=1&size=10", nil)
+	res := httptest.NewRecorder()
+	ctx := echo.New().NewContext(req, res)
+
+	require.NoError(t, newsHandlers.GetNews()(ctx))
+	require.Equal(t, http.StatusOK, res.Code)
+
+	body := res.Body.String()
+	require.NotContains(t, body, `"content"`)
+	require.NotContains(t, body, "should not be sent")
+	require.Contains(t, body, `"excerpt":"Full body of the…"`)
+	require.Contains(t, body, `"author":"Jane Doe"`)
+	require.Contains(t, body, `"total_count":1`)
+}
+
+func TestNewsHandlers_GetByID_CacheStatusHeader(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	cfg := &config.Config{Server: config.ServerConfig{CacheStatusHeader: true}}
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	redisRepo := newsRepository.NewNewsRedisRepo(redis.NewClient(&redis.Options{Addr: mr.Addr()}), cfg)
+	newsUC := newsUseCase.NewNewsUseCase(cfg, mockNewsRepo, redisRepo, nil, apiLogger)
+	newsHandlers := NewNewsHandlers(cfg, newsUC, apiLogger)
+
+	getByID := func(newsID uuid.UUID) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/news/"+newsID.String(), nil)
+		res := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, res)
+		ctx.SetParamNames("news_id")
+		ctx.SetParamValues(newsID.String())
+
+		require.NoError(t, newsHandlers.GetByID()(ctx))
+		require.Equal(t, http.StatusOK, res.Code)
+		return res
+	}
+
+	t.Run("Cold miss", func(t *testing.T) {
+		newsID := uuid.New()
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), newsID).Return(&models.NewsBase{NewsID: newsID, Status: models.NewsStatusPublished}, nil)
+
+		res := getByID(newsID)
+		require.Equal(t, "MISS", res.Header().Get("X-Cache"))
+	})
+
+	t.Run("Seeded cache hit", func(t *testing.T) {
+		newsID := uuid.New()
+		err := redisRepo.SetNewsCtx(context.Background(), "api-news:: "+newsID.String(), 60, &models.NewsBase{NewsID: newsID, Status: models.NewsStatusPublished})
+		require.NoError(t, err)
+
+		res := getByID(newsID)
+		require.Equal(t, "HIT", res.Header().Get("X-Cache"))
+	})
+
+	t.Run("Off by default", func(t *testing.T) {
+		newsID := uuid.New()
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), newsID).Return(&models.NewsBase{NewsID: newsID, Status: models.NewsStatusPublished}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/news/"+newsID.String(), nil)
+		res := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, res)
+		ctx.SetParamNames("news_id")
+		ctx.SetParamValues(newsID.String())
+
+		handlers := NewNewsHandlers(&config.Config{}, newsUC, apiLogger)
+		require.NoError(t, handlers.GetByID()(ctx))
+		require.Empty(t, res.Header().Get("X-Cache"))
+	})
+}
+
+func TestParseWindow(t *testing.T) {
+	t.Parallel()
+
+	window, err := parseWindow("7d")
+	require.NoError(t, err)
+	require.Equal(t, 7*24*time.Hour, window)
+
+	window, err = parseWindow("72h")
+	require.NoError(t, err)
+	require.Equal(t, 72*time.Hour, window)
+
+	window, err = parseWindow("")
+	require.NoError(t, err)
+	require.Zero(t, window)
+
+	_, err = parseWindow("week")
+	require.Error(t, err)
+}
+
+func TestNewsHandlers_GetChangedSince(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockNewsUC := mock.NewMockUseCase(ctrl)
+	newsHandlers := NewNewsHandlers(cfg, mockNewsUC, apiLogger)
+
+	handlerFunc := newsHandlers.GetChangedSince()
+
+	t.Run("Changes", func(t *testing.T) {
+		since := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/news/changes?since=2021-03-01T00:00:00Z", nil)
+		res := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, res)
+
+		deletedUID := uuid.New()
+		mockNewsUC.EXPECT().GetChangedSince(gomock.Any(), since, gomock.Any()).Return(&models.NewsChangesList{
+			TotalCount: 1,
+			Changes:    []*models.NewsChange{{NewsID: deletedUID, UpdatedAt: since.Add(time.Hour), Deleted: true}},
+		}, nil)
+
+		require.NoError(t, handlerFunc(ctx))
+		require.Equal(t, http.StatusOK, res.Code)
+
+		var changes models.NewsChangesList
+		require.NoError(t, json.Unmarshal(res.Body.Bytes(), &changes))
+		require.Len(t, changes.Changes, 1)
+		require.True(t, changes.Changes[0].Deleted)
+		require.Equal(t, deletedUID, changes.Changes[0].NewsID)
+	})
+
+	t.Run("Missing since", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/news/changes", nil)
+		res := httptest.NewRecorder()
+
+		require.NoError(t, handlerFunc(echo.New().NewContext(req, res)))
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func TestNewsHandlers_ExportAuthor(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}, News: config.NewsConfig{MaxConcurrentExports: 1}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockNewsUC := mock.NewMockUseCase(ctrl)
+	h := NewNewsHandlers(cfg, mockNewsUC, apiLogger)
+
+	authorID := uuid.New()
+	updatedAt := time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)
+	rows := []*models.News{
+		{NewsID: uuid.New(), AuthorID: authorID, Title: "First article title", Content: "<p>First article content</p>", Status: "published", UpdatedAt: updatedAt},
+		{NewsID: uuid.New(), AuthorID: authorID, Title: "Second article title", Content: "Second article content", Status: "draft", UpdatedAt: updatedAt},
+		{NewsID: uuid.New(), AuthorID: authorID, Title: "Third article title", Content: "Third article content", Status: "archived", UpdatedAt: updatedAt},
+	}
+	newContext := func(query string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/news/author/"+authorID.String()+"/export"+query, nil)
+		res := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, res)
+		ctx.SetParamNames("user_id")
+		ctx.SetParamValues(authorID.String())
+		return ctx, res
+	}
+	expectStream := func() {
+		mockNewsUC.EXPECT().StreamAuthorNews(gomock.Any(), authorID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, fn func(n *models.News) error) error {
+				for _, n := range rows {
+					if err := fn(n); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+	}
+	readZip := func(t *testing.T, res *httptest.ResponseRecorder) map[string]string {
+		zr, err := zip.NewReader(bytes.NewReader(res.Body.Bytes()), int64(res.Body.Len()))
+		require.NoError(t, err)
+		entries := make(map[string]string, len(zr.File))
+		for _, f := range zr.File {
+			r, err := f.Open()
+			require.NoError(t, err)
+			body, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+			entries[f.Name] = string(body)
+		}
+		return entries
+	}
+
+	t.Run("Entry per article as json", func(t *testing.T) {
+		ctx, res := newContext("")
+		expectStream()
+
+		require.NoError(t, h.ExportAuthor()(ctx))
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Equal(t, "application/zip", res.Header().Get(echo.HeaderContentType))
+		require.Equal(t, fmt.Sprintf(`attachment; filename="news-%s.zip"`, authorID), res.Header().Get(echo.HeaderContentDisposition))
+
+		entries := readZip(t, res)
+		require.Len(t, entries, len(rows))
+		for _, n := range rows {
+			body, ok := entries[n.NewsID.String()+".json"]
+			require.True(t, ok)
+			exported := &models.News{}
+			require.NoError(t, json.Unmarshal([]byte(body), exported))
+			require.Equal(t, n.Title, exported.Title)
+		}
+	})
+
+	t.Run("Entry per article as markdown", func(t *testing.T) {
+		ctx, res := newContext("?format=markdown")
+		expectStream()
+
+		require.NoError(t, h.ExportAuthor()(ctx))
+		entries := readZip(t, res)
+		require.Len(t, entries, len(rows))
+		require.Contains(t, entries[rows[0].NewsID.String()+".md"], "# First article title")
+		require.Contains(t, entries[rows[0].NewsID.String()+".md"], "First article content")
+	})
+
+	t.Run("No articles is empty archive", func(t *testing.T) {
+		ctx, res := newContext("")
+		mockNewsUC.EXPECT().StreamAuthorNews(gomock.Any(), authorID, gomock.Any()).Return(nil)
+
+		require.NoError(t, h.ExportAuthor()(ctx))
+		require.Equal(t, http.StatusOK, res.Code)
+		require.Empty(t, readZip(t, res))
+	})
+
+	t.Run("Invalid format", func(t *testing.T) {
+		ctx, res := newContext("?format=pdf")
+
+		require.NoError(t, h.ExportAuthor()(ctx))
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("Too many exports", func(t *testing.T) {
+		exports := h.(*newsHandlers).exports
+		exports <- struct{}{}
+		defer func() { <-exports }()
+		ctx, res := newContext("")
+
+		require.NoError(t, h.ExportAuthor()(ctx))
+		require.Equal(t, http.StatusTooManyRequests, res.Code)
+	})
+}
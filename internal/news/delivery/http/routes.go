@@ -9,10 +9,62 @@ import (
 
 // Map news routes
 func MapNewsRoutes(newsGroup *echo.Group, h news.Handlers, mw *middleware.MiddlewareManager) {
+	newsGroup.Use(mw.JSONContentTypeMiddleware(), mw.TimezoneMiddleware, mw.TenantMiddleware)
 	newsGroup.POST("/create", h.Create(), mw.AuthSessionMiddleware, mw.CSRF)
+	newsGroup.POST("", h.Create(), mw.AuthSessionMiddleware, mw.CSRF)
+	newsGroup.POST("/status/batch", h.UpdateStatusBatch(), mw.AuthSessionMiddleware, mw.CSRF, mw.RoleBasedAuthMiddleware([]string{"admin", "moderator"}))
+	newsGroup.POST("/tags/bulk", h.AddTagToMany(), mw.AuthSessionMiddleware, mw.CSRF, mw.RoleBasedAuthMiddleware([]string{"admin", "moderator"}))
+	newsGroup.POST("/orphaned/reassign", h.ReassignAuthor(), mw.AuthSessionMiddleware, mw.CSRF, mw.RoleBasedAuthMiddleware([]string{"admin"}))
+	newsGroup.POST("/comment-counts/recompute", h.RecomputeCommentCounts(), mw.AuthSessionMiddleware, mw.CSRF, mw.RoleBasedAuthMiddleware([]string{"admin"}))
+	newsGroup.POST("/:news_id/preview-token", h.CreatePreviewToken(), mw.AuthSessionMiddleware, mw.CSRF)
+	newsGroup.POST("/:news_id/pin", h.Pin(), mw.AuthSessionMiddleware, mw.CSRF, mw.RoleBasedAuthMiddleware([]string{"admin", "moderator"}))
+	newsGroup.DELETE("/:news_id/pin", h.Unpin(), mw.AuthSessionMiddleware, mw.CSRF, mw.RoleBasedAuthMiddleware([]string{"admin", "moderator"}))
+	newsGroup.POST("/:news_id/revisions/:revision_id/revert", h.RevertTo(), mw.AuthSessionMiddleware, mw.CSRF)
+	newsGroup.POST("/:news_id/relations", h.AddRelation(), mw.AuthSessionMiddleware, mw.CSRF, mw.RoleBasedAuthMiddleware([]string{"admin", "moderator"}))
+	newsGroup.DELETE("/:news_id/relations/:related_id", h.RemoveRelation(), mw.AuthSessionMiddleware, mw.CSRF, mw.RoleBasedAuthMiddleware([]string{"admin", "moderator"}))
+	newsGroup.POST("/:news_id/lock", h.AcquireEditLock(), mw.AuthSessionMiddleware, mw.CSRF)
+	newsGroup.DELETE("/:news_id/lock", h.ReleaseEditLock(), mw.AuthSessionMiddleware, mw.CSRF)
 	newsGroup.PUT("/:news_id", h.Update(), mw.AuthSessionMiddleware, mw.CSRF)
+	newsGroup.PUT("/:news_id/tags", h.SetTags(), mw.AuthSessionMiddleware, mw.CSRF)
+	newsGroup.PUT("/:news_id/metadata", h.SetMetadata(), mw.AuthSessionMiddleware, mw.CSRF)
+	newsGroup.PUT("/:news_id/translations/:locale", h.SetTranslation(), mw.AuthSessionMiddleware, mw.CSRF)
+	newsGroup.PUT("/:news_id/reading-position", h.SaveReadingPosition(), mw.AuthSessionMiddleware, mw.CSRF)
 	newsGroup.DELETE("/:news_id", h.Delete(), mw.AuthSessionMiddleware, mw.CSRF)
-	newsGroup.GET("/:news_id", h.GetByID())
-	newsGroup.GET("/search", h.SearchByTitle())
+	newsGroup.DELETE("/deleted", h.PurgeDeleted(), mw.AuthSessionMiddleware, mw.CSRF, mw.RoleBasedAuthMiddleware([]string{"admin"}))
+	newsGroup.GET("/:news_id", h.GetByID(), mw.OptionalAuthSessionMiddleware)
+	newsGroup.GET("/:news_id/neighbors", h.GetNeighbors())
+	newsGroup.GET("/:news_id/export", h.Export(), mw.OptionalAuthSessionMiddleware)
+	newsGroup.GET("/:news_id/preview", h.GetPreview())
+	newsGroup.GET("/:news_id/revisions", h.GetRevisions(), mw.AuthSessionMiddleware)
+	newsGroup.GET("/:news_id/tags", h.GetTags(), mw.OptionalAuthSessionMiddleware)
+	newsGroup.GET("/:news_id/relations", h.GetCuratedRelated(), mw.OptionalAuthSessionMiddleware)
+	newsGroup.GET("/:news_id/lock", h.GetEditLock(), mw.OptionalAuthSessionMiddleware)
+	newsGroup.GET("/:news_id/metadata", h.GetMetadata(), mw.OptionalAuthSessionMiddleware)
+	newsGroup.GET("/:news_id/translations/:locale", h.GetTranslation(), mw.OptionalAuthSessionMiddleware)
+	newsGroup.GET("/:news_id/reading-position", h.GetReadingPosition(), mw.AuthSessionMiddleware)
+	newsGroup.GET("/:news_id/cache", h.GetCacheEntry(), mw.AuthSessionMiddleware, mw.RoleBasedAuthMiddleware([]string{"admin"}))
+	newsGroup.GET("/revisions/:revision_id", h.GetRevision(), mw.AuthSessionMiddleware)
+	newsGroup.GET("/search", h.SearchByTitle(), mw.StrictQueryMiddleware("title", "scope"))
+	newsGroup.GET("/search/count", h.CountSearchByTitle(), mw.StrictQueryMiddleware("title", "scope"))
+	newsGroup.GET("/by-slugs", h.GetBySlugs())
+	newsGroup.GET("/latest-per-category", h.GetLatestPerCategory())
+	newsGroup.GET("/latest-per-author", h.GetLatestUpdatePerAuthor())
+	newsGroup.GET("/timeline", h.GetTimeline())
+	newsGroup.GET("/feed", h.GetFeed())
+	newsGroup.GET("/featured", h.GetFeatured())
+	newsGroup.GET("/trending", h.GetTrending())
+	newsGroup.GET("/leaderboard", h.GetAuthorLeaderboard())
+	newsGroup.GET("/stats/status", h.CountByStatus())
+	newsGroup.GET("/authors/:author_id/stats/status", h.GetAuthorStatusCounts())
+	newsGroup.GET("/recently-updated", h.GetRecentlyUpdated(), mw.StrictQueryMiddleware())
+	newsGroup.GET("/uncommented", h.GetWithoutComments(), mw.StrictQueryMiddleware())
+	newsGroup.GET("/changes", h.GetChangedSince(), mw.StrictQueryMiddleware("since"))
+	newsGroup.GET("/activity", h.GetActivityFeed(), mw.StrictQueryMiddleware("action"))
+	newsGroup.GET("/broken-images", h.GetBrokenImages(), mw.StrictQueryMiddleware(), mw.AuthSessionMiddleware, mw.RoleBasedAuthMiddleware([]string{"admin"}))
+	newsGroup.GET("/orphaned", h.GetOrphaned(), mw.StrictQueryMiddleware(), mw.AuthSessionMiddleware, mw.RoleBasedAuthMiddleware([]string{"admin"}))
+	newsGroup.GET("/my/drafts", h.GetMyDrafts(), mw.StrictQueryMiddleware(), mw.AuthSessionMiddleware)
+	newsGroup.GET("/edited-by/me", h.GetEditedByMe(), mw.StrictQueryMiddleware(), mw.AuthSessionMiddleware)
+	newsGroup.GET("/author/:user_id/export", h.ExportAuthor(), mw.AuthSessionMiddleware, mw.OwnerOrAdminMiddleware())
+	newsGroup.GET("/ids", h.GetNewsIDs())
 	newsGroup.GET("", h.GetNews())
 }
@@ -0,0 +1,104 @@
+package news
+
+import (
+	"context"
+	"sync"
+
+	"github.com/AleksK1NG/api-mc/internal/models"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+)
+
+// Side effect run once news mutation is committed
+type HookFunc func(ctx context.Context, n *models.News) error
+
+// Registry of post-commit side effects of news repository, e.g. search indexing or cache warming.
+// Hooks run in registration order after commit and never after rollback.
+// Error or panic of one hook is logged and neither stops other hooks nor fails the mutation
+type Hooks struct {
+	mu        sync.RWMutex
+	created   []HookFunc
+	updated   []HookFunc
+	deleted   []HookFunc
+	published []HookFunc
+	logger    logger.Logger
+}
+
+// Hooks registry constructor
+func NewHooks(logger logger.Logger) *Hooks {
+	return &Hooks{logger: logger}
+}
+
+// Register hook run after news is created
+func (h *Hooks) OnCreated(fn HookFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.created = append(h.created, fn)
+}
+
+// Register hook run after news is updated, status batch changes pass news with id, author, title and new status only
+func (h *Hooks) OnUpdated(fn HookFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.updated = append(h.updated, fn)
+}
+
+// Register hook run after news is deleted, news has id only
+func (h *Hooks) OnDeleted(fn HookFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.deleted = append(h.deleted, fn)
+}
+
+// Register hook run after news moves to published status, news has id, author, title and status only.
+// Updated hooks run for the same change too
+func (h *Hooks) OnPublished(fn HookFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.published = append(h.published, fn)
+}
+
+// Run created hooks
+func (h *Hooks) Created(ctx context.Context, n *models.News) {
+	h.run(ctx, "created", func() []HookFunc { return h.created }, n)
+}
+
+// Run updated hooks
+func (h *Hooks) Updated(ctx context.Context, n *models.News) {
+	h.run(ctx, "updated", func() []HookFunc { return h.updated }, n)
+}
+
+// Run deleted hooks
+func (h *Hooks) Deleted(ctx context.Context, n *models.News) {
+	h.run(ctx, "deleted", func() []HookFunc { return h.deleted }, n)
+}
+
+// Run published hooks
+func (h *Hooks) Published(ctx context.Context, n *models.News) {
+	h.run(ctx, "published", func() []HookFunc { return h.published }, n)
+}
+
+func (h *Hooks) run(ctx context.Context, event string, registered func() []HookFunc, n *models.News) {
+	if h == nil {
+		return
+	}
+
+	h.mu.RLock()
+	fns := registered()
+	h.mu.RUnlock()
+
+	for _, fn := range fns {
+		h.call(ctx, event, fn, n)
+	}
+}
+
+func (h *Hooks) call(ctx context.Context, event string, fn HookFunc, n *models.News) {
+	defer func() {
+		if r := recover(); r != nil {
+			h.logger.Errorf("news.Hooks %s hook panic, NewsID: %s, Panic: %v", event, n.NewsID, r)
+		}
+	}()
+
+	if err := fn(ctx, n); err != nil {
+		h.logger.Errorf("news.Hooks %s hook, NewsID: %s, Error: %v", event, n.NewsID, err)
+	}
+}
@@ -0,0 +1,20 @@
+package news
+
+import "github.com/AleksK1NG/api-mc/internal/models"
+
+// Iterator over news fetched batch by batch, next batch is fetched when current one is exhausted
+// so no cursor is held open between batches. Usage:
+//
+//	it := repo.IterateCategory(ctx, "go")
+//	for it.Next() {
+//		process(it.News())
+//	}
+//	if err := it.Err(); err != nil { ... }
+type Iterator interface {
+	// Advance to next news, false once all news were yielded, ctx is done or fetch failed
+	Next() bool
+	// Current news, valid after Next returned true
+	News() *models.News
+	// Error which stopped iteration, nil when all news were yielded
+	Err() error
+}
@@ -7,10 +7,12 @@ package mock
 import (
 	context "context"
 	models "github.com/AleksK1NG/api-mc/internal/models"
+	news "github.com/AleksK1NG/api-mc/internal/news"
 	utils "github.com/AleksK1NG/api-mc/pkg/utils"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 	reflect "reflect"
+	time "time"
 )
 
 // MockRepository is a mock of Repository interface
@@ -36,6 +38,20 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Hooks mocks base method
+func (m *MockRepository) Hooks() *news.Hooks {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hooks")
+	ret0, _ := ret[0].(*news.Hooks)
+	return ret0
+}
+
+// Hooks indicates an expected call of Hooks
+func (mr *MockRepositoryMockRecorder) Hooks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hooks", reflect.TypeOf((*MockRepository)(nil).Hooks))
+}
+
 // Create mocks base method
 func (m *MockRepository) Create(ctx context.Context, news *models.News) (*models.News, error) {
 	m.ctrl.T.Helper()
@@ -51,19 +67,35 @@ func (mr *MockRepositoryMockRecorder) Create(ctx, news interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, news)
 }
 
+// CreateIfNotExists mocks base method
+func (m *MockRepository) CreateIfNotExists(ctx context.Context, news *models.News) (*models.News, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIfNotExists", ctx, news)
+	ret0, _ := ret[0].(*models.News)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateIfNotExists indicates an expected call of CreateIfNotExists
+func (mr *MockRepositoryMockRecorder) CreateIfNotExists(ctx, news interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIfNotExists", reflect.TypeOf((*MockRepository)(nil).CreateIfNotExists), ctx, news)
+}
+
 // Update mocks base method
-func (m *MockRepository) Update(ctx context.Context, news *models.News) (*models.News, error) {
+func (m *MockRepository) Update(ctx context.Context, news *models.News, actorID uuid.UUID) (*models.News, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, news)
+	ret := m.ctrl.Call(m, "Update", ctx, news, actorID)
 	ret0, _ := ret[0].(*models.News)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update
-func (mr *MockRepositoryMockRecorder) Update(ctx, news interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Update(ctx, news, actorID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, news)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, news, actorID)
 }
 
 // GetNewsByID mocks base method
@@ -82,45 +114,859 @@ func (mr *MockRepositoryMockRecorder) GetNewsByID(ctx, newsID interface{}) *gomo
 }
 
 // Delete mocks base method
-func (m *MockRepository) Delete(ctx context.Context, newsID uuid.UUID) error {
+func (m *MockRepository) Delete(ctx context.Context, newsID, actorID uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, newsID)
+	ret := m.ctrl.Call(m, "Delete", ctx, newsID, actorID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete
-func (mr *MockRepositoryMockRecorder) Delete(ctx, newsID interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Delete(ctx, newsID, actorID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, newsID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, newsID, actorID)
+}
+
+// GetActivityFeed mocks base method
+func (m *MockRepository) GetActivityFeed(ctx context.Context, action string, pq *utils.PaginationQuery) (*models.NewsEventList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityFeed", ctx, action, pq)
+	ret0, _ := ret[0].(*models.NewsEventList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityFeed indicates an expected call of GetActivityFeed
+func (mr *MockRepositoryMockRecorder) GetActivityFeed(ctx, action, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityFeed", reflect.TypeOf((*MockRepository)(nil).GetActivityFeed), ctx, action, pq)
 }
 
 // GetNews mocks base method
-func (m *MockRepository) GetNews(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+func (m *MockRepository) GetNews(ctx context.Context, lq *utils.ListQuery, pq *utils.PaginationQuery) (*models.NewsList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNews", ctx, pq)
+	ret := m.ctrl.Call(m, "GetNews", ctx, lq, pq)
 	ret0, _ := ret[0].(*models.NewsList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetNews indicates an expected call of GetNews
-func (mr *MockRepositoryMockRecorder) GetNews(ctx, pq interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetNews(ctx, lq, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNews", reflect.TypeOf((*MockRepository)(nil).GetNews), ctx, lq, pq)
+}
+
+// GetNewsIDs mocks base method
+func (m *MockRepository) GetNewsIDs(ctx context.Context, lq *utils.ListQuery, pq *utils.PaginationQuery) (*models.NewsIDList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsIDs", ctx, lq, pq)
+	ret0, _ := ret[0].(*models.NewsIDList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewsIDs indicates an expected call of GetNewsIDs
+func (mr *MockRepositoryMockRecorder) GetNewsIDs(ctx, lq, pq interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNews", reflect.TypeOf((*MockRepository)(nil).GetNews), ctx, pq)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsIDs", reflect.TypeOf((*MockRepository)(nil).GetNewsIDs), ctx, lq, pq)
 }
 
 // SearchByTitle mocks base method
-func (m *MockRepository) SearchByTitle(ctx context.Context, title string, query *utils.PaginationQuery) (*models.NewsList, error) {
+func (m *MockRepository) SearchByTitle(ctx context.Context, title string, scope models.SearchScope, query *utils.PaginationQuery) (*models.NewsList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchByTitle", ctx, title, query)
+	ret := m.ctrl.Call(m, "SearchByTitle", ctx, title, scope, query)
 	ret0, _ := ret[0].(*models.NewsList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchByTitle indicates an expected call of SearchByTitle
-func (mr *MockRepositoryMockRecorder) SearchByTitle(ctx, title, query interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) SearchByTitle(ctx, title, scope, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByTitle", reflect.TypeOf((*MockRepository)(nil).SearchByTitle), ctx, title, scope, query)
+}
+
+// CountSearchByTitle mocks base method
+func (m *MockRepository) CountSearchByTitle(ctx context.Context, title string, scope models.SearchScope) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSearchByTitle", ctx, title, scope)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSearchByTitle indicates an expected call of CountSearchByTitle
+func (mr *MockRepositoryMockRecorder) CountSearchByTitle(ctx, title, scope interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSearchByTitle", reflect.TypeOf((*MockRepository)(nil).CountSearchByTitle), ctx, title, scope)
+}
+
+// GetTimeline mocks base method
+func (m *MockRepository) GetTimeline(ctx context.Context) ([]*models.TimelineBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeline", ctx)
+	ret0, _ := ret[0].([]*models.TimelineBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimeline indicates an expected call of GetTimeline
+func (mr *MockRepositoryMockRecorder) GetTimeline(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeline", reflect.TypeOf((*MockRepository)(nil).GetTimeline), ctx)
+}
+
+// GetNeighbors mocks base method
+func (m *MockRepository) GetNeighbors(ctx context.Context, newsID uuid.UUID) (*models.News, *models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNeighbors", ctx, newsID)
+	ret0, _ := ret[0].(*models.News)
+	ret1, _ := ret[1].(*models.News)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNeighbors indicates an expected call of GetNeighbors
+func (mr *MockRepositoryMockRecorder) GetNeighbors(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNeighbors", reflect.TypeOf((*MockRepository)(nil).GetNeighbors), ctx, newsID)
+}
+
+// UpdateStatusBatch mocks base method
+func (m *MockRepository) UpdateStatusBatch(ctx context.Context, ids []uuid.UUID, status string, dryRun bool) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatusBatch", ctx, ids, status, dryRun)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatusBatch indicates an expected call of UpdateStatusBatch
+func (mr *MockRepositoryMockRecorder) UpdateStatusBatch(ctx, ids, status, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatusBatch", reflect.TypeOf((*MockRepository)(nil).UpdateStatusBatch), ctx, ids, status, dryRun)
+}
+
+// GetMyDrafts mocks base method
+func (m *MockRepository) GetMyDrafts(ctx context.Context, authorID uuid.UUID, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMyDrafts", ctx, authorID, pq)
+	ret0, _ := ret[0].(*models.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMyDrafts indicates an expected call of GetMyDrafts
+func (mr *MockRepositoryMockRecorder) GetMyDrafts(ctx, authorID, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyDrafts", reflect.TypeOf((*MockRepository)(nil).GetMyDrafts), ctx, authorID, pq)
+}
+
+// GetEditedBy mocks base method
+func (m *MockRepository) GetEditedBy(ctx context.Context, userID uuid.UUID, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEditedBy", ctx, userID, pq)
+	ret0, _ := ret[0].(*models.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEditedBy indicates an expected call of GetEditedBy
+func (mr *MockRepositoryMockRecorder) GetEditedBy(ctx, userID, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEditedBy", reflect.TypeOf((*MockRepository)(nil).GetEditedBy), ctx, userID, pq)
+}
+
+// StreamAuthorNews mocks base method
+func (m *MockRepository) StreamAuthorNews(ctx context.Context, authorID uuid.UUID, fn func(*models.News) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamAuthorNews", ctx, authorID, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamAuthorNews indicates an expected call of StreamAuthorNews
+func (mr *MockRepositoryMockRecorder) StreamAuthorNews(ctx, authorID, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamAuthorNews", reflect.TypeOf((*MockRepository)(nil).StreamAuthorNews), ctx, authorID, fn)
+}
+
+// GetOrphaned mocks base method
+func (m *MockRepository) GetOrphaned(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrphaned", ctx, pq)
+	ret0, _ := ret[0].(*models.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrphaned indicates an expected call of GetOrphaned
+func (mr *MockRepositoryMockRecorder) GetOrphaned(ctx, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrphaned", reflect.TypeOf((*MockRepository)(nil).GetOrphaned), ctx, pq)
+}
+
+// ReassignAuthor mocks base method
+func (m *MockRepository) ReassignAuthor(ctx context.Context, ids []uuid.UUID, authorID uuid.UUID, dryRun bool) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReassignAuthor", ctx, ids, authorID, dryRun)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReassignAuthor indicates an expected call of ReassignAuthor
+func (mr *MockRepositoryMockRecorder) ReassignAuthor(ctx, ids, authorID, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignAuthor", reflect.TypeOf((*MockRepository)(nil).ReassignAuthor), ctx, ids, authorID, dryRun)
+}
+
+// GetOutsideTenant mocks base method
+func (m *MockRepository) GetOutsideTenant(ctx context.Context, ids []uuid.UUID, tenantID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutsideTenant", ctx, ids, tenantID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutsideTenant indicates an expected call of GetOutsideTenant
+func (mr *MockRepositoryMockRecorder) GetOutsideTenant(ctx, ids, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutsideTenant", reflect.TypeOf((*MockRepository)(nil).GetOutsideTenant), ctx, ids, tenantID)
+}
+
+// GetRecent mocks base method
+func (m *MockRepository) GetRecent(ctx context.Context, category string, limit int) ([]*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecent", ctx, category, limit)
+	ret0, _ := ret[0].([]*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecent indicates an expected call of GetRecent
+func (mr *MockRepositoryMockRecorder) GetRecent(ctx, category, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecent", reflect.TypeOf((*MockRepository)(nil).GetRecent), ctx, category, limit)
+}
+
+// IterateCategory mocks base method
+func (m *MockRepository) IterateCategory(ctx context.Context, category string) news.Iterator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterateCategory", ctx, category)
+	ret0, _ := ret[0].(news.Iterator)
+	return ret0
+}
+
+// IterateCategory indicates an expected call of IterateCategory
+func (mr *MockRepositoryMockRecorder) IterateCategory(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateCategory", reflect.TypeOf((*MockRepository)(nil).IterateCategory), ctx, category)
+}
+
+// GetRecentlyUpdated mocks base method
+func (m *MockRepository) GetRecentlyUpdated(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentlyUpdated", ctx, pq)
+	ret0, _ := ret[0].(*models.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentlyUpdated indicates an expected call of GetRecentlyUpdated
+func (mr *MockRepositoryMockRecorder) GetRecentlyUpdated(ctx, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentlyUpdated", reflect.TypeOf((*MockRepository)(nil).GetRecentlyUpdated), ctx, pq)
+}
+
+// GetWithoutComments mocks base method
+func (m *MockRepository) GetWithoutComments(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithoutComments", ctx, pq)
+	ret0, _ := ret[0].(*models.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithoutComments indicates an expected call of GetWithoutComments
+func (mr *MockRepositoryMockRecorder) GetWithoutComments(ctx, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithoutComments", reflect.TypeOf((*MockRepository)(nil).GetWithoutComments), ctx, pq)
+}
+
+// Close mocks base method
+func (m *MockRepository) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close
+func (mr *MockRepositoryMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockRepository)(nil).Close))
+}
+
+// FindSimilarTitles mocks base method
+func (m *MockRepository) FindSimilarTitles(ctx context.Context, title string, threshold float64, authorID uuid.UUID, tenantID *uuid.UUID) ([]*models.NewsRef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSimilarTitles", ctx, title, threshold, authorID, tenantID)
+	ret0, _ := ret[0].([]*models.NewsRef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSimilarTitles indicates an expected call of FindSimilarTitles
+func (mr *MockRepositoryMockRecorder) FindSimilarTitles(ctx, title, threshold, authorID, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSimilarTitles", reflect.TypeOf((*MockRepository)(nil).FindSimilarTitles), ctx, title, threshold, authorID, tenantID)
+}
+
+// StreamNews mocks base method
+func (m *MockRepository) StreamNews(ctx context.Context, lq *utils.ListQuery, pq *utils.PaginationQuery, fn func(*models.News) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamNews", ctx, lq, pq, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamNews indicates an expected call of StreamNews
+func (mr *MockRepositoryMockRecorder) StreamNews(ctx, lq, pq, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamNews", reflect.TypeOf((*MockRepository)(nil).StreamNews), ctx, lq, pq, fn)
+}
+
+// StreamSearchByTitle mocks base method
+func (m *MockRepository) StreamSearchByTitle(ctx context.Context, title string, scope models.SearchScope, pq *utils.PaginationQuery, fn func(*models.News) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamSearchByTitle", ctx, title, scope, pq, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamSearchByTitle indicates an expected call of StreamSearchByTitle
+func (mr *MockRepositoryMockRecorder) StreamSearchByTitle(ctx, title, scope, pq, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamSearchByTitle", reflect.TypeOf((*MockRepository)(nil).StreamSearchByTitle), ctx, title, scope, pq, fn)
+}
+
+// Pin mocks base method
+func (m *MockRepository) Pin(ctx context.Context, newsID uuid.UUID, until *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pin", ctx, newsID, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pin indicates an expected call of Pin
+func (mr *MockRepositoryMockRecorder) Pin(ctx, newsID, until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pin", reflect.TypeOf((*MockRepository)(nil).Pin), ctx, newsID, until)
+}
+
+// Unpin mocks base method
+func (m *MockRepository) Unpin(ctx context.Context, newsID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unpin", ctx, newsID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unpin indicates an expected call of Unpin
+func (mr *MockRepositoryMockRecorder) Unpin(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unpin", reflect.TypeOf((*MockRepository)(nil).Unpin), ctx, newsID)
+}
+
+// GetFeatured mocks base method
+func (m *MockRepository) GetFeatured(ctx context.Context, limit int) ([]*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeatured", ctx, limit)
+	ret0, _ := ret[0].([]*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeatured indicates an expected call of GetFeatured
+func (mr *MockRepositoryMockRecorder) GetFeatured(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeatured", reflect.TypeOf((*MockRepository)(nil).GetFeatured), ctx, limit)
+}
+
+// GetRevisions mocks base method
+func (m *MockRepository) GetRevisions(ctx context.Context, newsID uuid.UUID) ([]*models.NewsRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", ctx, newsID)
+	ret0, _ := ret[0].([]*models.NewsRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions
+func (mr *MockRepositoryMockRecorder) GetRevisions(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockRepository)(nil).GetRevisions), ctx, newsID)
+}
+
+// GetRevision mocks base method
+func (m *MockRepository) GetRevision(ctx context.Context, revisionID uuid.UUID) (*models.NewsRevisionSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", ctx, revisionID)
+	ret0, _ := ret[0].(*models.NewsRevisionSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision
+func (mr *MockRepositoryMockRecorder) GetRevision(ctx, revisionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockRepository)(nil).GetRevision), ctx, revisionID)
+}
+
+// RevertTo mocks base method
+func (m *MockRepository) RevertTo(ctx context.Context, newsID, revisionID, actorID uuid.UUID) (*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertTo", ctx, newsID, revisionID, actorID)
+	ret0, _ := ret[0].(*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertTo indicates an expected call of RevertTo
+func (mr *MockRepositoryMockRecorder) RevertTo(ctx, newsID, revisionID, actorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertTo", reflect.TypeOf((*MockRepository)(nil).RevertTo), ctx, newsID, revisionID, actorID)
+}
+
+// GetAuthorLeaderboard mocks base method
+func (m *MockRepository) GetAuthorLeaderboard(ctx context.Context, since time.Time, limit int) ([]*models.AuthorStat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthorLeaderboard", ctx, since, limit)
+	ret0, _ := ret[0].([]*models.AuthorStat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthorLeaderboard indicates an expected call of GetAuthorLeaderboard
+func (mr *MockRepositoryMockRecorder) GetAuthorLeaderboard(ctx, since, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorLeaderboard", reflect.TypeOf((*MockRepository)(nil).GetAuthorLeaderboard), ctx, since, limit)
+}
+
+// CountByStatus mocks base method
+func (m *MockRepository) CountByStatus(ctx context.Context) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByStatus", ctx)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByStatus indicates an expected call of CountByStatus
+func (mr *MockRepositoryMockRecorder) CountByStatus(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByStatus", reflect.TypeOf((*MockRepository)(nil).CountByStatus), ctx)
+}
+
+// GetAuthorStatusCounts mocks base method
+func (m *MockRepository) GetAuthorStatusCounts(ctx context.Context, authorID uuid.UUID) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthorStatusCounts", ctx, authorID)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthorStatusCounts indicates an expected call of GetAuthorStatusCounts
+func (mr *MockRepositoryMockRecorder) GetAuthorStatusCounts(ctx, authorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorStatusCounts", reflect.TypeOf((*MockRepository)(nil).GetAuthorStatusCounts), ctx, authorID)
+}
+
+// GetNewsByContentHash mocks base method
+func (m *MockRepository) GetNewsByContentHash(ctx context.Context, hash string, tenantID *uuid.UUID) (*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsByContentHash", ctx, hash, tenantID)
+	ret0, _ := ret[0].(*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewsByContentHash indicates an expected call of GetNewsByContentHash
+func (mr *MockRepositoryMockRecorder) GetNewsByContentHash(ctx, hash, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsByContentHash", reflect.TypeOf((*MockRepository)(nil).GetNewsByContentHash), ctx, hash, tenantID)
+}
+
+// GetNewsBySlugs mocks base method
+func (m *MockRepository) GetNewsBySlugs(ctx context.Context, slugs []string) ([]*models.NewsBase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsBySlugs", ctx, slugs)
+	ret0, _ := ret[0].([]*models.NewsBase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewsBySlugs indicates an expected call of GetNewsBySlugs
+func (mr *MockRepositoryMockRecorder) GetNewsBySlugs(ctx, slugs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsBySlugs", reflect.TypeOf((*MockRepository)(nil).GetNewsBySlugs), ctx, slugs)
+}
+
+// GetBySlugsOrdered mocks base method
+func (m *MockRepository) GetBySlugsOrdered(ctx context.Context, slugs []string) ([]*models.NewsBase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySlugsOrdered", ctx, slugs)
+	ret0, _ := ret[0].([]*models.NewsBase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySlugsOrdered indicates an expected call of GetBySlugsOrdered
+func (mr *MockRepositoryMockRecorder) GetBySlugsOrdered(ctx, slugs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlugsOrdered", reflect.TypeOf((*MockRepository)(nil).GetBySlugsOrdered), ctx, slugs)
+}
+
+// GetUnknownCategories mocks base method
+func (m *MockRepository) GetUnknownCategories(ctx context.Context, categories []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnknownCategories", ctx, categories)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnknownCategories indicates an expected call of GetUnknownCategories
+func (mr *MockRepositoryMockRecorder) GetUnknownCategories(ctx, categories interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnknownCategories", reflect.TypeOf((*MockRepository)(nil).GetUnknownCategories), ctx, categories)
+}
+
+// SetMetadata mocks base method
+func (m *MockRepository) SetMetadata(ctx context.Context, newsID uuid.UUID, metadata models.Metadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMetadata", ctx, newsID, metadata)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMetadata indicates an expected call of SetMetadata
+func (mr *MockRepositoryMockRecorder) SetMetadata(ctx, newsID, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMetadata", reflect.TypeOf((*MockRepository)(nil).SetMetadata), ctx, newsID, metadata)
+}
+
+// GetMetadata mocks base method
+func (m *MockRepository) GetMetadata(ctx context.Context, newsID uuid.UUID) (models.Metadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetadata", ctx, newsID)
+	ret0, _ := ret[0].(models.Metadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMetadata indicates an expected call of GetMetadata
+func (mr *MockRepositoryMockRecorder) GetMetadata(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockRepository)(nil).GetMetadata), ctx, newsID)
+}
+
+// SetTags mocks base method
+func (m *MockRepository) SetTags(ctx context.Context, newsID uuid.UUID, tags []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTags", ctx, newsID, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTags indicates an expected call of SetTags
+func (mr *MockRepositoryMockRecorder) SetTags(ctx, newsID, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTags", reflect.TypeOf((*MockRepository)(nil).SetTags), ctx, newsID, tags)
+}
+
+// GetTags mocks base method
+func (m *MockRepository) GetTags(ctx context.Context, newsID uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTags", ctx, newsID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags
+func (mr *MockRepositoryMockRecorder) GetTags(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockRepository)(nil).GetTags), ctx, newsID)
+}
+
+// AddTagToMany mocks base method
+func (m *MockRepository) AddTagToMany(ctx context.Context, ids []uuid.UUID, tag string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTagToMany", ctx, ids, tag)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTagToMany indicates an expected call of AddTagToMany
+func (mr *MockRepositoryMockRecorder) AddTagToMany(ctx, ids, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagToMany", reflect.TypeOf((*MockRepository)(nil).AddTagToMany), ctx, ids, tag)
+}
+
+// SetTranslation mocks base method
+func (m *MockRepository) SetTranslation(ctx context.Context, translation *models.NewsTranslation) (*models.NewsTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTranslation", ctx, translation)
+	ret0, _ := ret[0].(*models.NewsTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTranslation indicates an expected call of SetTranslation
+func (mr *MockRepositoryMockRecorder) SetTranslation(ctx, translation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTranslation", reflect.TypeOf((*MockRepository)(nil).SetTranslation), ctx, translation)
+}
+
+// GetTranslation mocks base method
+func (m *MockRepository) GetTranslation(ctx context.Context, newsID uuid.UUID, locale string) (*models.NewsTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTranslation", ctx, newsID, locale)
+	ret0, _ := ret[0].(*models.NewsTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTranslation indicates an expected call of GetTranslation
+func (mr *MockRepositoryMockRecorder) GetTranslation(ctx, newsID, locale interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTranslation", reflect.TypeOf((*MockRepository)(nil).GetTranslation), ctx, newsID, locale)
+}
+
+// SaveReadingPosition mocks base method
+func (m *MockRepository) SaveReadingPosition(ctx context.Context, position *models.ReadingPosition) (*models.ReadingPosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveReadingPosition", ctx, position)
+	ret0, _ := ret[0].(*models.ReadingPosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveReadingPosition indicates an expected call of SaveReadingPosition
+func (mr *MockRepositoryMockRecorder) SaveReadingPosition(ctx, position interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveReadingPosition", reflect.TypeOf((*MockRepository)(nil).SaveReadingPosition), ctx, position)
+}
+
+// GetReadingPosition mocks base method
+func (m *MockRepository) GetReadingPosition(ctx context.Context, userID, newsID uuid.UUID) (*models.ReadingPosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadingPosition", ctx, userID, newsID)
+	ret0, _ := ret[0].(*models.ReadingPosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadingPosition indicates an expected call of GetReadingPosition
+func (mr *MockRepositoryMockRecorder) GetReadingPosition(ctx, userID, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadingPosition", reflect.TypeOf((*MockRepository)(nil).GetReadingPosition), ctx, userID, newsID)
+}
+
+// GetChangedSince mocks base method
+func (m *MockRepository) GetChangedSince(ctx context.Context, since time.Time, pq *utils.PaginationQuery) (*models.NewsChangesList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangedSince", ctx, since, pq)
+	ret0, _ := ret[0].(*models.NewsChangesList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangedSince indicates an expected call of GetChangedSince
+func (mr *MockRepositoryMockRecorder) GetChangedSince(ctx, since, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangedSince", reflect.TypeOf((*MockRepository)(nil).GetChangedSince), ctx, since, pq)
+}
+
+// PurgeDeleted mocks base method
+func (m *MockRepository) PurgeDeleted(ctx context.Context, before time.Time, batchSize int) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeleted", ctx, before, batchSize)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeleted indicates an expected call of PurgeDeleted
+func (mr *MockRepositoryMockRecorder) PurgeDeleted(ctx, before, batchSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeleted", reflect.TypeOf((*MockRepository)(nil).PurgeDeleted), ctx, before, batchSize)
+}
+
+// RecomputeCommentCounts mocks base method
+func (m *MockRepository) RecomputeCommentCounts(ctx context.Context, batchSize int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecomputeCommentCounts", ctx, batchSize)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecomputeCommentCounts indicates an expected call of RecomputeCommentCounts
+func (mr *MockRepositoryMockRecorder) RecomputeCommentCounts(ctx, batchSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeCommentCounts", reflect.TypeOf((*MockRepository)(nil).RecomputeCommentCounts), ctx, batchSize)
+}
+
+// GetLatestPerCategory mocks base method
+func (m *MockRepository) GetLatestPerCategory(ctx context.Context, categories []string) (map[string]*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestPerCategory", ctx, categories)
+	ret0, _ := ret[0].(map[string]*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestPerCategory indicates an expected call of GetLatestPerCategory
+func (mr *MockRepositoryMockRecorder) GetLatestPerCategory(ctx, categories interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestPerCategory", reflect.TypeOf((*MockRepository)(nil).GetLatestPerCategory), ctx, categories)
+}
+
+// GetLatestUpdatePerAuthor mocks base method
+func (m *MockRepository) GetLatestUpdatePerAuthor(ctx context.Context, authorIDs []uuid.UUID) (map[uuid.UUID]*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestUpdatePerAuthor", ctx, authorIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestUpdatePerAuthor indicates an expected call of GetLatestUpdatePerAuthor
+func (mr *MockRepositoryMockRecorder) GetLatestUpdatePerAuthor(ctx, authorIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestUpdatePerAuthor", reflect.TypeOf((*MockRepository)(nil).GetLatestUpdatePerAuthor), ctx, authorIDs)
+}
+
+// AddRelation mocks base method
+func (m *MockRepository) AddRelation(ctx context.Context, fromID, toID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRelation", ctx, fromID, toID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRelation indicates an expected call of AddRelation
+func (mr *MockRepositoryMockRecorder) AddRelation(ctx, fromID, toID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRelation", reflect.TypeOf((*MockRepository)(nil).AddRelation), ctx, fromID, toID)
+}
+
+// RemoveRelation mocks base method
+func (m *MockRepository) RemoveRelation(ctx context.Context, fromID, toID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRelation", ctx, fromID, toID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRelation indicates an expected call of RemoveRelation
+func (mr *MockRepositoryMockRecorder) RemoveRelation(ctx, fromID, toID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRelation", reflect.TypeOf((*MockRepository)(nil).RemoveRelation), ctx, fromID, toID)
+}
+
+// GetCuratedRelated mocks base method
+func (m *MockRepository) GetCuratedRelated(ctx context.Context, newsID uuid.UUID) ([]*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCuratedRelated", ctx, newsID)
+	ret0, _ := ret[0].([]*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCuratedRelated indicates an expected call of GetCuratedRelated
+func (mr *MockRepositoryMockRecorder) GetCuratedRelated(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCuratedRelated", reflect.TypeOf((*MockRepository)(nil).GetCuratedRelated), ctx, newsID)
+}
+
+// AddViews mocks base method
+func (m *MockRepository) AddViews(ctx context.Context, ids []uuid.UUID, views []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddViews", ctx, ids, views)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddViews indicates an expected call of AddViews
+func (mr *MockRepositoryMockRecorder) AddViews(ctx, ids, views interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddViews", reflect.TypeOf((*MockRepository)(nil).AddViews), ctx, ids, views)
+}
+
+// GetTrending mocks base method
+func (m *MockRepository) GetTrending(ctx context.Context, gravity float64, limit int) ([]*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrending", ctx, gravity, limit)
+	ret0, _ := ret[0].([]*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrending indicates an expected call of GetTrending
+func (mr *MockRepositoryMockRecorder) GetTrending(ctx, gravity, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrending", reflect.TypeOf((*MockRepository)(nil).GetTrending), ctx, gravity, limit)
+}
+
+// GetNewsImages mocks base method
+func (m *MockRepository) GetNewsImages(ctx context.Context, afterID uuid.UUID, limit int) ([]*models.NewsImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsImages", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*models.NewsImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewsImages indicates an expected call of GetNewsImages
+func (mr *MockRepositoryMockRecorder) GetNewsImages(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsImages", reflect.TypeOf((*MockRepository)(nil).GetNewsImages), ctx, afterID, limit)
+}
+
+// SetImageBroken mocks base method
+func (m *MockRepository) SetImageBroken(ctx context.Context, checked, broken []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetImageBroken", ctx, checked, broken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetImageBroken indicates an expected call of SetImageBroken
+func (mr *MockRepositoryMockRecorder) SetImageBroken(ctx, checked, broken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetImageBroken", reflect.TypeOf((*MockRepository)(nil).SetImageBroken), ctx, checked, broken)
+}
+
+// GetBrokenImages mocks base method
+func (m *MockRepository) GetBrokenImages(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBrokenImages", ctx, pq)
+	ret0, _ := ret[0].(*models.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBrokenImages indicates an expected call of GetBrokenImages
+func (mr *MockRepositoryMockRecorder) GetBrokenImages(ctx, pq interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByTitle", reflect.TypeOf((*MockRepository)(nil).SearchByTitle), ctx, title, query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrokenImages", reflect.TypeOf((*MockRepository)(nil).GetBrokenImages), ctx, pq)
 }
@@ -8,7 +8,9 @@ import (
 	context "context"
 	models "github.com/AleksK1NG/api-mc/internal/models"
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 	reflect "reflect"
+	time "time"
 )
 
 // MockRedisRepository is a mock of RedisRepository interface
@@ -76,3 +78,545 @@ func (mr *MockRedisRepositoryMockRecorder) DeleteNewsCtx(ctx, key interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNewsCtx", reflect.TypeOf((*MockRedisRepository)(nil).DeleteNewsCtx), ctx, key)
 }
+
+// GetNewsByKeysCtx mocks base method
+func (m *MockRedisRepository) GetNewsByKeysCtx(ctx context.Context, keys []string) ([]*models.NewsBase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsByKeysCtx", ctx, keys)
+	ret0, _ := ret[0].([]*models.NewsBase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewsByKeysCtx indicates an expected call of GetNewsByKeysCtx
+func (mr *MockRedisRepositoryMockRecorder) GetNewsByKeysCtx(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsByKeysCtx", reflect.TypeOf((*MockRedisRepository)(nil).GetNewsByKeysCtx), ctx, keys)
+}
+
+// GetNewsIDsCtx mocks base method
+func (m *MockRedisRepository) GetNewsIDsCtx(ctx context.Context, keys []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsIDsCtx", ctx, keys)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewsIDsCtx indicates an expected call of GetNewsIDsCtx
+func (mr *MockRedisRepositoryMockRecorder) GetNewsIDsCtx(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsIDsCtx", reflect.TypeOf((*MockRedisRepository)(nil).GetNewsIDsCtx), ctx, keys)
+}
+
+// SetNewsIDCtx mocks base method
+func (m *MockRedisRepository) SetNewsIDCtx(ctx context.Context, key string, seconds int, newsID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNewsIDCtx", ctx, key, seconds, newsID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNewsIDCtx indicates an expected call of SetNewsIDCtx
+func (mr *MockRedisRepositoryMockRecorder) SetNewsIDCtx(ctx, key, seconds, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNewsIDCtx", reflect.TypeOf((*MockRedisRepository)(nil).SetNewsIDCtx), ctx, key, seconds, newsID)
+}
+
+// GetRawCtx mocks base method
+func (m *MockRedisRepository) GetRawCtx(ctx context.Context, key string) ([]byte, time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRawCtx", ctx, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(time.Duration)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRawCtx indicates an expected call of GetRawCtx
+func (mr *MockRedisRepositoryMockRecorder) GetRawCtx(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRawCtx", reflect.TypeOf((*MockRedisRepository)(nil).GetRawCtx), ctx, key)
+}
+
+// GetNewsWithFreshnessCtx mocks base method
+func (m *MockRedisRepository) GetNewsWithFreshnessCtx(ctx context.Context, key string) (*models.NewsBase, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsWithFreshnessCtx", ctx, key)
+	ret0, _ := ret[0].(*models.NewsBase)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNewsWithFreshnessCtx indicates an expected call of GetNewsWithFreshnessCtx
+func (mr *MockRedisRepositoryMockRecorder) GetNewsWithFreshnessCtx(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsWithFreshnessCtx", reflect.TypeOf((*MockRedisRepository)(nil).GetNewsWithFreshnessCtx), ctx, key)
+}
+
+// SetNewsWithFreshnessCtx mocks base method
+func (m *MockRedisRepository) SetNewsWithFreshnessCtx(ctx context.Context, key string, seconds int, freshUntil time.Time, news *models.NewsBase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNewsWithFreshnessCtx", ctx, key, seconds, freshUntil, news)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNewsWithFreshnessCtx indicates an expected call of SetNewsWithFreshnessCtx
+func (mr *MockRedisRepositoryMockRecorder) SetNewsWithFreshnessCtx(ctx, key, seconds, freshUntil, news interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNewsWithFreshnessCtx", reflect.TypeOf((*MockRedisRepository)(nil).SetNewsWithFreshnessCtx), ctx, key, seconds, freshUntil, news)
+}
+
+// GetTimelineCtx mocks base method
+func (m *MockRedisRepository) GetTimelineCtx(ctx context.Context, key string) ([]*models.TimelineBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimelineCtx", ctx, key)
+	ret0, _ := ret[0].([]*models.TimelineBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimelineCtx indicates an expected call of GetTimelineCtx
+func (mr *MockRedisRepositoryMockRecorder) GetTimelineCtx(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimelineCtx", reflect.TypeOf((*MockRedisRepository)(nil).GetTimelineCtx), ctx, key)
+}
+
+// SetTimelineCtx mocks base method
+func (m *MockRedisRepository) SetTimelineCtx(ctx context.Context, key string, seconds int, timeline []*models.TimelineBucket) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTimelineCtx", ctx, key, seconds, timeline)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTimelineCtx indicates an expected call of SetTimelineCtx
+func (mr *MockRedisRepositoryMockRecorder) SetTimelineCtx(ctx, key, seconds, timeline interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTimelineCtx", reflect.TypeOf((*MockRedisRepository)(nil).SetTimelineCtx), ctx, key, seconds, timeline)
+}
+
+// GetFeedCtx mocks base method
+func (m *MockRedisRepository) GetFeedCtx(ctx context.Context, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedCtx", ctx, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedCtx indicates an expected call of GetFeedCtx
+func (mr *MockRedisRepositoryMockRecorder) GetFeedCtx(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedCtx", reflect.TypeOf((*MockRedisRepository)(nil).GetFeedCtx), ctx, key)
+}
+
+// SetFeedCtx mocks base method
+func (m *MockRedisRepository) SetFeedCtx(ctx context.Context, key string, seconds int, feed []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFeedCtx", ctx, key, seconds, feed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFeedCtx indicates an expected call of SetFeedCtx
+func (mr *MockRedisRepositoryMockRecorder) SetFeedCtx(ctx, key, seconds, feed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeedCtx", reflect.TypeOf((*MockRedisRepository)(nil).SetFeedCtx), ctx, key, seconds, feed)
+}
+
+// GetFeaturedCtx mocks base method
+func (m *MockRedisRepository) GetFeaturedCtx(ctx context.Context, key string) ([]*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeaturedCtx", ctx, key)
+	ret0, _ := ret[0].([]*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeaturedCtx indicates an expected call of GetFeaturedCtx
+func (mr *MockRedisRepositoryMockRecorder) GetFeaturedCtx(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeaturedCtx", reflect.TypeOf((*MockRedisRepository)(nil).GetFeaturedCtx), ctx, key)
+}
+
+// SetFeaturedCtx mocks base method
+func (m *MockRedisRepository) SetFeaturedCtx(ctx context.Context, key string, seconds int, featured []*models.News) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFeaturedCtx", ctx, key, seconds, featured)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFeaturedCtx indicates an expected call of SetFeaturedCtx
+func (mr *MockRedisRepositoryMockRecorder) SetFeaturedCtx(ctx, key, seconds, featured interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeaturedCtx", reflect.TypeOf((*MockRedisRepository)(nil).SetFeaturedCtx), ctx, key, seconds, featured)
+}
+
+// GetLatestPerCategoryCtx mocks base method
+func (m *MockRedisRepository) GetLatestPerCategoryCtx(ctx context.Context, key string) (map[string]*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestPerCategoryCtx", ctx, key)
+	ret0, _ := ret[0].(map[string]*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestPerCategoryCtx indicates an expected call of GetLatestPerCategoryCtx
+func (mr *MockRedisRepositoryMockRecorder) GetLatestPerCategoryCtx(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestPerCategoryCtx", reflect.TypeOf((*MockRedisRepository)(nil).GetLatestPerCategoryCtx), ctx, key)
+}
+
+// SetLatestPerCategoryCtx mocks base method
+func (m *MockRedisRepository) SetLatestPerCategoryCtx(ctx context.Context, key string, seconds int, latest map[string]*models.News) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLatestPerCategoryCtx", ctx, key, seconds, latest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLatestPerCategoryCtx indicates an expected call of SetLatestPerCategoryCtx
+func (mr *MockRedisRepositoryMockRecorder) SetLatestPerCategoryCtx(ctx, key, seconds, latest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLatestPerCategoryCtx", reflect.TypeOf((*MockRedisRepository)(nil).SetLatestPerCategoryCtx), ctx, key, seconds, latest)
+}
+
+// GetLatestPerAuthorCtx mocks base method
+func (m *MockRedisRepository) GetLatestPerAuthorCtx(ctx context.Context, key string) (map[uuid.UUID]*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestPerAuthorCtx", ctx, key)
+	ret0, _ := ret[0].(map[uuid.UUID]*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestPerAuthorCtx indicates an expected call of GetLatestPerAuthorCtx
+func (mr *MockRedisRepositoryMockRecorder) GetLatestPerAuthorCtx(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestPerAuthorCtx", reflect.TypeOf((*MockRedisRepository)(nil).GetLatestPerAuthorCtx), ctx, key)
+}
+
+// SetLatestPerAuthorCtx mocks base method
+func (m *MockRedisRepository) SetLatestPerAuthorCtx(ctx context.Context, key string, seconds int, latest map[uuid.UUID]*models.News) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLatestPerAuthorCtx", ctx, key, seconds, latest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLatestPerAuthorCtx indicates an expected call of SetLatestPerAuthorCtx
+func (mr *MockRedisRepositoryMockRecorder) SetLatestPerAuthorCtx(ctx, key, seconds, latest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLatestPerAuthorCtx", reflect.TypeOf((*MockRedisRepository)(nil).SetLatestPerAuthorCtx), ctx, key, seconds, latest)
+}
+
+// GetRelatedCtx mocks base method
+func (m *MockRedisRepository) GetRelatedCtx(ctx context.Context, key string) ([]*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelatedCtx", ctx, key)
+	ret0, _ := ret[0].([]*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelatedCtx indicates an expected call of GetRelatedCtx
+func (mr *MockRedisRepositoryMockRecorder) GetRelatedCtx(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatedCtx", reflect.TypeOf((*MockRedisRepository)(nil).GetRelatedCtx), ctx, key)
+}
+
+// SetRelatedCtx mocks base method
+func (m *MockRedisRepository) SetRelatedCtx(ctx context.Context, key string, seconds int, related []*models.News) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRelatedCtx", ctx, key, seconds, related)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRelatedCtx indicates an expected call of SetRelatedCtx
+func (mr *MockRedisRepositoryMockRecorder) SetRelatedCtx(ctx, key, seconds, related interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRelatedCtx", reflect.TypeOf((*MockRedisRepository)(nil).SetRelatedCtx), ctx, key, seconds, related)
+}
+
+// AcquireEditLockCtx mocks base method
+func (m *MockRedisRepository) AcquireEditLockCtx(ctx context.Context, key, userID string, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireEditLockCtx", ctx, key, userID, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireEditLockCtx indicates an expected call of AcquireEditLockCtx
+func (mr *MockRedisRepositoryMockRecorder) AcquireEditLockCtx(ctx, key, userID, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireEditLockCtx", reflect.TypeOf((*MockRedisRepository)(nil).AcquireEditLockCtx), ctx, key, userID, ttl)
+}
+
+// ReleaseEditLockCtx mocks base method
+func (m *MockRedisRepository) ReleaseEditLockCtx(ctx context.Context, key, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseEditLockCtx", ctx, key, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseEditLockCtx indicates an expected call of ReleaseEditLockCtx
+func (mr *MockRedisRepositoryMockRecorder) ReleaseEditLockCtx(ctx, key, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseEditLockCtx", reflect.TypeOf((*MockRedisRepository)(nil).ReleaseEditLockCtx), ctx, key, userID)
+}
+
+// GetEditLockCtx mocks base method
+func (m *MockRedisRepository) GetEditLockCtx(ctx context.Context, key string) (string, time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEditLockCtx", ctx, key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Duration)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEditLockCtx indicates an expected call of GetEditLockCtx
+func (mr *MockRedisRepositoryMockRecorder) GetEditLockCtx(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEditLockCtx", reflect.TypeOf((*MockRedisRepository)(nil).GetEditLockCtx), ctx, key)
+}
+
+// GetNewsListCtx mocks base method
+func (m *MockRedisRepository) GetNewsListCtx(ctx context.Context, key string) (*models.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsListCtx", ctx, key)
+	ret0, _ := ret[0].(*models.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewsListCtx indicates an expected call of GetNewsListCtx
+func (mr *MockRedisRepositoryMockRecorder) GetNewsListCtx(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsListCtx", reflect.TypeOf((*MockRedisRepository)(nil).GetNewsListCtx), ctx, key)
+}
+
+// SetNewsListCtx mocks base method
+func (m *MockRedisRepository) SetNewsListCtx(ctx context.Context, key string, seconds int, newsList *models.NewsList) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNewsListCtx", ctx, key, seconds, newsList)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNewsListCtx indicates an expected call of SetNewsListCtx
+func (mr *MockRedisRepositoryMockRecorder) SetNewsListCtx(ctx, key, seconds, newsList interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNewsListCtx", reflect.TypeOf((*MockRedisRepository)(nil).SetNewsListCtx), ctx, key, seconds, newsList)
+}
+
+// GetTrendingCtx mocks base method
+func (m *MockRedisRepository) GetTrendingCtx(ctx context.Context, key string) ([]*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrendingCtx", ctx, key)
+	ret0, _ := ret[0].([]*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrendingCtx indicates an expected call of GetTrendingCtx
+func (mr *MockRedisRepositoryMockRecorder) GetTrendingCtx(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrendingCtx", reflect.TypeOf((*MockRedisRepository)(nil).GetTrendingCtx), ctx, key)
+}
+
+// SetTrendingCtx mocks base method
+func (m *MockRedisRepository) SetTrendingCtx(ctx context.Context, key string, seconds int, trending []*models.News) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTrendingCtx", ctx, key, seconds, trending)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTrendingCtx indicates an expected call of SetTrendingCtx
+func (mr *MockRedisRepositoryMockRecorder) SetTrendingCtx(ctx, key, seconds, trending interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrendingCtx", reflect.TypeOf((*MockRedisRepository)(nil).SetTrendingCtx), ctx, key, seconds, trending)
+}
+
+// GetActivityFeedCtx mocks base method
+func (m *MockRedisRepository) GetActivityFeedCtx(ctx context.Context, key string) (*models.NewsEventList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityFeedCtx", ctx, key)
+	ret0, _ := ret[0].(*models.NewsEventList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityFeedCtx indicates an expected call of GetActivityFeedCtx
+func (mr *MockRedisRepositoryMockRecorder) GetActivityFeedCtx(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityFeedCtx", reflect.TypeOf((*MockRedisRepository)(nil).GetActivityFeedCtx), ctx, key)
+}
+
+// SetActivityFeedCtx mocks base method
+func (m *MockRedisRepository) SetActivityFeedCtx(ctx context.Context, key string, seconds int, feed *models.NewsEventList) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetActivityFeedCtx", ctx, key, seconds, feed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetActivityFeedCtx indicates an expected call of SetActivityFeedCtx
+func (mr *MockRedisRepositoryMockRecorder) SetActivityFeedCtx(ctx, key, seconds, feed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetActivityFeedCtx", reflect.TypeOf((*MockRedisRepository)(nil).SetActivityFeedCtx), ctx, key, seconds, feed)
+}
+
+// GetLeaderboardCtx mocks base method
+func (m *MockRedisRepository) GetLeaderboardCtx(ctx context.Context, key string) ([]*models.AuthorStat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderboardCtx", ctx, key)
+	ret0, _ := ret[0].([]*models.AuthorStat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderboardCtx indicates an expected call of GetLeaderboardCtx
+func (mr *MockRedisRepositoryMockRecorder) GetLeaderboardCtx(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderboardCtx", reflect.TypeOf((*MockRedisRepository)(nil).GetLeaderboardCtx), ctx, key)
+}
+
+// SetLeaderboardCtx mocks base method
+func (m *MockRedisRepository) SetLeaderboardCtx(ctx context.Context, key string, seconds int, stats []*models.AuthorStat) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLeaderboardCtx", ctx, key, seconds, stats)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLeaderboardCtx indicates an expected call of SetLeaderboardCtx
+func (mr *MockRedisRepositoryMockRecorder) SetLeaderboardCtx(ctx, key, seconds, stats interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLeaderboardCtx", reflect.TypeOf((*MockRedisRepository)(nil).SetLeaderboardCtx), ctx, key, seconds, stats)
+}
+
+// GetStatusCountsCtx mocks base method
+func (m *MockRedisRepository) GetStatusCountsCtx(ctx context.Context, key string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusCountsCtx", ctx, key)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatusCountsCtx indicates an expected call of GetStatusCountsCtx
+func (mr *MockRedisRepositoryMockRecorder) GetStatusCountsCtx(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusCountsCtx", reflect.TypeOf((*MockRedisRepository)(nil).GetStatusCountsCtx), ctx, key)
+}
+
+// SetStatusCountsCtx mocks base method
+func (m *MockRedisRepository) SetStatusCountsCtx(ctx context.Context, key string, seconds int, counts map[string]int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStatusCountsCtx", ctx, key, seconds, counts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStatusCountsCtx indicates an expected call of SetStatusCountsCtx
+func (mr *MockRedisRepositoryMockRecorder) SetStatusCountsCtx(ctx, key, seconds, counts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStatusCountsCtx", reflect.TypeOf((*MockRedisRepository)(nil).SetStatusCountsCtx), ctx, key, seconds, counts)
+}
+
+// GetTranslationCtx mocks base method
+func (m *MockRedisRepository) GetTranslationCtx(ctx context.Context, key string) (*models.NewsTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTranslationCtx", ctx, key)
+	ret0, _ := ret[0].(*models.NewsTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTranslationCtx indicates an expected call of GetTranslationCtx
+func (mr *MockRedisRepositoryMockRecorder) GetTranslationCtx(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTranslationCtx", reflect.TypeOf((*MockRedisRepository)(nil).GetTranslationCtx), ctx, key)
+}
+
+// SetTranslationCtx mocks base method
+func (m *MockRedisRepository) SetTranslationCtx(ctx context.Context, key string, seconds int, translation *models.NewsTranslation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTranslationCtx", ctx, key, seconds, translation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTranslationCtx indicates an expected call of SetTranslationCtx
+func (mr *MockRedisRepositoryMockRecorder) SetTranslationCtx(ctx, key, seconds, translation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTranslationCtx", reflect.TypeOf((*MockRedisRepository)(nil).SetTranslationCtx), ctx, key, seconds, translation)
+}
+
+// GetReadingPositionCtx mocks base method
+func (m *MockRedisRepository) GetReadingPositionCtx(ctx context.Context, key string) (*models.ReadingPosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadingPositionCtx", ctx, key)
+	ret0, _ := ret[0].(*models.ReadingPosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadingPositionCtx indicates an expected call of GetReadingPositionCtx
+func (mr *MockRedisRepositoryMockRecorder) GetReadingPositionCtx(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadingPositionCtx", reflect.TypeOf((*MockRedisRepository)(nil).GetReadingPositionCtx), ctx, key)
+}
+
+// SetReadingPositionCtx mocks base method
+func (m *MockRedisRepository) SetReadingPositionCtx(ctx context.Context, key string, seconds int, position *models.ReadingPosition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadingPositionCtx", ctx, key, seconds, position)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReadingPositionCtx indicates an expected call of SetReadingPositionCtx
+func (mr *MockRedisRepositoryMockRecorder) SetReadingPositionCtx(ctx, key, seconds, position interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadingPositionCtx", reflect.TypeOf((*MockRedisRepository)(nil).SetReadingPositionCtx), ctx, key, seconds, position)
+}
+
+// IncrViewsCtx mocks base method
+func (m *MockRedisRepository) IncrViewsCtx(ctx context.Context, key, newsID string, views int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrViewsCtx", ctx, key, newsID, views)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrViewsCtx indicates an expected call of IncrViewsCtx
+func (mr *MockRedisRepositoryMockRecorder) IncrViewsCtx(ctx, key, newsID, views interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrViewsCtx", reflect.TypeOf((*MockRedisRepository)(nil).IncrViewsCtx), ctx, key, newsID, views)
+}
+
+// PopViewsCtx mocks base method
+func (m *MockRedisRepository) PopViewsCtx(ctx context.Context, key string) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PopViewsCtx", ctx, key)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PopViewsCtx indicates an expected call of PopViewsCtx
+func (mr *MockRedisRepositoryMockRecorder) PopViewsCtx(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopViewsCtx", reflect.TypeOf((*MockRedisRepository)(nil).PopViewsCtx), ctx, key)
+}
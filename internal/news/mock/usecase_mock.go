@@ -10,7 +10,9 @@ import (
 	utils "github.com/AleksK1NG/api-mc/pkg/utils"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
+	url "net/url"
 	reflect "reflect"
+	time "time"
 )
 
 // MockUseCase is a mock of UseCase interface
@@ -51,6 +53,22 @@ func (mr *MockUseCaseMockRecorder) Create(ctx, news interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUseCase)(nil).Create), ctx, news)
 }
 
+// CreateIfNotExists mocks base method
+func (m *MockUseCase) CreateIfNotExists(ctx context.Context, news *models.News) (*models.News, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIfNotExists", ctx, news)
+	ret0, _ := ret[0].(*models.News)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateIfNotExists indicates an expected call of CreateIfNotExists
+func (mr *MockUseCaseMockRecorder) CreateIfNotExists(ctx, news interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIfNotExists", reflect.TypeOf((*MockUseCase)(nil).CreateIfNotExists), ctx, news)
+}
+
 // Update mocks base method
 func (m *MockUseCase) Update(ctx context.Context, news *models.News) (*models.News, error) {
 	m.ctrl.T.Helper()
@@ -96,31 +114,834 @@ func (mr *MockUseCaseMockRecorder) Delete(ctx, newsID interface{}) *gomock.Call
 }
 
 // GetNews mocks base method
-func (m *MockUseCase) GetNews(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+func (m *MockUseCase) GetNews(ctx context.Context, pq *utils.PaginationQuery, params url.Values) (*models.NewsList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNews", ctx, pq)
+	ret := m.ctrl.Call(m, "GetNews", ctx, pq, params)
 	ret0, _ := ret[0].(*models.NewsList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetNews indicates an expected call of GetNews
-func (mr *MockUseCaseMockRecorder) GetNews(ctx, pq interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetNews(ctx, pq, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNews", reflect.TypeOf((*MockUseCase)(nil).GetNews), ctx, pq, params)
+}
+
+// GetNewsIDs mocks base method
+func (m *MockUseCase) GetNewsIDs(ctx context.Context, pq *utils.PaginationQuery, params url.Values) (*models.NewsIDList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsIDs", ctx, pq, params)
+	ret0, _ := ret[0].(*models.NewsIDList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewsIDs indicates an expected call of GetNewsIDs
+func (mr *MockUseCaseMockRecorder) GetNewsIDs(ctx, pq, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNews", reflect.TypeOf((*MockUseCase)(nil).GetNews), ctx, pq)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsIDs", reflect.TypeOf((*MockUseCase)(nil).GetNewsIDs), ctx, pq, params)
 }
 
 // SearchByTitle mocks base method
-func (m *MockUseCase) SearchByTitle(ctx context.Context, title string, query *utils.PaginationQuery) (*models.NewsList, error) {
+func (m *MockUseCase) SearchByTitle(ctx context.Context, title, scope string, query *utils.PaginationQuery) (*models.NewsList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchByTitle", ctx, title, query)
+	ret := m.ctrl.Call(m, "SearchByTitle", ctx, title, scope, query)
 	ret0, _ := ret[0].(*models.NewsList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchByTitle indicates an expected call of SearchByTitle
-func (mr *MockUseCaseMockRecorder) SearchByTitle(ctx, title, query interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) SearchByTitle(ctx, title, scope, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByTitle", reflect.TypeOf((*MockUseCase)(nil).SearchByTitle), ctx, title, scope, query)
+}
+
+// CountSearchByTitle mocks base method
+func (m *MockUseCase) CountSearchByTitle(ctx context.Context, title, scope string) (*models.NewsSearchCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSearchByTitle", ctx, title, scope)
+	ret0, _ := ret[0].(*models.NewsSearchCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSearchByTitle indicates an expected call of CountSearchByTitle
+func (mr *MockUseCaseMockRecorder) CountSearchByTitle(ctx, title, scope interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSearchByTitle", reflect.TypeOf((*MockUseCase)(nil).CountSearchByTitle), ctx, title, scope)
+}
+
+// GetTimeline mocks base method
+func (m *MockUseCase) GetTimeline(ctx context.Context) ([]*models.TimelineBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeline", ctx)
+	ret0, _ := ret[0].([]*models.TimelineBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimeline indicates an expected call of GetTimeline
+func (mr *MockUseCaseMockRecorder) GetTimeline(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeline", reflect.TypeOf((*MockUseCase)(nil).GetTimeline), ctx)
+}
+
+// GetNeighbors mocks base method
+func (m *MockUseCase) GetNeighbors(ctx context.Context, newsID uuid.UUID) (*models.News, *models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNeighbors", ctx, newsID)
+	ret0, _ := ret[0].(*models.News)
+	ret1, _ := ret[1].(*models.News)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNeighbors indicates an expected call of GetNeighbors
+func (mr *MockUseCaseMockRecorder) GetNeighbors(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNeighbors", reflect.TypeOf((*MockUseCase)(nil).GetNeighbors), ctx, newsID)
+}
+
+// UpdateStatusBatch mocks base method
+func (m *MockUseCase) UpdateStatusBatch(ctx context.Context, ids []uuid.UUID, status string, dryRun bool) (*models.NewsBatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatusBatch", ctx, ids, status, dryRun)
+	ret0, _ := ret[0].(*models.NewsBatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatusBatch indicates an expected call of UpdateStatusBatch
+func (mr *MockUseCaseMockRecorder) UpdateStatusBatch(ctx, ids, status, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatusBatch", reflect.TypeOf((*MockUseCase)(nil).UpdateStatusBatch), ctx, ids, status, dryRun)
+}
+
+// GetMyDrafts mocks base method
+func (m *MockUseCase) GetMyDrafts(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMyDrafts", ctx, pq)
+	ret0, _ := ret[0].(*models.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMyDrafts indicates an expected call of GetMyDrafts
+func (mr *MockUseCaseMockRecorder) GetMyDrafts(ctx, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyDrafts", reflect.TypeOf((*MockUseCase)(nil).GetMyDrafts), ctx, pq)
+}
+
+// GetEditedByMe mocks base method
+func (m *MockUseCase) GetEditedByMe(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEditedByMe", ctx, pq)
+	ret0, _ := ret[0].(*models.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEditedByMe indicates an expected call of GetEditedByMe
+func (mr *MockUseCaseMockRecorder) GetEditedByMe(ctx, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEditedByMe", reflect.TypeOf((*MockUseCase)(nil).GetEditedByMe), ctx, pq)
+}
+
+// StreamAuthorNews mocks base method
+func (m *MockUseCase) StreamAuthorNews(ctx context.Context, authorID uuid.UUID, fn func(*models.News) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamAuthorNews", ctx, authorID, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamAuthorNews indicates an expected call of StreamAuthorNews
+func (mr *MockUseCaseMockRecorder) StreamAuthorNews(ctx, authorID, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamAuthorNews", reflect.TypeOf((*MockUseCase)(nil).StreamAuthorNews), ctx, authorID, fn)
+}
+
+// GetOrphaned mocks base method
+func (m *MockUseCase) GetOrphaned(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrphaned", ctx, pq)
+	ret0, _ := ret[0].(*models.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrphaned indicates an expected call of GetOrphaned
+func (mr *MockUseCaseMockRecorder) GetOrphaned(ctx, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrphaned", reflect.TypeOf((*MockUseCase)(nil).GetOrphaned), ctx, pq)
+}
+
+// ReassignAuthor mocks base method
+func (m *MockUseCase) ReassignAuthor(ctx context.Context, ids []uuid.UUID, authorID uuid.UUID, dryRun bool) (*models.NewsBatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReassignAuthor", ctx, ids, authorID, dryRun)
+	ret0, _ := ret[0].(*models.NewsBatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReassignAuthor indicates an expected call of ReassignAuthor
+func (mr *MockUseCaseMockRecorder) ReassignAuthor(ctx, ids, authorID, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignAuthor", reflect.TypeOf((*MockUseCase)(nil).ReassignAuthor), ctx, ids, authorID, dryRun)
+}
+
+// GetFeed mocks base method
+func (m *MockUseCase) GetFeed(ctx context.Context, category, format, baseURL string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, category, format, baseURL)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed
+func (mr *MockUseCaseMockRecorder) GetFeed(ctx, category, format, baseURL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockUseCase)(nil).GetFeed), ctx, category, format, baseURL)
+}
+
+// GetRecentlyUpdated mocks base method
+func (m *MockUseCase) GetRecentlyUpdated(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentlyUpdated", ctx, pq)
+	ret0, _ := ret[0].(*models.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentlyUpdated indicates an expected call of GetRecentlyUpdated
+func (mr *MockUseCaseMockRecorder) GetRecentlyUpdated(ctx, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentlyUpdated", reflect.TypeOf((*MockUseCase)(nil).GetRecentlyUpdated), ctx, pq)
+}
+
+// GetWithoutComments mocks base method
+func (m *MockUseCase) GetWithoutComments(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithoutComments", ctx, pq)
+	ret0, _ := ret[0].(*models.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithoutComments indicates an expected call of GetWithoutComments
+func (mr *MockUseCaseMockRecorder) GetWithoutComments(ctx, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithoutComments", reflect.TypeOf((*MockUseCase)(nil).GetWithoutComments), ctx, pq)
+}
+
+// CreatePreviewToken mocks base method
+func (m *MockUseCase) CreatePreviewToken(ctx context.Context, newsID uuid.UUID) (*models.NewsPreviewToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePreviewToken", ctx, newsID)
+	ret0, _ := ret[0].(*models.NewsPreviewToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePreviewToken indicates an expected call of CreatePreviewToken
+func (mr *MockUseCaseMockRecorder) CreatePreviewToken(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePreviewToken", reflect.TypeOf((*MockUseCase)(nil).CreatePreviewToken), ctx, newsID)
+}
+
+// GetPreview mocks base method
+func (m *MockUseCase) GetPreview(ctx context.Context, newsID uuid.UUID, token string) (*models.NewsBase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreview", ctx, newsID, token)
+	ret0, _ := ret[0].(*models.NewsBase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreview indicates an expected call of GetPreview
+func (mr *MockUseCaseMockRecorder) GetPreview(ctx, newsID, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreview", reflect.TypeOf((*MockUseCase)(nil).GetPreview), ctx, newsID, token)
+}
+
+// StreamNews mocks base method
+func (m *MockUseCase) StreamNews(ctx context.Context, pq *utils.PaginationQuery, params url.Values, fn func(*models.News) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamNews", ctx, pq, params, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamNews indicates an expected call of StreamNews
+func (mr *MockUseCaseMockRecorder) StreamNews(ctx, pq, params, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamNews", reflect.TypeOf((*MockUseCase)(nil).StreamNews), ctx, pq, params, fn)
+}
+
+// StreamSearchByTitle mocks base method
+func (m *MockUseCase) StreamSearchByTitle(ctx context.Context, title, scope string, pq *utils.PaginationQuery, fn func(*models.News) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamSearchByTitle", ctx, title, scope, pq, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamSearchByTitle indicates an expected call of StreamSearchByTitle
+func (mr *MockUseCaseMockRecorder) StreamSearchByTitle(ctx, title, scope, pq, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamSearchByTitle", reflect.TypeOf((*MockUseCase)(nil).StreamSearchByTitle), ctx, title, scope, pq, fn)
+}
+
+// Pin mocks base method
+func (m *MockUseCase) Pin(ctx context.Context, newsID uuid.UUID, until *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pin", ctx, newsID, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pin indicates an expected call of Pin
+func (mr *MockUseCaseMockRecorder) Pin(ctx, newsID, until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pin", reflect.TypeOf((*MockUseCase)(nil).Pin), ctx, newsID, until)
+}
+
+// Unpin mocks base method
+func (m *MockUseCase) Unpin(ctx context.Context, newsID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unpin", ctx, newsID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unpin indicates an expected call of Unpin
+func (mr *MockUseCaseMockRecorder) Unpin(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unpin", reflect.TypeOf((*MockUseCase)(nil).Unpin), ctx, newsID)
+}
+
+// GetFeatured mocks base method
+func (m *MockUseCase) GetFeatured(ctx context.Context) ([]*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeatured", ctx)
+	ret0, _ := ret[0].([]*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeatured indicates an expected call of GetFeatured
+func (mr *MockUseCaseMockRecorder) GetFeatured(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeatured", reflect.TypeOf((*MockUseCase)(nil).GetFeatured), ctx)
+}
+
+// GetRevisions mocks base method
+func (m *MockUseCase) GetRevisions(ctx context.Context, newsID uuid.UUID) ([]*models.NewsRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", ctx, newsID)
+	ret0, _ := ret[0].([]*models.NewsRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions
+func (mr *MockUseCaseMockRecorder) GetRevisions(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockUseCase)(nil).GetRevisions), ctx, newsID)
+}
+
+// GetRevision mocks base method
+func (m *MockUseCase) GetRevision(ctx context.Context, revisionID uuid.UUID) (*models.NewsRevisionSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", ctx, revisionID)
+	ret0, _ := ret[0].(*models.NewsRevisionSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision
+func (mr *MockUseCaseMockRecorder) GetRevision(ctx, revisionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockUseCase)(nil).GetRevision), ctx, revisionID)
+}
+
+// RevertTo mocks base method
+func (m *MockUseCase) RevertTo(ctx context.Context, newsID, revisionID uuid.UUID) (*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertTo", ctx, newsID, revisionID)
+	ret0, _ := ret[0].(*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertTo indicates an expected call of RevertTo
+func (mr *MockUseCaseMockRecorder) RevertTo(ctx, newsID, revisionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertTo", reflect.TypeOf((*MockUseCase)(nil).RevertTo), ctx, newsID, revisionID)
+}
+
+// GetAuthorLeaderboard mocks base method
+func (m *MockUseCase) GetAuthorLeaderboard(ctx context.Context, window time.Duration, limit int) ([]*models.AuthorStat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthorLeaderboard", ctx, window, limit)
+	ret0, _ := ret[0].([]*models.AuthorStat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthorLeaderboard indicates an expected call of GetAuthorLeaderboard
+func (mr *MockUseCaseMockRecorder) GetAuthorLeaderboard(ctx, window, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorLeaderboard", reflect.TypeOf((*MockUseCase)(nil).GetAuthorLeaderboard), ctx, window, limit)
+}
+
+// CountByStatus mocks base method
+func (m *MockUseCase) CountByStatus(ctx context.Context) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByStatus", ctx)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByStatus indicates an expected call of CountByStatus
+func (mr *MockUseCaseMockRecorder) CountByStatus(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByStatus", reflect.TypeOf((*MockUseCase)(nil).CountByStatus), ctx)
+}
+
+// GetAuthorStatusCounts mocks base method
+func (m *MockUseCase) GetAuthorStatusCounts(ctx context.Context, authorID uuid.UUID) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthorStatusCounts", ctx, authorID)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthorStatusCounts indicates an expected call of GetAuthorStatusCounts
+func (mr *MockUseCaseMockRecorder) GetAuthorStatusCounts(ctx, authorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorStatusCounts", reflect.TypeOf((*MockUseCase)(nil).GetAuthorStatusCounts), ctx, authorID)
+}
+
+// GetBySlugs mocks base method
+func (m *MockUseCase) GetBySlugs(ctx context.Context, slugs []string) ([]*models.NewsBase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySlugs", ctx, slugs)
+	ret0, _ := ret[0].([]*models.NewsBase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySlugs indicates an expected call of GetBySlugs
+func (mr *MockUseCaseMockRecorder) GetBySlugs(ctx, slugs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlugs", reflect.TypeOf((*MockUseCase)(nil).GetBySlugs), ctx, slugs)
+}
+
+// SetMetadata mocks base method
+func (m *MockUseCase) SetMetadata(ctx context.Context, newsID uuid.UUID, metadata models.Metadata) (*models.NewsMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMetadata", ctx, newsID, metadata)
+	ret0, _ := ret[0].(*models.NewsMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetMetadata indicates an expected call of SetMetadata
+func (mr *MockUseCaseMockRecorder) SetMetadata(ctx, newsID, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMetadata", reflect.TypeOf((*MockUseCase)(nil).SetMetadata), ctx, newsID, metadata)
+}
+
+// GetMetadata mocks base method
+func (m *MockUseCase) GetMetadata(ctx context.Context, newsID uuid.UUID) (*models.NewsMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetadata", ctx, newsID)
+	ret0, _ := ret[0].(*models.NewsMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMetadata indicates an expected call of GetMetadata
+func (mr *MockUseCaseMockRecorder) GetMetadata(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockUseCase)(nil).GetMetadata), ctx, newsID)
+}
+
+// GetCacheEntry mocks base method
+func (m *MockUseCase) GetCacheEntry(ctx context.Context, newsID uuid.UUID) (*models.NewsCacheEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCacheEntry", ctx, newsID)
+	ret0, _ := ret[0].(*models.NewsCacheEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCacheEntry indicates an expected call of GetCacheEntry
+func (mr *MockUseCaseMockRecorder) GetCacheEntry(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCacheEntry", reflect.TypeOf((*MockUseCase)(nil).GetCacheEntry), ctx, newsID)
+}
+
+// SetTags mocks base method
+func (m *MockUseCase) SetTags(ctx context.Context, newsID uuid.UUID, tags []string) (*models.NewsTags, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTags", ctx, newsID, tags)
+	ret0, _ := ret[0].(*models.NewsTags)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTags indicates an expected call of SetTags
+func (mr *MockUseCaseMockRecorder) SetTags(ctx, newsID, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTags", reflect.TypeOf((*MockUseCase)(nil).SetTags), ctx, newsID, tags)
+}
+
+// GetTags mocks base method
+func (m *MockUseCase) GetTags(ctx context.Context, newsID uuid.UUID) (*models.NewsTags, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTags", ctx, newsID)
+	ret0, _ := ret[0].(*models.NewsTags)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags
+func (mr *MockUseCaseMockRecorder) GetTags(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockUseCase)(nil).GetTags), ctx, newsID)
+}
+
+// AddTagToMany mocks base method
+func (m *MockUseCase) AddTagToMany(ctx context.Context, ids []uuid.UUID, tag string) (*models.NewsTagBatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTagToMany", ctx, ids, tag)
+	ret0, _ := ret[0].(*models.NewsTagBatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTagToMany indicates an expected call of AddTagToMany
+func (mr *MockUseCaseMockRecorder) AddTagToMany(ctx, ids, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagToMany", reflect.TypeOf((*MockUseCase)(nil).AddTagToMany), ctx, ids, tag)
+}
+
+// SetTranslation mocks base method
+func (m *MockUseCase) SetTranslation(ctx context.Context, newsID uuid.UUID, locale string, translation *models.NewsTranslation) (*models.NewsTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTranslation", ctx, newsID, locale, translation)
+	ret0, _ := ret[0].(*models.NewsTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTranslation indicates an expected call of SetTranslation
+func (mr *MockUseCaseMockRecorder) SetTranslation(ctx, newsID, locale, translation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTranslation", reflect.TypeOf((*MockUseCase)(nil).SetTranslation), ctx, newsID, locale, translation)
+}
+
+// GetTranslation mocks base method
+func (m *MockUseCase) GetTranslation(ctx context.Context, newsID uuid.UUID, locale string) (*models.NewsTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTranslation", ctx, newsID, locale)
+	ret0, _ := ret[0].(*models.NewsTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTranslation indicates an expected call of GetTranslation
+func (mr *MockUseCaseMockRecorder) GetTranslation(ctx, newsID, locale interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTranslation", reflect.TypeOf((*MockUseCase)(nil).GetTranslation), ctx, newsID, locale)
+}
+
+// SaveReadingPosition mocks base method
+func (m *MockUseCase) SaveReadingPosition(ctx context.Context, newsID uuid.UUID, position float64) (*models.ReadingPosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveReadingPosition", ctx, newsID, position)
+	ret0, _ := ret[0].(*models.ReadingPosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveReadingPosition indicates an expected call of SaveReadingPosition
+func (mr *MockUseCaseMockRecorder) SaveReadingPosition(ctx, newsID, position interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveReadingPosition", reflect.TypeOf((*MockUseCase)(nil).SaveReadingPosition), ctx, newsID, position)
+}
+
+// GetReadingPosition mocks base method
+func (m *MockUseCase) GetReadingPosition(ctx context.Context, newsID uuid.UUID) (*models.ReadingPosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadingPosition", ctx, newsID)
+	ret0, _ := ret[0].(*models.ReadingPosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadingPosition indicates an expected call of GetReadingPosition
+func (mr *MockUseCaseMockRecorder) GetReadingPosition(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadingPosition", reflect.TypeOf((*MockUseCase)(nil).GetReadingPosition), ctx, newsID)
+}
+
+// GetNewsByIDInLocale mocks base method
+func (m *MockUseCase) GetNewsByIDInLocale(ctx context.Context, newsID uuid.UUID, locale string) (*models.NewsBase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewsByIDInLocale", ctx, newsID, locale)
+	ret0, _ := ret[0].(*models.NewsBase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewsByIDInLocale indicates an expected call of GetNewsByIDInLocale
+func (mr *MockUseCaseMockRecorder) GetNewsByIDInLocale(ctx, newsID, locale interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewsByIDInLocale", reflect.TypeOf((*MockUseCase)(nil).GetNewsByIDInLocale), ctx, newsID, locale)
+}
+
+// GetChangedSince mocks base method
+func (m *MockUseCase) GetChangedSince(ctx context.Context, since time.Time, pq *utils.PaginationQuery) (*models.NewsChangesList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangedSince", ctx, since, pq)
+	ret0, _ := ret[0].(*models.NewsChangesList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangedSince indicates an expected call of GetChangedSince
+func (mr *MockUseCaseMockRecorder) GetChangedSince(ctx, since, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangedSince", reflect.TypeOf((*MockUseCase)(nil).GetChangedSince), ctx, since, pq)
+}
+
+// GetActivityFeed mocks base method
+func (m *MockUseCase) GetActivityFeed(ctx context.Context, action string, pq *utils.PaginationQuery) (*models.NewsEventList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityFeed", ctx, action, pq)
+	ret0, _ := ret[0].(*models.NewsEventList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityFeed indicates an expected call of GetActivityFeed
+func (mr *MockUseCaseMockRecorder) GetActivityFeed(ctx, action, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityFeed", reflect.TypeOf((*MockUseCase)(nil).GetActivityFeed), ctx, action, pq)
+}
+
+// PurgeDeleted mocks base method
+func (m *MockUseCase) PurgeDeleted(ctx context.Context, olderThan time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeleted", ctx, olderThan)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeleted indicates an expected call of PurgeDeleted
+func (mr *MockUseCaseMockRecorder) PurgeDeleted(ctx, olderThan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeleted", reflect.TypeOf((*MockUseCase)(nil).PurgeDeleted), ctx, olderThan)
+}
+
+// RecomputeCommentCounts mocks base method
+func (m *MockUseCase) RecomputeCommentCounts(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecomputeCommentCounts", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecomputeCommentCounts indicates an expected call of RecomputeCommentCounts
+func (mr *MockUseCaseMockRecorder) RecomputeCommentCounts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeCommentCounts", reflect.TypeOf((*MockUseCase)(nil).RecomputeCommentCounts), ctx)
+}
+
+// FlushViews mocks base method
+func (m *MockUseCase) FlushViews(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlushViews", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FlushViews indicates an expected call of FlushViews
+func (mr *MockUseCaseMockRecorder) FlushViews(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushViews", reflect.TypeOf((*MockUseCase)(nil).FlushViews), ctx)
+}
+
+// GetBrokenImages mocks base method
+func (m *MockUseCase) GetBrokenImages(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBrokenImages", ctx, pq)
+	ret0, _ := ret[0].(*models.NewsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBrokenImages indicates an expected call of GetBrokenImages
+func (mr *MockUseCaseMockRecorder) GetBrokenImages(ctx, pq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrokenImages", reflect.TypeOf((*MockUseCase)(nil).GetBrokenImages), ctx, pq)
+}
+
+// GetLatestPerCategory mocks base method
+func (m *MockUseCase) GetLatestPerCategory(ctx context.Context, categories []string) (map[string]*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestPerCategory", ctx, categories)
+	ret0, _ := ret[0].(map[string]*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestPerCategory indicates an expected call of GetLatestPerCategory
+func (mr *MockUseCaseMockRecorder) GetLatestPerCategory(ctx, categories interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestPerCategory", reflect.TypeOf((*MockUseCase)(nil).GetLatestPerCategory), ctx, categories)
+}
+
+// GetLatestUpdatePerAuthor mocks base method
+func (m *MockUseCase) GetLatestUpdatePerAuthor(ctx context.Context, authorIDs []uuid.UUID) (map[uuid.UUID]*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestUpdatePerAuthor", ctx, authorIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestUpdatePerAuthor indicates an expected call of GetLatestUpdatePerAuthor
+func (mr *MockUseCaseMockRecorder) GetLatestUpdatePerAuthor(ctx, authorIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestUpdatePerAuthor", reflect.TypeOf((*MockUseCase)(nil).GetLatestUpdatePerAuthor), ctx, authorIDs)
+}
+
+// AddRelation mocks base method
+func (m *MockUseCase) AddRelation(ctx context.Context, fromID, toID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRelation", ctx, fromID, toID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRelation indicates an expected call of AddRelation
+func (mr *MockUseCaseMockRecorder) AddRelation(ctx, fromID, toID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRelation", reflect.TypeOf((*MockUseCase)(nil).AddRelation), ctx, fromID, toID)
+}
+
+// RemoveRelation mocks base method
+func (m *MockUseCase) RemoveRelation(ctx context.Context, fromID, toID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRelation", ctx, fromID, toID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRelation indicates an expected call of RemoveRelation
+func (mr *MockUseCaseMockRecorder) RemoveRelation(ctx, fromID, toID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRelation", reflect.TypeOf((*MockUseCase)(nil).RemoveRelation), ctx, fromID, toID)
+}
+
+// GetCuratedRelated mocks base method
+func (m *MockUseCase) GetCuratedRelated(ctx context.Context, newsID uuid.UUID) ([]*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCuratedRelated", ctx, newsID)
+	ret0, _ := ret[0].([]*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCuratedRelated indicates an expected call of GetCuratedRelated
+func (mr *MockUseCaseMockRecorder) GetCuratedRelated(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCuratedRelated", reflect.TypeOf((*MockUseCase)(nil).GetCuratedRelated), ctx, newsID)
+}
+
+// GetTrending mocks base method
+func (m *MockUseCase) GetTrending(ctx context.Context, limit int) ([]*models.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrending", ctx, limit)
+	ret0, _ := ret[0].([]*models.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrending indicates an expected call of GetTrending
+func (mr *MockUseCaseMockRecorder) GetTrending(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrending", reflect.TypeOf((*MockUseCase)(nil).GetTrending), ctx, limit)
+}
+
+// Summarize mocks base method
+func (m *MockUseCase) Summarize(ctx context.Context, list *models.NewsList) (*models.NewsSummaryList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summarize", ctx, list)
+	ret0, _ := ret[0].(*models.NewsSummaryList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summarize indicates an expected call of Summarize
+func (mr *MockUseCaseMockRecorder) Summarize(ctx, list interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summarize", reflect.TypeOf((*MockUseCase)(nil).Summarize), ctx, list)
+}
+
+// AcquireEditLock mocks base method
+func (m *MockUseCase) AcquireEditLock(ctx context.Context, newsID uuid.UUID, ttl time.Duration) (*models.NewsEditLock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireEditLock", ctx, newsID, ttl)
+	ret0, _ := ret[0].(*models.NewsEditLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireEditLock indicates an expected call of AcquireEditLock
+func (mr *MockUseCaseMockRecorder) AcquireEditLock(ctx, newsID, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireEditLock", reflect.TypeOf((*MockUseCase)(nil).AcquireEditLock), ctx, newsID, ttl)
+}
+
+// ReleaseEditLock mocks base method
+func (m *MockUseCase) ReleaseEditLock(ctx context.Context, newsID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseEditLock", ctx, newsID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseEditLock indicates an expected call of ReleaseEditLock
+func (mr *MockUseCaseMockRecorder) ReleaseEditLock(ctx, newsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseEditLock", reflect.TypeOf((*MockUseCase)(nil).ReleaseEditLock), ctx, newsID)
+}
+
+// GetEditLock mocks base method
+func (m *MockUseCase) GetEditLock(ctx context.Context, newsID uuid.UUID) (*models.NewsEditLock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEditLock", ctx, newsID)
+	ret0, _ := ret[0].(*models.NewsEditLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEditLock indicates an expected call of GetEditLock
+func (mr *MockUseCaseMockRecorder) GetEditLock(ctx, newsID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByTitle", reflect.TypeOf((*MockUseCase)(nil).SearchByTitle), ctx, title, query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEditLock", reflect.TypeOf((*MockUseCase)(nil).GetEditLock), ctx, newsID)
 }
@@ -3,6 +3,7 @@ package news
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -12,10 +13,68 @@ import (
 
 // News Repository
 type Repository interface {
+	// Post-commit hooks of mutations
+	Hooks() *Hooks
 	Create(ctx context.Context, news *models.News) (*models.News, error)
-	Update(ctx context.Context, news *models.News) (*models.News, error)
+	CreateIfNotExists(ctx context.Context, news *models.News) (*models.News, bool, error)
+	Update(ctx context.Context, news *models.News, actorID uuid.UUID) (*models.News, error)
 	GetNewsByID(ctx context.Context, newsID uuid.UUID) (*models.NewsBase, error)
-	Delete(ctx context.Context, newsID uuid.UUID) error
-	GetNews(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error)
-	SearchByTitle(ctx context.Context, title string, query *utils.PaginationQuery) (*models.NewsList, error)
+	Delete(ctx context.Context, newsID uuid.UUID, actorID uuid.UUID) error
+	GetActivityFeed(ctx context.Context, action string, pq *utils.PaginationQuery) (*models.NewsEventList, error)
+	GetNews(ctx context.Context, lq *utils.ListQuery, pq *utils.PaginationQuery) (*models.NewsList, error)
+	GetNewsIDs(ctx context.Context, lq *utils.ListQuery, pq *utils.PaginationQuery) (*models.NewsIDList, error)
+	SearchByTitle(ctx context.Context, title string, scope models.SearchScope, query *utils.PaginationQuery) (*models.NewsList, error)
+	CountSearchByTitle(ctx context.Context, title string, scope models.SearchScope) (int, error)
+	GetTimeline(ctx context.Context) ([]*models.TimelineBucket, error)
+	GetNeighbors(ctx context.Context, newsID uuid.UUID) (prev *models.News, next *models.News, err error)
+	UpdateStatusBatch(ctx context.Context, ids []uuid.UUID, status string, dryRun bool) ([]uuid.UUID, error)
+	GetMyDrafts(ctx context.Context, authorID uuid.UUID, pq *utils.PaginationQuery) (*models.NewsList, error)
+	GetEditedBy(ctx context.Context, userID uuid.UUID, pq *utils.PaginationQuery) (*models.NewsList, error)
+	StreamAuthorNews(ctx context.Context, authorID uuid.UUID, fn func(n *models.News) error) error
+	GetOrphaned(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error)
+	ReassignAuthor(ctx context.Context, ids []uuid.UUID, authorID uuid.UUID, dryRun bool) ([]uuid.UUID, error)
+	GetOutsideTenant(ctx context.Context, ids []uuid.UUID, tenantID uuid.UUID) ([]uuid.UUID, error)
+	GetRecent(ctx context.Context, category string, limit int) ([]*models.News, error)
+	IterateCategory(ctx context.Context, category string) Iterator
+	GetRecentlyUpdated(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error)
+	GetWithoutComments(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error)
+	Close() error
+	FindSimilarTitles(ctx context.Context, title string, threshold float64, authorID uuid.UUID, tenantID *uuid.UUID) ([]*models.NewsRef, error)
+	StreamNews(ctx context.Context, lq *utils.ListQuery, pq *utils.PaginationQuery, fn func(n *models.News) error) error
+	StreamSearchByTitle(ctx context.Context, title string, scope models.SearchScope, pq *utils.PaginationQuery, fn func(n *models.News) error) error
+	Pin(ctx context.Context, newsID uuid.UUID, until *time.Time) error
+	Unpin(ctx context.Context, newsID uuid.UUID) error
+	GetFeatured(ctx context.Context, limit int) ([]*models.News, error)
+	GetRevisions(ctx context.Context, newsID uuid.UUID) ([]*models.NewsRevision, error)
+	GetRevision(ctx context.Context, revisionID uuid.UUID) (*models.NewsRevisionSnapshot, error)
+	RevertTo(ctx context.Context, newsID uuid.UUID, revisionID uuid.UUID, actorID uuid.UUID) (*models.News, error)
+	GetAuthorLeaderboard(ctx context.Context, since time.Time, limit int) ([]*models.AuthorStat, error)
+	CountByStatus(ctx context.Context) (map[string]int, error)
+	GetAuthorStatusCounts(ctx context.Context, authorID uuid.UUID) (map[string]int, error)
+	GetNewsByContentHash(ctx context.Context, hash string, tenantID *uuid.UUID) (*models.News, error)
+	GetNewsBySlugs(ctx context.Context, slugs []string) ([]*models.NewsBase, error)
+	GetBySlugsOrdered(ctx context.Context, slugs []string) ([]*models.NewsBase, error)
+	GetUnknownCategories(ctx context.Context, categories []string) ([]string, error)
+	SetMetadata(ctx context.Context, newsID uuid.UUID, metadata models.Metadata) error
+	GetMetadata(ctx context.Context, newsID uuid.UUID) (models.Metadata, error)
+	SetTags(ctx context.Context, newsID uuid.UUID, tags []string) error
+	GetTags(ctx context.Context, newsID uuid.UUID) ([]string, error)
+	AddTagToMany(ctx context.Context, ids []uuid.UUID, tag string) (int, error)
+	SetTranslation(ctx context.Context, translation *models.NewsTranslation) (*models.NewsTranslation, error)
+	GetTranslation(ctx context.Context, newsID uuid.UUID, locale string) (*models.NewsTranslation, error)
+	SaveReadingPosition(ctx context.Context, position *models.ReadingPosition) (*models.ReadingPosition, error)
+	GetReadingPosition(ctx context.Context, userID uuid.UUID, newsID uuid.UUID) (*models.ReadingPosition, error)
+	GetChangedSince(ctx context.Context, since time.Time, pq *utils.PaginationQuery) (*models.NewsChangesList, error)
+	PurgeDeleted(ctx context.Context, before time.Time, batchSize int) ([]uuid.UUID, error)
+	RecomputeCommentCounts(ctx context.Context, batchSize int) (int, error)
+	GetLatestPerCategory(ctx context.Context, categories []string) (map[string]*models.News, error)
+	GetLatestUpdatePerAuthor(ctx context.Context, authorIDs []uuid.UUID) (map[uuid.UUID]*models.News, error)
+	AddRelation(ctx context.Context, fromID uuid.UUID, toID uuid.UUID) (bool, error)
+	RemoveRelation(ctx context.Context, fromID uuid.UUID, toID uuid.UUID) error
+	GetCuratedRelated(ctx context.Context, newsID uuid.UUID) ([]*models.News, error)
+	AddViews(ctx context.Context, ids []uuid.UUID, views []int64) error
+	GetTrending(ctx context.Context, gravity float64, limit int) ([]*models.News, error)
+	GetNewsImages(ctx context.Context, afterID uuid.UUID, limit int) ([]*models.NewsImage, error)
+	SetImageBroken(ctx context.Context, checked []uuid.UUID, broken []uuid.UUID) error
+	GetBrokenImages(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error)
 }
@@ -3,6 +3,9 @@ package news
 
 import (
 	"context"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/AleksK1NG/api-mc/internal/models"
 )
@@ -12,4 +15,41 @@ type RedisRepository interface {
 	GetNewsByIDCtx(ctx context.Context, key string) (*models.NewsBase, error)
 	SetNewsCtx(ctx context.Context, key string, seconds int, news *models.NewsBase) error
 	DeleteNewsCtx(ctx context.Context, key string) error
+	GetNewsByKeysCtx(ctx context.Context, keys []string) ([]*models.NewsBase, error)
+	GetNewsIDsCtx(ctx context.Context, keys []string) ([]string, error)
+	SetNewsIDCtx(ctx context.Context, key string, seconds int, newsID string) error
+	GetRawCtx(ctx context.Context, key string) ([]byte, time.Duration, error)
+	GetNewsWithFreshnessCtx(ctx context.Context, key string) (*models.NewsBase, time.Time, error)
+	SetNewsWithFreshnessCtx(ctx context.Context, key string, seconds int, freshUntil time.Time, news *models.NewsBase) error
+	GetTimelineCtx(ctx context.Context, key string) ([]*models.TimelineBucket, error)
+	SetTimelineCtx(ctx context.Context, key string, seconds int, timeline []*models.TimelineBucket) error
+	GetFeedCtx(ctx context.Context, key string) ([]byte, error)
+	SetFeedCtx(ctx context.Context, key string, seconds int, feed []byte) error
+	GetFeaturedCtx(ctx context.Context, key string) ([]*models.News, error)
+	SetFeaturedCtx(ctx context.Context, key string, seconds int, featured []*models.News) error
+	GetLatestPerCategoryCtx(ctx context.Context, key string) (map[string]*models.News, error)
+	SetLatestPerCategoryCtx(ctx context.Context, key string, seconds int, latest map[string]*models.News) error
+	GetLatestPerAuthorCtx(ctx context.Context, key string) (map[uuid.UUID]*models.News, error)
+	SetLatestPerAuthorCtx(ctx context.Context, key string, seconds int, latest map[uuid.UUID]*models.News) error
+	GetRelatedCtx(ctx context.Context, key string) ([]*models.News, error)
+	SetRelatedCtx(ctx context.Context, key string, seconds int, related []*models.News) error
+	AcquireEditLockCtx(ctx context.Context, key string, userID string, ttl time.Duration) (bool, error)
+	ReleaseEditLockCtx(ctx context.Context, key string, userID string) (bool, error)
+	GetEditLockCtx(ctx context.Context, key string) (string, time.Duration, error)
+	GetNewsListCtx(ctx context.Context, key string) (*models.NewsList, error)
+	SetNewsListCtx(ctx context.Context, key string, seconds int, newsList *models.NewsList) error
+	GetTrendingCtx(ctx context.Context, key string) ([]*models.News, error)
+	SetTrendingCtx(ctx context.Context, key string, seconds int, trending []*models.News) error
+	GetActivityFeedCtx(ctx context.Context, key string) (*models.NewsEventList, error)
+	SetActivityFeedCtx(ctx context.Context, key string, seconds int, feed *models.NewsEventList) error
+	GetLeaderboardCtx(ctx context.Context, key string) ([]*models.AuthorStat, error)
+	SetLeaderboardCtx(ctx context.Context, key string, seconds int, stats []*models.AuthorStat) error
+	GetStatusCountsCtx(ctx context.Context, key string) (map[string]int, error)
+	SetStatusCountsCtx(ctx context.Context, key string, seconds int, counts map[string]int) error
+	GetTranslationCtx(ctx context.Context, key string) (*models.NewsTranslation, error)
+	SetTranslationCtx(ctx context.Context, key string, seconds int, translation *models.NewsTranslation) error
+	GetReadingPositionCtx(ctx context.Context, key string) (*models.ReadingPosition, error)
+	SetReadingPositionCtx(ctx context.Context, key string, seconds int, position *models.ReadingPosition) error
+	IncrViewsCtx(ctx context.Context, key string, newsID string, views int64) error
+	PopViewsCtx(ctx context.Context, key string) (map[string]int64, error)
 }
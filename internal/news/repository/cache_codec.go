@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"encoding/json"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Serialization of cached values, both codecs read and write the same plain JSON
+// so Redis.FastCacheCodec can be flipped while cache is populated
+type cacheCodec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// encoding/json codec
+type stdCodec struct{}
+
+func (stdCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (stdCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// Cache codec constructor, fast one is json-iterator configured to behave like encoding/json
+func newCacheCodec(fast bool) cacheCodec {
+	if fast {
+		return jsoniter.ConfigCompatibleWithStandardLibrary
+	}
+	return stdCodec{}
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/api-mc/internal/models"
+	"github.com/AleksK1NG/api-mc/internal/news"
+)
+
+const categoryIteratorBatchSize = 100
+
+// Iterate all news of category in news id order, pages by keyset so news added or removed meanwhile never shift batches
+func (r *newsRepo) IterateCategory(ctx context.Context, category string) news.Iterator {
+	return r.iterateCategory(ctx, category, categoryIteratorBatchSize)
+}
+
+func (r *newsRepo) iterateCategory(ctx context.Context, category string, batchSize int) *categoryIterator {
+	return &categoryIterator{repo: r, ctx: ctx, category: category, batchSize: batchSize, afterID: uuid.Nil}
+}
+
+// Keyset paged iterator over news of one category
+type categoryIterator struct {
+	repo      *newsRepo
+	ctx       context.Context
+	category  string
+	batchSize int
+	afterID   uuid.UUID
+	batch     []*models.News
+	pos       int
+	current   *models.News
+	// Last fetched batch was short, nothing is left past it
+	last bool
+	err  error
+}
+
+func (it *categoryIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		it.current = nil
+		return false
+	}
+
+	if it.pos >= len(it.batch) {
+		if it.last || !it.fetch() {
+			it.current = nil
+			return false
+		}
+	}
+
+	it.current = it.batch[it.pos]
+	it.pos++
+	return true
+}
+
+func (it *categoryIterator) News() *models.News {
+	return it.current
+}
+
+func (it *categoryIterator) Err() error {
+	return it.err
+}
+
+// Fetch batch following the last yielded news, false when there is none
+func (it *categoryIterator) fetch() bool {
+	span, ctx := opentracing.StartSpanFromContext(it.ctx, "newsRepo.IterateCategory.fetch")
+	defer span.Finish()
+
+	batch := make([]*models.News, 0, it.batchSize)
+	if err := it.repo.timer.SelectContext(ctx, it.repo.db, "getCategoryNewsAfter", &batch, getCategoryNewsAfter, it.category, it.afterID, it.batchSize, tenantArg(ctx)); err != nil {
+		it.err = errors.Wrap(err, "newsRepo.IterateCategory.SelectContext")
+		return false
+	}
+
+	it.batch = batch
+	it.pos = 0
+	it.last = len(batch) < it.batchSize
+	if len(batch) == 0 {
+		return false
+	}
+	it.afterID = batch[len(batch)-1].NewsID
+	return true
+}
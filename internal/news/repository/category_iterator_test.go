@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/config"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+)
+
+func TestNewsRepo_IterateCategory(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	repo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil)).(*newsRepo)
+
+	ids := make([]uuid.UUID, 0, 5)
+	for i := 0; i < 5; i++ {
+		ids = append(ids, uuid.New())
+	}
+	expectBatch := func(afterID uuid.UUID, batch ...uuid.UUID) {
+		rows := sqlmock.NewRows([]string{"news_id", "category"})
+		for _, id := range batch {
+			rows.AddRow(id, "go")
+		}
+		mock.ExpectQuery(getCategoryNewsAfter).WithArgs("go", afterID, 2, nil).WillReturnRows(rows)
+	}
+
+	t.Run("Across pages", func(t *testing.T) {
+		expectBatch(uuid.Nil, ids[0], ids[1])
+		expectBatch(ids[1], ids[2], ids[3])
+		expectBatch(ids[3], ids[4])
+
+		it := repo.iterateCategory(context.Background(), "go", 2)
+		yielded := make([]uuid.UUID, 0, len(ids))
+		for it.Next() {
+			yielded = append(yielded, it.News().NewsID)
+		}
+		require.NoError(t, it.Err())
+		require.Equal(t, ids, yielded)
+		require.Nil(t, it.News())
+		require.False(t, it.Next())
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Last page full", func(t *testing.T) {
+		expectBatch(uuid.Nil, ids[0], ids[1])
+		expectBatch(ids[1], ids[2], ids[3])
+		expectBatch(ids[3])
+
+		it := repo.iterateCategory(context.Background(), "go", 2)
+		count := 0
+		for it.Next() {
+			count++
+		}
+		require.NoError(t, it.Err())
+		require.Equal(t, 4, count)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Empty category", func(t *testing.T) {
+		expectBatch(uuid.Nil)
+
+		it := repo.iterateCategory(context.Background(), "go", 2)
+		require.False(t, it.Next())
+		require.NoError(t, it.Err())
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Context cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		expectBatch(uuid.Nil, ids[0], ids[1])
+
+		it := repo.iterateCategory(ctx, "go", 2)
+		require.True(t, it.Next())
+		cancel()
+		require.False(t, it.Next())
+		require.True(t, errors.Is(it.Err(), context.Canceled))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Fetch error", func(t *testing.T) {
+		expectBatch(uuid.Nil, ids[0], ids[1])
+		mock.ExpectQuery(getCategoryNewsAfter).WithArgs("go", ids[1], 2, nil).WillReturnError(errors.New("connection reset"))
+
+		it := repo.iterateCategory(context.Background(), "go", 2)
+		count := 0
+		for it.Next() {
+			count++
+		}
+		require.Equal(t, 2, count)
+		require.Error(t, it.Err())
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
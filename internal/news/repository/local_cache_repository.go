@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/api-mc/internal/models"
+	"github.com/AleksK1NG/api-mc/internal/news"
+	"github.com/AleksK1NG/api-mc/pkg/localcache"
+)
+
+// News cache with in memory layer in front of redis, only news by id are kept locally
+type newsLocalCacheRepo struct {
+	news.RedisRepository
+	cache       *localcache.Cache
+	invalidator *localcache.Invalidator
+}
+
+// News local cache repository constructor, wraps redis repository
+func NewNewsLocalCacheRepo(redisRepo news.RedisRepository, cache *localcache.Cache, invalidator *localcache.Invalidator) news.RedisRepository {
+	return &newsLocalCacheRepo{RedisRepository: redisRepo, cache: cache, invalidator: invalidator}
+}
+
+// Get news by id from local cache, then from redis
+func (n *newsLocalCacheRepo) GetNewsByIDCtx(ctx context.Context, key string) (*models.NewsBase, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsLocalCacheRepo.GetNewsByIDCtx")
+	defer span.Finish()
+
+	if newsBytes, ok := n.cache.Get(key); ok {
+		newsBase := &models.NewsBase{}
+		if err := json.Unmarshal(newsBytes, newsBase); err == nil {
+			return newsBase, nil
+		}
+		n.cache.Delete(key)
+	}
+
+	newsBase, err := n.RedisRepository.GetNewsByIDCtx(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	n.setLocal(key, newsBase)
+
+	return newsBase, nil
+}
+
+// Cache news in redis and locally
+func (n *newsLocalCacheRepo) SetNewsCtx(ctx context.Context, key string, seconds int, news *models.NewsBase) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsLocalCacheRepo.SetNewsCtx")
+	defer span.Finish()
+
+	if err := n.RedisRepository.SetNewsCtx(ctx, key, seconds, news); err != nil {
+		return err
+	}
+	n.setLocal(key, news)
+
+	return nil
+}
+
+// Delete news from redis and from local caches of all instances
+func (n *newsLocalCacheRepo) DeleteNewsCtx(ctx context.Context, key string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsLocalCacheRepo.DeleteNewsCtx")
+	defer span.Finish()
+
+	err := n.RedisRepository.DeleteNewsCtx(ctx, key)
+	if invalidateErr := n.invalidator.Invalidate(ctx, key); invalidateErr != nil {
+		return errors.Wrap(invalidateErr, "newsLocalCacheRepo.DeleteNewsCtx.Invalidate")
+	}
+	return err
+}
+
+func (n *newsLocalCacheRepo) setLocal(key string, news *models.NewsBase) {
+	newsBytes, err := json.Marshal(news)
+	if err != nil {
+		return
+	}
+	n.cache.Set(key, newsBytes)
+}
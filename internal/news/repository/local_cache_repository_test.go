@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/config"
+	"github.com/AleksK1NG/api-mc/internal/models"
+	"github.com/AleksK1NG/api-mc/pkg/localcache"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+)
+
+type nopPublisher struct{}
+
+func (nopPublisher) Publish(ctx context.Context, channel string, message interface{}) *redis.IntCmd {
+	return redis.NewIntResult(0, nil)
+}
+
+func TestNewsLocalCacheRepo(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+
+	redisRepo := SetupRedis()
+	cache := localcache.NewCache(time.Minute, 10)
+	invalidator := localcache.NewInvalidator(cache, nopPublisher{}, "", apiLogger)
+	localRepo := NewNewsLocalCacheRepo(redisRepo, cache, invalidator)
+
+	key := "key"
+	n := &models.NewsBase{NewsID: uuid.New(), Title: "Title", Content: "Content"}
+
+	err := localRepo.SetNewsCtx(context.Background(), key, 10, n)
+	require.NoError(t, err)
+	_, ok := cache.Get(key)
+	require.True(t, ok)
+
+	// served from local cache even when redis lost the key
+	require.NoError(t, redisRepo.DeleteNewsCtx(context.Background(), key))
+	cached, err := localRepo.GetNewsByIDCtx(context.Background(), key)
+	require.NoError(t, err)
+	require.Equal(t, n.NewsID, cached.NewsID)
+
+	require.NoError(t, redisRepo.SetNewsCtx(context.Background(), key, 10, n))
+	require.NoError(t, localRepo.DeleteNewsCtx(context.Background(), key))
+	_, ok = cache.Get(key)
+	require.False(t, ok)
+	_, err = localRepo.GetNewsByIDCtx(context.Background(), key)
+	require.Error(t, err)
+}
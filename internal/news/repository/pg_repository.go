@@ -3,113 +3,450 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
 
+	"github.com/AleksK1NG/api-mc/config"
 	"github.com/AleksK1NG/api-mc/internal/models"
 	"github.com/AleksK1NG/api-mc/internal/news"
+	"github.com/AleksK1NG/api-mc/pkg/db/postgres"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
 	"github.com/AleksK1NG/api-mc/pkg/utils"
 )
 
+const similarTitlesLimit = 5
+
 // News Repository
 type newsRepo struct {
-	db *sqlx.DB
+	db    *sqlx.DB
+	timer *postgres.QueryTimer
+	guard *postgres.CostGuard
+	stmts *postgres.StmtCache
+	hooks *news.Hooks
+	// Max items of list argument, longer lists are rejected with postgres.ErrBatchTooLarge
+	maxListParams int
 }
 
 // News repository constructor
-func NewNewsRepository(db *sqlx.DB) news.Repository {
-	return &newsRepo{db: db}
+func NewNewsRepository(db *sqlx.DB, cfg *config.Config, logger logger.Logger) news.Repository {
+	timer := postgres.NewQueryTimer(cfg.Postgres.SlowQueryThreshold, cfg.Server.Debug && cfg.Postgres.LogQueryParams, logger)
+	return &newsRepo{
+		db:    db,
+		timer: timer.WithLimiter(postgres.NewLimiter(cfg.Postgres.MaxConcurrentQueries, cfg.Postgres.QueryAcquireTimeout)),
+		guard: postgres.NewCostGuard(cfg.Postgres.ExplainListQueries, cfg.Postgres.ExplainCostThreshold, logger),
+		stmts: postgres.NewStmtCache(db),
+		hooks: news.NewHooks(logger),
+
+		maxListParams: postgres.MaxListParams(cfg.Postgres.MaxListParams),
+	}
+}
+
+// Check list argument fits one query, see postgres.CheckListSize
+func (r *newsRepo) checkListSize(method string, size int) error {
+	return postgres.CheckListSize(method, size, r.maxListParams)
+}
+
+// Post-commit hooks of mutations
+func (r *newsRepo) Hooks() *news.Hooks {
+	return r.hooks
+}
+
+// Close prepared statements
+func (r *newsRepo) Close() error {
+	return r.stmts.Close()
 }
 
-// Create news
+// Create news, initial revision is recorded in the same transaction
 func (r *newsRepo) Create(ctx context.Context, news *models.News) (*models.News, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.Create")
 	defer span.Finish()
 
 	var n models.News
-	if err := r.db.QueryRowxContext(
-		ctx,
-		createNews,
-		&news.AuthorID,
-		&news.Title,
-		&news.Content,
-		&news.Category,
-	).StructScan(&n); err != nil {
-		return nil, errors.Wrap(err, "newsRepo.Create.QueryRowxContext")
+	err := postgres.WithTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		if err := r.timer.QueryRowxContext(
+			ctx,
+			tx,
+			"createNews",
+			createNews,
+			&news.AuthorID,
+			&news.Title,
+			&news.Content,
+			&news.Category,
+			&news.Status,
+			&news.Slug,
+			&news.ContentHash,
+			utils.WordCount(news.Content),
+			&news.TenantID,
+		).StructScan(&n); err != nil {
+			return errors.Wrap(err, "newsRepo.Create.QueryRowxContext")
+		}
+
+		return r.createRevision(ctx, tx, &n, n.AuthorID, changedNewsFields(&models.News{}, &n), models.NewsActionCreate)
+	})
+	if err != nil {
+		return nil, err
 	}
+	r.hooks.Created(ctx, &n)
 
 	return &n, nil
 }
 
-// Update news item
-func (r *newsRepo) Update(ctx context.Context, news *models.News) (*models.News, error) {
+// Create news unless news with same slug exists, existing news is returned with created false
+func (r *newsRepo) CreateIfNotExists(ctx context.Context, news *models.News) (*models.News, bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.CreateIfNotExists")
+	defer span.Finish()
+
+	var n models.News
+	created := true
+	err := postgres.WithTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		err := r.timer.QueryRowxContext(
+			ctx,
+			tx,
+			"createNewsIfNotExists",
+			createNewsIfNotExists,
+			&news.AuthorID,
+			&news.Title,
+			&news.Content,
+			&news.Category,
+			&news.Status,
+			&news.Slug,
+			&news.ContentHash,
+			utils.WordCount(news.Content),
+			&news.TenantID,
+		).StructScan(&n)
+		if err == nil {
+			return r.createRevision(ctx, tx, &n, n.AuthorID, changedNewsFields(&models.News{}, &n), models.NewsActionCreate)
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return errors.Wrap(err, "newsRepo.CreateIfNotExists.QueryRowxContext")
+		}
+
+		// Conflicting row is committed once insert returns nothing, so new statement sees it
+		created = false
+		if err = r.timer.QueryRowxContext(ctx, tx, "getNewsBySlug", getNewsBySlug, &news.Slug, &news.TenantID).StructScan(&n); err != nil {
+			return errors.Wrap(err, "newsRepo.CreateIfNotExists.getNewsBySlug")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if created {
+		r.hooks.Created(ctx, &n)
+	}
+
+	return &n, created, nil
+}
+
+// Get news with author by slugs in any order, slugs are looked up within tenant request is scoped to, unknown slugs are skipped
+func (r *newsRepo) GetNewsBySlugs(ctx context.Context, slugs []string) ([]*models.NewsBase, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetNewsBySlugs")
+	defer span.Finish()
+
+	if err := r.checkListSize("newsRepo.GetNewsBySlugs", len(slugs)); err != nil {
+		return nil, err
+	}
+
+	news := make([]*models.NewsBase, 0, len(slugs))
+	if err := r.timer.SelectContext(ctx, r.db, "getNewsBySlugs", &news, getNewsBySlugs, utils.TextArray(slugs), tenantArg(ctx)); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetNewsBySlugs.SelectContext")
+	}
+
+	return news, nil
+}
+
+// Get news with author by slugs, result is aligned with slugs and holds nil for every unknown slug
+func (r *newsRepo) GetBySlugsOrdered(ctx context.Context, slugs []string) ([]*models.NewsBase, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetBySlugsOrdered")
+	defer span.Finish()
+
+	news, err := r.GetNewsBySlugs(ctx, slugs)
+	if err != nil {
+		return nil, errors.WithMessage(err, "newsRepo.GetBySlugsOrdered")
+	}
+
+	bySlug := make(map[string]*models.NewsBase, len(news))
+	for _, n := range news {
+		if n.Slug != nil {
+			bySlug[*n.Slug] = n
+		}
+	}
+
+	ordered := make([]*models.NewsBase, len(slugs))
+	for i, slug := range slugs {
+		ordered[i] = bySlug[slug]
+	}
+
+	return ordered, nil
+}
+
+// Get latest published news of every given category, categories without published news are left out
+func (r *newsRepo) GetLatestPerCategory(ctx context.Context, categories []string) (map[string]*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetLatestPerCategory")
+	defer span.Finish()
+
+	if err := r.checkListSize("newsRepo.GetLatestPerCategory", len(categories)); err != nil {
+		return nil, err
+	}
+
+	news := make([]*models.News, 0, len(categories))
+	args := withTenant(ctx, utils.TextArray(categories))
+	if err := r.timer.SelectContext(ctx, r.db, "getLatestPerCategory", &news, getLatestPerCategory, args...); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetLatestPerCategory.SelectContext")
+	}
+
+	latest := make(map[string]*models.News, len(news))
+	for _, n := range news {
+		if n.Category != nil {
+			latest[*n.Category] = n
+		}
+	}
+
+	return latest, nil
+}
+
+// Get most recently updated published news of every given author, authors without published news are left out
+func (r *newsRepo) GetLatestUpdatePerAuthor(ctx context.Context, authorIDs []uuid.UUID) (map[uuid.UUID]*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetLatestUpdatePerAuthor")
+	defer span.Finish()
+
+	if err := r.checkListSize("newsRepo.GetLatestUpdatePerAuthor", len(authorIDs)); err != nil {
+		return nil, err
+	}
+
+	news := make([]*models.News, 0, len(authorIDs))
+	args := withTenant(ctx, utils.UUIDArray(authorIDs))
+	if err := r.timer.SelectContext(ctx, r.db, "getLatestUpdatePerAuthor", &news, getLatestUpdatePerAuthor, args...); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetLatestUpdatePerAuthor.SelectContext")
+	}
+
+	latest := make(map[uuid.UUID]*models.News, len(news))
+	for _, n := range news {
+		latest[n.AuthorID] = n
+	}
+
+	return latest, nil
+}
+
+// Get given categories no news uses, news table is the only source of categories
+func (r *newsRepo) GetUnknownCategories(ctx context.Context, categories []string) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetUnknownCategories")
+	defer span.Finish()
+
+	if err := r.checkListSize("newsRepo.GetUnknownCategories", len(categories)); err != nil {
+		return nil, err
+	}
+
+	unknown := make([]string, 0)
+	if err := r.timer.SelectContext(ctx, r.db, "getUnknownCategories", &unknown, getUnknownCategories, utils.TextArray(categories), tenantArg(ctx)); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetUnknownCategories.SelectContext")
+	}
+
+	return unknown, nil
+}
+
+// Get not deleted news of tenant with given content hash
+func (r *newsRepo) GetNewsByContentHash(ctx context.Context, hash string, tenantID *uuid.UUID) (*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetNewsByContentHash")
+	defer span.Finish()
+
+	n := &models.News{}
+	if err := r.timer.GetContext(ctx, r.db, "getNewsByContentHash", n, getNewsByContentHash, hash, tenantID); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetNewsByContentHash.GetContext")
+	}
+
+	return n, nil
+}
+
+// Update news item, changed fields are recorded as new revision made by actor
+func (r *newsRepo) Update(ctx context.Context, news *models.News, actorID uuid.UUID) (*models.News, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.Update")
 	defer span.Finish()
 
+	// Row is locked for update, so read committed is enough
+	var n *models.News
+	err := postgres.WithTxIsolation(ctx, r.db, sql.LevelReadCommitted, func(tx *sqlx.Tx) error {
+		var err error
+		n, err = r.update(ctx, tx, news, actorID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.hooks.Updated(ctx, n)
+
+	return n, nil
+}
+
+func (r *newsRepo) update(ctx context.Context, tx *sqlx.Tx, news *models.News, actorID uuid.UUID) (*models.News, error) {
+	var prev models.News
+	if err := r.timer.GetContext(ctx, tx, "getNewsForUpdate", &prev, getNewsForUpdate, &news.NewsID); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.Update.GetContext")
+	}
+
 	var n models.News
-	if err := r.db.QueryRowxContext(
+	if err := r.timer.QueryRowxContext(
 		ctx,
+		tx,
+		"updateNews",
 		updateNews,
 		&news.Title,
 		&news.Content,
 		&news.ImageURL,
 		&news.Category,
 		&news.NewsID,
+		&news.ContentHash,
+		contentWordCount(news.Content),
 	).StructScan(&n); err != nil {
 		return nil, errors.Wrap(err, "newsRepo.Update.QueryRowxContext")
 	}
 
+	if err := r.createRevision(ctx, tx, &n, actorID, changedNewsFields(&prev, &n), models.NewsActionUpdate); err != nil {
+		return nil, err
+	}
+
 	return &n, nil
 }
 
+// Store snapshot of news as revision and record action in activity feed, nothing is stored when no field changed
+func (r *newsRepo) createRevision(ctx context.Context, tx *sqlx.Tx, n *models.News, actorID uuid.UUID, changed models.ChangedFields, action string) error {
+	if len(changed) == 0 {
+		return nil
+	}
+
+	if _, err := r.timer.ExecContext(
+		ctx,
+		tx,
+		"createNewsRevision",
+		createNewsRevision,
+		n.NewsID,
+		actorID,
+		n.Title,
+		n.Content,
+		n.ImageURL,
+		n.Category,
+		n.Status,
+		changed,
+	); err != nil {
+		return errors.Wrap(err, "newsRepo.createRevision.ExecContext")
+	}
+	return r.createEvent(ctx, tx, n.NewsID, actorID, action)
+}
+
+// Record action of actor on news in activity feed
+func (r *newsRepo) createEvent(ctx context.Context, tx *sqlx.Tx, newsID uuid.UUID, actorID uuid.UUID, action string) error {
+	if _, err := r.timer.ExecContext(ctx, tx, "createNewsEvent", createNewsEvent, newsID, actorID, action); err != nil {
+		return errors.Wrap(err, "newsRepo.createEvent.ExecContext")
+	}
+	return nil
+}
+
+// Names of tracked fields which differ between two versions of news
+func changedNewsFields(prev *models.News, next *models.News) models.ChangedFields {
+	changed := make(models.ChangedFields, 0)
+	if prev.Title != next.Title {
+		changed = append(changed, models.NewsFieldTitle)
+	}
+	if prev.Content != next.Content {
+		changed = append(changed, models.NewsFieldContent)
+	}
+	if !equalStringPtr(prev.ImageURL, next.ImageURL) {
+		changed = append(changed, models.NewsFieldImageURL)
+	}
+	if !equalStringPtr(prev.Category, next.Category) {
+		changed = append(changed, models.NewsFieldCategory)
+	}
+	if prev.Status != next.Status {
+		changed = append(changed, models.NewsFieldStatus)
+	}
+	return changed
+}
+
+func equalStringPtr(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
 // Get single news by id
 func (r *newsRepo) GetNewsByID(ctx context.Context, newsID uuid.UUID) (*models.NewsBase, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetNewsByID")
 	defer span.Finish()
 
+	stmt, err := r.stmts.Queryer(ctx, getNewsByID)
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetNewsByID.Queryer")
+	}
+
 	n := &models.NewsBase{}
-	if err := r.db.GetContext(ctx, n, getNewsByID, newsID); err != nil {
+	if err = r.timer.GetContext(ctx, stmt, "getNewsByID", n, getNewsByID, newsID); err != nil {
 		return nil, errors.Wrap(err, "newsRepo.GetNewsByID.GetContext")
 	}
 
 	return n, nil
 }
 
-// Delete news by id
-func (r *newsRepo) Delete(ctx context.Context, newsID uuid.UUID) error {
+// Delete news by id, deletion by actor is recorded in activity feed in the same transaction
+func (r *newsRepo) Delete(ctx context.Context, newsID uuid.UUID, actorID uuid.UUID) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.Delete")
 	defer span.Finish()
 
-	result, err := r.db.ExecContext(ctx, deleteNews, newsID)
-	if err != nil {
-		return errors.Wrap(err, "newsRepo.Delete.ExecContext")
-	}
+	err := postgres.WithTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		result, err := r.timer.ExecContext(ctx, tx, "deleteNews", deleteNews, newsID)
+		if err != nil {
+			return errors.Wrap(err, "newsRepo.Delete.ExecContext")
+		}
 
-	rowsAffected, err := result.RowsAffected()
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "newsRepo.Delete.RowsAffected")
+		}
+		if rowsAffected == 0 {
+			return errors.Wrap(sql.ErrNoRows, "newsRepo.Delete.rowsAffected")
+		}
+
+		return r.createEvent(ctx, tx, newsID, actorID, models.NewsActionDelete)
+	})
 	if err != nil {
-		return errors.Wrap(err, "newsRepo.Delete.RowsAffected")
-	}
-	if rowsAffected == 0 {
-		return errors.Wrap(sql.ErrNoRows, "newsRepo.Delete.rowsAffected")
+		return err
 	}
+	r.hooks.Deleted(ctx, &models.News{NewsID: newsID})
 
 	return nil
 }
 
-// Get news
-func (r *newsRepo) GetNews(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+// Get published news page filtered and ordered by list query
+func (r *newsRepo) GetNews(ctx context.Context, lq *utils.ListQuery, pq *utils.PaginationQuery) (*models.NewsList, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetNews")
 	defer span.Finish()
 
+	countQuery, listQuery, args := buildNewsListQueries(lq)
+	approximate := lq.EstimateCount && len(lq.Conditions) == 0
+
 	var totalCount int
-	if err := r.db.GetContext(ctx, &totalCount, getTotalCount); err != nil {
-		return nil, errors.Wrap(err, "newsRepo.GetNews.GetContext.totalCount")
+	if approximate {
+		if err := r.timer.GetContext(ctx, r.db, "getEstimatedNewsCount", &totalCount, getEstimatedNewsCount); err != nil {
+			return nil, errors.Wrap(err, "newsRepo.GetNews.GetContext.estimatedCount")
+		}
+	} else {
+		countStmt, err := r.stmts.Queryer(ctx, countQuery)
+		if err != nil {
+			return nil, errors.Wrap(err, "newsRepo.GetNews.Queryer.totalCount")
+		}
+		if err = r.timer.GetContext(ctx, countStmt, "getNewsCount", &totalCount, countQuery, args...); err != nil {
+			return nil, errors.Wrap(err, "newsRepo.GetNews.GetContext.totalCount")
+		}
 	}
 
-	if totalCount == 0 {
+	// Estimate may lag behind inserts, so zero estimate still reads the page
+	if totalCount == 0 && !approximate {
 		return &models.NewsList{
 			TotalCount: totalCount,
 			TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
@@ -120,8 +457,16 @@ func (r *newsRepo) GetNews(ctx context.Context, pq *utils.PaginationQuery) (*mod
 		}, nil
 	}
 
-	var newsList = make([]*models.News, 0, pq.GetSize())
-	rows, err := r.db.QueryxContext(ctx, getNews, pq.GetOffset(), pq.GetLimit())
+	stmt, err := r.stmts.Queryer(ctx, listQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetNews.Queryer")
+	}
+
+	args = append(args, pq.GetOffset(), pq.GetLimit()+lq.Prefetch)
+	r.guard.Check(ctx, r.db, "getNews", listQuery, args...)
+
+	var newsList = make([]*models.News, 0, pq.GetSize()+lq.Prefetch)
+	rows, err := r.timer.QueryxContext(ctx, stmt, "getNews", listQuery, args...)
 	if err != nil {
 		return nil, errors.Wrap(err, "newsRepo.GetNews.QueryxContext")
 	}
@@ -139,23 +484,96 @@ func (r *newsRepo) GetNews(ctx context.Context, pq *utils.PaginationQuery) (*mod
 		return nil, errors.Wrap(err, "newsRepo.GetNews.rows.Err")
 	}
 
+	var nextPreview []*models.News
+	if len(newsList) > pq.GetLimit() {
+		nextPreview = newsList[pq.GetLimit():]
+		newsList = newsList[:pq.GetLimit():pq.GetLimit()]
+	}
+
 	return &models.NewsList{
+		TotalCount:       totalCount,
+		TotalPages:       utils.GetTotalPages(totalCount, pq.GetSize()),
+		Page:             pq.GetPage(),
+		Size:             pq.GetSize(),
+		HasMore:          utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+		News:             newsList,
+		NextPreview:      nextPreview,
+		ApproximateCount: approximate,
+	}, nil
+}
+
+// Word count of updated content, nil keeps stored count when update leaves content as is
+func contentWordCount(content string) *int {
+	if content == "" {
+		return nil
+	}
+	count := utils.WordCount(content)
+	return &count
+}
+
+// Get ids of news list page, filters and order are the same as of GetNews
+func (r *newsRepo) GetNewsIDs(ctx context.Context, lq *utils.ListQuery, pq *utils.PaginationQuery) (*models.NewsIDList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetNewsIDs")
+	defer span.Finish()
+
+	where, args := lq.Where(newsListBaseCondition)
+	countQuery := fmt.Sprintf(getNewsCount, where)
+
+	countStmt, err := r.stmts.Queryer(ctx, countQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetNewsIDs.Queryer.totalCount")
+	}
+
+	var totalCount int
+	if err = r.timer.GetContext(ctx, countStmt, "getNewsCount", &totalCount, countQuery, args...); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetNewsIDs.GetContext.totalCount")
+	}
+
+	ids := make([]uuid.UUID, 0, pq.GetSize())
+	if totalCount > 0 {
+		idsQuery := fmt.Sprintf(getNewsIDs, where, lq.Order(), len(args)+1, len(args)+2)
+		stmt, err := r.stmts.Queryer(ctx, idsQuery)
+		if err != nil {
+			return nil, errors.Wrap(err, "newsRepo.GetNewsIDs.Queryer")
+		}
+
+		args = append(args, pq.GetOffset(), pq.GetLimit())
+		r.guard.Check(ctx, r.db, "getNewsIDs", idsQuery, args...)
+
+		if err = r.timer.SelectContext(ctx, stmt, "getNewsIDs", &ids, idsQuery, args...); err != nil {
+			return nil, errors.Wrap(err, "newsRepo.GetNewsIDs.SelectContext")
+		}
+	}
+
+	return &models.NewsIDList{
 		TotalCount: totalCount,
 		TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
 		Page:       pq.GetPage(),
 		Size:       pq.GetSize(),
 		HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
-		News:       newsList,
+		IDs:        ids,
 	}, nil
 }
 
-// Find news by title
-func (r *newsRepo) SearchByTitle(ctx context.Context, title string, query *utils.PaginationQuery) (*models.NewsList, error) {
+// Build count and page queries of published news list, offset and limit placeholders follow filter args
+func buildNewsListQueries(lq *utils.ListQuery) (string, string, []interface{}) {
+	where, args := lq.Where(newsListBaseCondition)
+	countQuery := fmt.Sprintf(getNewsCount, where)
+	listQuery := fmt.Sprintf(getNews, where, lq.Order(), len(args)+1, len(args)+2)
+	return countQuery, listQuery, args
+}
+
+// Find news by title, or by content words within scope
+func (r *newsRepo) SearchByTitle(ctx context.Context, title string, scope models.SearchScope, query *utils.PaginationQuery) (*models.NewsList, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.SearchByTitle")
 	defer span.Finish()
 
+	name, countQuery, listQuery := searchQueries(scope)
+	countArgs := withTenant(ctx, title)
+	listArgs := withTenant(ctx, title, query.GetOffset(), query.GetLimit())
+
 	var totalCount int
-	if err := r.db.GetContext(ctx, &totalCount, findByTitleCount, title); err != nil {
+	if err := r.timer.GetContext(ctx, r.db, name+"Count", &totalCount, countQuery, countArgs...); err != nil {
 		return nil, errors.Wrap(err, "newsRepo.SearchByTitle.GetContext")
 	}
 	if totalCount == 0 {
@@ -169,8 +587,10 @@ func (r *newsRepo) SearchByTitle(ctx context.Context, title string, query *utils
 		}, nil
 	}
 
+	r.guard.Check(ctx, r.db, name, listQuery, listArgs...)
+
 	var newsList = make([]*models.News, 0, query.GetSize())
-	rows, err := r.db.QueryxContext(ctx, findByTitle, title, query.GetOffset(), query.GetLimit())
+	rows, err := r.timer.QueryxContext(ctx, r.db, name, listQuery, listArgs...)
 	if err != nil {
 		return nil, errors.Wrap(err, "newsRepo.SearchByTitle.QueryxContext")
 	}
@@ -197,3 +617,1082 @@ func (r *newsRepo) SearchByTitle(ctx context.Context, title string, query *utils
 		News:       newsList,
 	}, nil
 }
+
+// Count news found by title, or by content words within scope, without fetching them
+func (r *newsRepo) CountSearchByTitle(ctx context.Context, title string, scope models.SearchScope) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.CountSearchByTitle")
+	defer span.Finish()
+
+	name, countQuery, _ := searchQueries(scope)
+	args := withTenant(ctx, title)
+
+	var totalCount int
+	if err := r.timer.GetContext(ctx, r.db, name+"Count", &totalCount, countQuery, args...); err != nil {
+		return 0, errors.Wrap(err, "newsRepo.CountSearchByTitle.GetContext")
+	}
+	return totalCount, nil
+}
+
+// Statement name, count and page queries of search scope
+func searchQueries(scope models.SearchScope) (string, string, string) {
+	switch scope {
+	case models.SearchScopeContent:
+		return "findByContent", findByContentCount, findByContent
+	case models.SearchScopeAll:
+		return "findByText", findByTextCount, findByText
+	default:
+		return "findByTitle", findByTitleCount, findByTitle
+	}
+}
+
+// Tenant request is scoped to, nil when request is not scoped to any tenant
+func tenantArg(ctx context.Context) *uuid.UUID {
+	if tenantID, ok := utils.GetTenantFromCtx(ctx); ok {
+		return &tenantID
+	}
+	return nil
+}
+
+// Append tenant request is scoped to as last arg, bound to tenant check placeholder every news query ends its args with
+func withTenant(ctx context.Context, args ...interface{}) []interface{} {
+	return append(args, tenantArg(ctx))
+}
+
+// Get news count grouped by month
+func (r *newsRepo) GetTimeline(ctx context.Context) ([]*models.TimelineBucket, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetTimeline")
+	defer span.Finish()
+
+	var timeline = make([]*models.TimelineBucket, 0)
+	args := withTenant(ctx)
+	if err := r.timer.SelectContext(ctx, r.db, "getTimeline", &timeline, getTimeline, args...); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetTimeline.SelectContext")
+	}
+
+	return timeline, nil
+}
+
+// Get previous and next news by creation order, nil at the ends of the feed
+func (r *newsRepo) GetNeighbors(ctx context.Context, newsID uuid.UUID) (*models.News, *models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetNeighbors")
+	defer span.Finish()
+
+	var createdAt time.Time
+	if err := r.timer.GetContext(ctx, r.db, "getNewsCreatedAt", &createdAt, getNewsCreatedAt, newsID, tenantArg(ctx)); err != nil {
+		return nil, nil, errors.Wrap(err, "newsRepo.GetNeighbors.GetContext.createdAt")
+	}
+
+	prev := &models.News{}
+	prevArgs := withTenant(ctx, createdAt, newsID)
+	if err := r.timer.GetContext(ctx, r.db, "getPrevNews", prev, getPrevNews, prevArgs...); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, errors.Wrap(err, "newsRepo.GetNeighbors.GetContext.prev")
+		}
+		prev = nil
+	}
+
+	next := &models.News{}
+	nextArgs := withTenant(ctx, createdAt, newsID)
+	if err := r.timer.GetContext(ctx, r.db, "getNextNews", next, getNextNews, nextArgs...); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, errors.Wrap(err, "newsRepo.GetNeighbors.GetContext.next")
+		}
+		next = nil
+	}
+
+	return prev, next, nil
+}
+
+// Change status of many news, news not allowed to move to given status are skipped.
+// Returns ids of changed news, in dry run mode returns ids which would be changed without updating them
+func (r *newsRepo) UpdateStatusBatch(ctx context.Context, ids []uuid.UUID, status string, dryRun bool) ([]uuid.UUID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.UpdateStatusBatch")
+	defer span.Finish()
+
+	if err := r.checkListSize("newsRepo.UpdateStatusBatch", len(ids)); err != nil {
+		return nil, err
+	}
+
+	var (
+		allowed []uuid.UUID
+		changed []*models.News
+	)
+	// Whole batch is checked against one consistent snapshot, conflicts are retried by WithTxIsolation
+	err := postgres.WithTxIsolation(ctx, r.db, sql.LevelSerializable, func(tx *sqlx.Tx) error {
+		var current []struct {
+			NewsID   uuid.UUID `db:"news_id"`
+			AuthorID uuid.UUID `db:"author_id"`
+			Title    string    `db:"title"`
+			Status   string    `db:"status"`
+		}
+		if err := r.timer.SelectContext(ctx, tx, "getNewsStatusesForUpdate", &current, getNewsStatusesForUpdate, utils.UUIDArray(ids), tenantArg(ctx)); err != nil {
+			return errors.Wrap(err, "newsRepo.UpdateStatusBatch.SelectContext")
+		}
+
+		allowed = make([]uuid.UUID, 0, len(current))
+		changed = make([]*models.News, 0, len(current))
+		for _, n := range current {
+			if models.CanTransitionNewsStatus(n.Status, status) {
+				allowed = append(allowed, n.NewsID)
+				changed = append(changed, &models.News{NewsID: n.NewsID, AuthorID: n.AuthorID, Title: n.Title, Status: status})
+			}
+		}
+		if len(allowed) == 0 || dryRun {
+			return nil
+		}
+
+		if _, err := r.timer.ExecContext(ctx, tx, "updateNewsStatusBatch", updateNewsStatusBatch, status, utils.UUIDArray(allowed), tenantArg(ctx)); err != nil {
+			return errors.Wrap(err, "newsRepo.UpdateStatusBatch.ExecContext")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !dryRun {
+		for _, n := range changed {
+			r.hooks.Updated(ctx, n)
+			if status == models.NewsStatusPublished {
+				r.hooks.Published(ctx, n)
+			}
+		}
+	}
+
+	return allowed, nil
+}
+
+// Get author draft news
+func (r *newsRepo) GetMyDrafts(ctx context.Context, authorID uuid.UUID, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetMyDrafts")
+	defer span.Finish()
+
+	var totalCount int
+	if err := r.timer.GetContext(ctx, r.db, "getDraftsCountByAuthor", &totalCount, getDraftsCountByAuthor, authorID); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetMyDrafts.GetContext.totalCount")
+	}
+
+	if totalCount == 0 {
+		return &models.NewsList{
+			TotalCount: totalCount,
+			TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
+			Page:       pq.GetPage(),
+			Size:       pq.GetSize(),
+			HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+			News:       make([]*models.News, 0),
+		}, nil
+	}
+
+	r.guard.Check(ctx, r.db, "getDraftsByAuthor", getDraftsByAuthor, authorID, pq.GetOffset(), pq.GetLimit())
+
+	var newsList = make([]*models.News, 0, pq.GetSize())
+	if err := r.timer.SelectContext(ctx, r.db, "getDraftsByAuthor", &newsList, getDraftsByAuthor, authorID, pq.GetOffset(), pq.GetLimit()); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetMyDrafts.SelectContext")
+	}
+
+	return &models.NewsList{
+		TotalCount: totalCount,
+		TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
+		Page:       pq.GetPage(),
+		Size:       pq.GetSize(),
+		HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+		News:       newsList,
+	}, nil
+}
+
+// Get news updated by user, each news once, most recently edited first
+func (r *newsRepo) GetEditedBy(ctx context.Context, userID uuid.UUID, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetEditedBy")
+	defer span.Finish()
+
+	var totalCount int
+	if err := r.timer.GetContext(ctx, r.db, "getEditedByCount", &totalCount, getEditedByCount, userID, tenantArg(ctx)); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetEditedBy.GetContext.totalCount")
+	}
+
+	var newsList = make([]*models.News, 0, pq.GetSize())
+	if totalCount > 0 {
+		if err := r.timer.SelectContext(ctx, r.db, "getEditedBy", &newsList, getEditedBy, userID, pq.GetOffset(), pq.GetLimit(), tenantArg(ctx)); err != nil {
+			return nil, errors.Wrap(err, "newsRepo.GetEditedBy.SelectContext")
+		}
+	}
+
+	return &models.NewsList{
+		TotalCount: totalCount,
+		TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
+		Page:       pq.GetPage(),
+		Size:       pq.GetSize(),
+		HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+		News:       newsList,
+	}, nil
+}
+
+// Get news whose author no longer exists
+func (r *newsRepo) GetOrphaned(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetOrphaned")
+	defer span.Finish()
+
+	var totalCount int
+	if err := r.timer.GetContext(ctx, r.db, "getOrphanedCount", &totalCount, getOrphanedCount, tenantArg(ctx)); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetOrphaned.GetContext.totalCount")
+	}
+
+	if totalCount == 0 {
+		return &models.NewsList{
+			TotalCount: totalCount,
+			TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
+			Page:       pq.GetPage(),
+			Size:       pq.GetSize(),
+			HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+			News:       make([]*models.News, 0),
+		}, nil
+	}
+
+	r.guard.Check(ctx, r.db, "getOrphaned", getOrphaned, pq.GetOffset(), pq.GetLimit(), tenantArg(ctx))
+
+	var newsList = make([]*models.News, 0, pq.GetSize())
+	if err := r.timer.SelectContext(ctx, r.db, "getOrphaned", &newsList, getOrphaned, pq.GetOffset(), pq.GetLimit(), tenantArg(ctx)); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetOrphaned.SelectContext")
+	}
+
+	return &models.NewsList{
+		TotalCount: totalCount,
+		TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
+		Page:       pq.GetPage(),
+		Size:       pq.GetSize(),
+		HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+		News:       newsList,
+	}, nil
+}
+
+// Reassign orphaned news to a new author, news which still have an existing author are left untouched.
+// Returns ids of reassigned news, in dry run mode returns ids which would be reassigned without updating them
+func (r *newsRepo) ReassignAuthor(ctx context.Context, ids []uuid.UUID, authorID uuid.UUID, dryRun bool) ([]uuid.UUID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.ReassignAuthor")
+	defer span.Finish()
+
+	if err := r.checkListSize("newsRepo.ReassignAuthor", len(ids)); err != nil {
+		return nil, err
+	}
+
+	affected := make([]uuid.UUID, 0, len(ids))
+	if dryRun {
+		if err := r.timer.SelectContext(ctx, r.db, "getOrphanedByIDs", &affected, getOrphanedByIDs, utils.UUIDArray(ids), tenantArg(ctx)); err != nil {
+			return nil, errors.Wrap(err, "newsRepo.ReassignAuthor.SelectContext.dryRun")
+		}
+		return affected, nil
+	}
+
+	if err := r.timer.SelectContext(ctx, r.db, "reassignOrphanedAuthor", &affected, reassignOrphanedAuthor, authorID, utils.UUIDArray(ids), tenantArg(ctx)); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.ReassignAuthor.SelectContext")
+	}
+
+	return affected, nil
+}
+
+// Get ids of not deleted news of given ids which do not belong to tenant
+func (r *newsRepo) GetOutsideTenant(ctx context.Context, ids []uuid.UUID, tenantID uuid.UUID) ([]uuid.UUID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetOutsideTenant")
+	defer span.Finish()
+
+	if err := r.checkListSize("newsRepo.GetOutsideTenant", len(ids)); err != nil {
+		return nil, err
+	}
+
+	outside := make([]uuid.UUID, 0)
+	if err := r.timer.SelectContext(ctx, r.db, "getNewsOutsideTenant", &outside, getNewsOutsideTenant, utils.UUIDArray(ids), tenantID); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetOutsideTenant.SelectContext")
+	}
+
+	return outside, nil
+}
+
+// Get most recent published news, optionally filtered by category
+func (r *newsRepo) GetRecent(ctx context.Context, category string, limit int) ([]*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetRecent")
+	defer span.Finish()
+
+	var newsList = make([]*models.News, 0, limit)
+	args := withTenant(ctx, category, limit)
+	if err := r.timer.SelectContext(ctx, r.db, "getRecentNews", &newsList, getRecentNews, args...); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetRecent.SelectContext")
+	}
+
+	return newsList, nil
+}
+
+// Get published news ordered by last update, most recently updated first
+func (r *newsRepo) GetRecentlyUpdated(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetRecentlyUpdated")
+	defer span.Finish()
+
+	var totalCount int
+	countArgs := withTenant(ctx)
+	if err := r.timer.GetContext(ctx, r.db, "getTotalCount", &totalCount, getTotalCount, countArgs...); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetRecentlyUpdated.GetContext.totalCount")
+	}
+
+	if totalCount == 0 {
+		return &models.NewsList{
+			TotalCount: totalCount,
+			TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
+			Page:       pq.GetPage(),
+			Size:       pq.GetSize(),
+			HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+			News:       make([]*models.News, 0),
+		}, nil
+	}
+
+	listArgs := withTenant(ctx, pq.GetOffset(), pq.GetLimit())
+	r.guard.Check(ctx, r.db, "getRecentlyUpdatedNews", getRecentlyUpdatedNews, listArgs...)
+
+	var newsList = make([]*models.News, 0, pq.GetSize())
+	if err := r.timer.SelectContext(ctx, r.db, "getRecentlyUpdatedNews", &newsList, getRecentlyUpdatedNews, listArgs...); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetRecentlyUpdated.SelectContext")
+	}
+
+	return &models.NewsList{
+		TotalCount: totalCount,
+		TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
+		Page:       pq.GetPage(),
+		Size:       pq.GetSize(),
+		HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+		News:       newsList,
+	}, nil
+}
+
+// Get published news nobody has commented yet, newest first
+func (r *newsRepo) GetWithoutComments(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetWithoutComments")
+	defer span.Finish()
+
+	var totalCount int
+	countArgs := withTenant(ctx)
+	if err := r.timer.GetContext(ctx, r.db, "getTotalWithoutComments", &totalCount, getTotalWithoutComments, countArgs...); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetWithoutComments.GetContext.totalCount")
+	}
+
+	if totalCount == 0 {
+		return &models.NewsList{
+			TotalCount: totalCount,
+			TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
+			Page:       pq.GetPage(),
+			Size:       pq.GetSize(),
+			HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+			News:       make([]*models.News, 0),
+		}, nil
+	}
+
+	listArgs := withTenant(ctx, pq.GetOffset(), pq.GetLimit())
+	r.guard.Check(ctx, r.db, "getNewsWithoutComments", getNewsWithoutComments, listArgs...)
+
+	var newsList = make([]*models.News, 0, pq.GetSize())
+	if err := r.timer.SelectContext(ctx, r.db, "getNewsWithoutComments", &newsList, getNewsWithoutComments, listArgs...); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetWithoutComments.SelectContext")
+	}
+
+	return &models.NewsList{
+		TotalCount: totalCount,
+		TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
+		Page:       pq.GetPage(),
+		Size:       pq.GetSize(),
+		HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+		News:       newsList,
+	}, nil
+}
+
+// Find news of tenant with title similar to given one, using pg_trgm similarity not lower than threshold.
+// Only published news and news of author are matched
+func (r *newsRepo) FindSimilarTitles(ctx context.Context, title string, threshold float64, authorID uuid.UUID, tenantID *uuid.UUID) ([]*models.NewsRef, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.FindSimilarTitles")
+	defer span.Finish()
+
+	var newsList = make([]*models.NewsRef, 0)
+	if err := r.timer.SelectContext(
+		ctx,
+		r.db,
+		"findSimilarTitles",
+		&newsList,
+		findSimilarTitles,
+		title,
+		threshold,
+		similarTitlesLimit,
+		authorID,
+		tenantID,
+	); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.FindSimilarTitles.SelectContext")
+	}
+
+	return newsList, nil
+}
+
+// Stream published news page row by row into fn, rows are not collected in memory
+func (r *newsRepo) StreamNews(ctx context.Context, lq *utils.ListQuery, pq *utils.PaginationQuery, fn func(n *models.News) error) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.StreamNews")
+	defer span.Finish()
+
+	_, listQuery, args := buildNewsListQueries(lq)
+	return r.stream(ctx, "getNews", fn, listQuery, append(args, pq.GetOffset(), pq.GetLimit())...)
+}
+
+// Stream every not deleted news of author in any status row by row into fn, oldest first
+func (r *newsRepo) StreamAuthorNews(ctx context.Context, authorID uuid.UUID, fn func(n *models.News) error) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.StreamAuthorNews")
+	defer span.Finish()
+
+	return r.stream(ctx, "getAuthorNews", fn, getAuthorNews, authorID)
+}
+
+// Stream published news page found within search scope row by row into fn
+func (r *newsRepo) StreamSearchByTitle(ctx context.Context, title string, scope models.SearchScope, pq *utils.PaginationQuery, fn func(n *models.News) error) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.StreamSearchByTitle")
+	defer span.Finish()
+
+	name, _, listQuery := searchQueries(scope)
+	args := withTenant(ctx, title, pq.GetOffset(), pq.GetLimit())
+	return r.stream(ctx, name, fn, listQuery, args...)
+}
+
+func (r *newsRepo) stream(ctx context.Context, name string, fn func(n *models.News) error, query string, args ...interface{}) error {
+	rows, err := r.timer.QueryxContext(ctx, r.db, name, query, args...)
+	if err != nil {
+		return errors.Wrapf(err, "newsRepo.stream.QueryxContext, Statement: %s", name)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		n := &models.News{}
+		if err = rows.StructScan(n); err != nil {
+			return errors.Wrapf(err, "newsRepo.stream.StructScan, Statement: %s", name)
+		}
+		if err = fn(n); err != nil {
+			return err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return errors.Wrapf(err, "newsRepo.stream.rows.Err, Statement: %s", name)
+	}
+	return nil
+}
+
+// Get page of recent events of published news, newest first, empty action lists every action
+func (r *newsRepo) GetActivityFeed(ctx context.Context, action string, pq *utils.PaginationQuery) (*models.NewsEventList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetActivityFeed")
+	defer span.Finish()
+
+	var totalCount int
+	countArgs := withTenant(ctx, action)
+	if err := r.timer.GetContext(ctx, r.db, "getActivityCount", &totalCount, getActivityCount, countArgs...); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetActivityFeed.GetContext.totalCount")
+	}
+
+	events := make([]*models.NewsEvent, 0, pq.GetSize())
+	if totalCount > 0 {
+		listArgs := withTenant(ctx, action, pq.GetOffset(), pq.GetLimit())
+		if err := r.timer.SelectContext(ctx, r.db, "getActivityFeed", &events, getActivityFeed, listArgs...); err != nil {
+			return nil, errors.Wrap(err, "newsRepo.GetActivityFeed.SelectContext")
+		}
+	}
+
+	return &models.NewsEventList{
+		TotalCount: totalCount,
+		TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
+		Page:       pq.GetPage(),
+		Size:       pq.GetSize(),
+		HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+		Events:     events,
+	}, nil
+}
+
+// Pin news to featured list until given time, nil until pins forever
+func (r *newsRepo) Pin(ctx context.Context, newsID uuid.UUID, until *time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.Pin")
+	defer span.Finish()
+
+	result, err := r.timer.ExecContext(ctx, r.db, "pinNews", pinNews, newsID, until)
+	if err != nil {
+		return errors.Wrap(err, "newsRepo.Pin.ExecContext")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "newsRepo.Pin.RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return errors.Wrap(sql.ErrNoRows, "newsRepo.Pin.rowsAffected")
+	}
+
+	return nil
+}
+
+// Remove news from featured list
+func (r *newsRepo) Unpin(ctx context.Context, newsID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.Unpin")
+	defer span.Finish()
+
+	result, err := r.timer.ExecContext(ctx, r.db, "unpinNews", unpinNews, newsID)
+	if err != nil {
+		return errors.Wrap(err, "newsRepo.Unpin.ExecContext")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "newsRepo.Unpin.RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return errors.Wrap(sql.ErrNoRows, "newsRepo.Unpin.rowsAffected")
+	}
+
+	return nil
+}
+
+// Get currently pinned published news, pins past their pinned_until are skipped
+func (r *newsRepo) GetFeatured(ctx context.Context, limit int) ([]*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetFeatured")
+	defer span.Finish()
+
+	var newsList = make([]*models.News, 0, limit)
+	args := withTenant(ctx, limit)
+	if err := r.timer.SelectContext(ctx, r.db, "getFeaturedNews", &newsList, getFeaturedNews, args...); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetFeatured.SelectContext")
+	}
+
+	return newsList, nil
+}
+
+// Get revisions of news in chronological order
+func (r *newsRepo) GetRevisions(ctx context.Context, newsID uuid.UUID) ([]*models.NewsRevision, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetRevisions")
+	defer span.Finish()
+
+	var revisions = make([]*models.NewsRevision, 0)
+	if err := r.timer.SelectContext(ctx, r.db, "getNewsRevisions", &revisions, getNewsRevisions, newsID); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetRevisions.SelectContext")
+	}
+
+	return revisions, nil
+}
+
+// Get full news snapshot stored with revision
+func (r *newsRepo) GetRevision(ctx context.Context, revisionID uuid.UUID) (*models.NewsRevisionSnapshot, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetRevision")
+	defer span.Finish()
+
+	revision := &models.NewsRevisionSnapshot{}
+	if err := r.timer.GetContext(ctx, r.db, "getNewsRevisionByID", revision, getNewsRevisionByID, revisionID); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetRevision.GetContext")
+	}
+
+	return revision, nil
+}
+
+// Apply snapshot of revision to news as new update, history is kept and revert is recorded as new revision
+func (r *newsRepo) RevertTo(ctx context.Context, newsID uuid.UUID, revisionID uuid.UUID, actorID uuid.UUID) (*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.RevertTo")
+	defer span.Finish()
+
+	var n models.News
+	err := postgres.WithTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		revision := &models.NewsRevisionSnapshot{}
+		if err := r.timer.GetContext(ctx, tx, "getNewsRevisionByID", revision, getNewsRevisionByID, revisionID); err != nil {
+			return errors.Wrap(err, "newsRepo.RevertTo.GetContext.revision")
+		}
+		if revision.NewsID != newsID {
+			return errors.Wrap(sql.ErrNoRows, "newsRepo.RevertTo.revision.NewsID")
+		}
+
+		var prev models.News
+		if err := r.timer.GetContext(ctx, tx, "getNewsForUpdate", &prev, getNewsForUpdate, newsID); err != nil {
+			return errors.Wrap(err, "newsRepo.RevertTo.GetContext.news")
+		}
+
+		if err := r.timer.QueryRowxContext(
+			ctx,
+			tx,
+			"revertNews",
+			revertNews,
+			revision.Title,
+			revision.Content,
+			revision.ImageURL,
+			revision.Category,
+			newsID,
+			utils.ContentHash(revision.Content),
+			utils.WordCount(revision.Content),
+		).StructScan(&n); err != nil {
+			return errors.Wrap(err, "newsRepo.RevertTo.QueryRowxContext")
+		}
+
+		return r.createRevision(ctx, tx, &n, actorID, changedNewsFields(&prev, &n), models.NewsActionUpdate)
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.hooks.Updated(ctx, &n)
+
+	return &n, nil
+}
+
+// Count published news per author created since given time, ties are ordered by author name
+func (r *newsRepo) GetAuthorLeaderboard(ctx context.Context, since time.Time, limit int) ([]*models.AuthorStat, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetAuthorLeaderboard")
+	defer span.Finish()
+
+	var stats = make([]*models.AuthorStat, 0, limit)
+	args := withTenant(ctx, since, limit)
+	if err := r.timer.SelectContext(ctx, r.db, "getAuthorLeaderboard", &stats, getAuthorLeaderboard, args...); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetAuthorLeaderboard.SelectContext")
+	}
+
+	return stats, nil
+}
+
+// Count news per status, every known status is present even with zero news
+func (r *newsRepo) CountByStatus(ctx context.Context) (map[string]int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.CountByStatus")
+	defer span.Finish()
+
+	var rows []*models.NewsStatusCount
+	args := withTenant(ctx, utils.TextArray(models.NewsStatuses))
+	if err := r.timer.SelectContext(ctx, r.db, "getCountByStatus", &rows, getCountByStatus, args...); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.CountByStatus.SelectContext")
+	}
+
+	return statusCountsMap(rows), nil
+}
+
+// Count news of author per status, every known status is present even with zero news
+func (r *newsRepo) GetAuthorStatusCounts(ctx context.Context, authorID uuid.UUID) (map[string]int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetAuthorStatusCounts")
+	defer span.Finish()
+
+	var rows []*models.NewsStatusCount
+	args := withTenant(ctx, utils.TextArray(models.NewsStatuses), authorID)
+	if err := r.timer.SelectContext(ctx, r.db, "getAuthorStatusCounts", &rows, getAuthorStatusCounts, args...); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetAuthorStatusCounts.SelectContext")
+	}
+
+	return statusCountsMap(rows), nil
+}
+
+func statusCountsMap(rows []*models.NewsStatusCount) map[string]int {
+	counts := make(map[string]int, len(models.NewsStatuses))
+	for _, status := range models.NewsStatuses {
+		counts[status] = 0
+	}
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts
+}
+
+// Replace news tags, tags missing in tags table are created
+func (r *newsRepo) SetTags(ctx context.Context, newsID uuid.UUID, tags []string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.SetTags")
+	defer span.Finish()
+
+	if err := r.checkListSize("newsRepo.SetTags", len(tags)); err != nil {
+		return err
+	}
+
+	return postgres.WithTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		if _, err := r.timer.ExecContext(ctx, tx, "deleteNewsTags", deleteNewsTags, newsID); err != nil {
+			return errors.Wrap(err, "newsRepo.SetTags.deleteNewsTags")
+		}
+		if len(tags) == 0 {
+			return nil
+		}
+
+		names := utils.TextArray(tags)
+		if _, err := r.timer.ExecContext(ctx, tx, "createTags", createTags, names); err != nil {
+			return errors.Wrap(err, "newsRepo.SetTags.createTags")
+		}
+		if _, err := r.timer.ExecContext(ctx, tx, "addNewsTags", addNewsTags, newsID, names); err != nil {
+			return errors.Wrap(err, "newsRepo.SetTags.addNewsTags")
+		}
+		return nil
+	})
+}
+
+// Get news tags ordered by name
+func (r *newsRepo) GetTags(ctx context.Context, newsID uuid.UUID) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetTags")
+	defer span.Finish()
+
+	var tags = make([]string, 0)
+	if err := r.timer.SelectContext(ctx, r.db, "getNewsTags", &tags, getNewsTags, newsID); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetTags.SelectContext")
+	}
+
+	return tags, nil
+}
+
+// Add tag to not deleted news of ids in one transaction, returns number of news which did not have the tag before
+func (r *newsRepo) AddTagToMany(ctx context.Context, ids []uuid.UUID, tag string) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.AddTagToMany")
+	defer span.Finish()
+
+	if err := r.checkListSize("newsRepo.AddTagToMany", len(ids)); err != nil {
+		return 0, err
+	}
+
+	var affected int64
+	err := postgres.WithTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		if _, err := r.timer.ExecContext(ctx, tx, "createTags", createTags, utils.TextArray([]string{tag})); err != nil {
+			return errors.Wrap(err, "newsRepo.AddTagToMany.createTags")
+		}
+		result, err := r.timer.ExecContext(ctx, tx, "addTagToMany", addTagToMany, utils.UUIDArray(ids), tag, tenantArg(ctx))
+		if err != nil {
+			return errors.Wrap(err, "newsRepo.AddTagToMany.addTagToMany")
+		}
+		if affected, err = result.RowsAffected(); err != nil {
+			return errors.Wrap(err, "newsRepo.AddTagToMany.RowsAffected")
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
+
+// Create or replace translation of news in translation locale
+func (r *newsRepo) SetTranslation(ctx context.Context, translation *models.NewsTranslation) (*models.NewsTranslation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.SetTranslation")
+	defer span.Finish()
+
+	var t models.NewsTranslation
+	if err := r.timer.QueryRowxContext(
+		ctx,
+		r.db,
+		"setNewsTranslation",
+		setNewsTranslation,
+		translation.NewsID,
+		translation.Locale,
+		translation.Title,
+		translation.Content,
+	).StructScan(&t); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.SetTranslation.QueryRowxContext")
+	}
+
+	return &t, nil
+}
+
+// Get translation of news in locale, sql.ErrNoRows when news has none
+func (r *newsRepo) GetTranslation(ctx context.Context, newsID uuid.UUID, locale string) (*models.NewsTranslation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetTranslation")
+	defer span.Finish()
+
+	t := &models.NewsTranslation{}
+	if err := r.timer.GetContext(ctx, r.db, "getNewsTranslation", t, getNewsTranslation, newsID, locale); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetTranslation.GetContext")
+	}
+
+	return t, nil
+}
+
+// Create or move reading position of user in news
+func (r *newsRepo) SaveReadingPosition(ctx context.Context, position *models.ReadingPosition) (*models.ReadingPosition, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.SaveReadingPosition")
+	defer span.Finish()
+
+	var p models.ReadingPosition
+	if err := r.timer.QueryRowxContext(
+		ctx,
+		r.db,
+		"saveReadingPosition",
+		saveReadingPosition,
+		position.UserID,
+		position.NewsID,
+		position.Position,
+	).StructScan(&p); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.SaveReadingPosition.QueryRowxContext")
+	}
+
+	return &p, nil
+}
+
+// Get reading position of user in news, sql.ErrNoRows when user has none
+func (r *newsRepo) GetReadingPosition(ctx context.Context, userID uuid.UUID, newsID uuid.UUID) (*models.ReadingPosition, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetReadingPosition")
+	defer span.Finish()
+
+	p := &models.ReadingPosition{}
+	if err := r.timer.GetContext(ctx, r.db, "getReadingPosition", p, getReadingPosition, userID, newsID); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetReadingPosition.GetContext")
+	}
+
+	return p, nil
+}
+
+// Replace news metadata, nil metadata clears it
+func (r *newsRepo) SetMetadata(ctx context.Context, newsID uuid.UUID, metadata models.Metadata) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.SetMetadata")
+	defer span.Finish()
+
+	result, err := r.timer.ExecContext(ctx, r.db, "setNewsMetadata", setNewsMetadata, newsID, postgres.Sensitive(metadata))
+	if err != nil {
+		return errors.Wrap(err, "newsRepo.SetMetadata.ExecContext")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "newsRepo.SetMetadata.RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return errors.Wrap(sql.ErrNoRows, "newsRepo.SetMetadata.rowsAffected")
+	}
+
+	return nil
+}
+
+// Get news metadata, nil when news has none
+func (r *newsRepo) GetMetadata(ctx context.Context, newsID uuid.UUID) (models.Metadata, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetMetadata")
+	defer span.Finish()
+
+	var metadata models.Metadata
+	if err := r.timer.GetContext(ctx, r.db, "getNewsMetadata", &metadata, getNewsMetadata, newsID); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetMetadata.GetContext")
+	}
+
+	return metadata, nil
+}
+
+// Get news changed after since ordered by update time, deleted and unpublished news are returned as tombstones
+func (r *newsRepo) GetChangedSince(ctx context.Context, since time.Time, pq *utils.PaginationQuery) (*models.NewsChangesList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetChangedSince")
+	defer span.Finish()
+
+	var totalCount int
+	countArgs := withTenant(ctx, since)
+	if err := r.timer.GetContext(ctx, r.db, "getChangedSinceCount", &totalCount, getChangedSinceCount, countArgs...); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetChangedSince.GetContext.totalCount")
+	}
+
+	changes := make([]*models.NewsChange, 0, pq.GetSize())
+	if totalCount > 0 {
+		listArgs := withTenant(ctx, since, pq.GetOffset(), pq.GetLimit())
+		r.guard.Check(ctx, r.db, "getChangedSince", getChangedSince, listArgs...)
+
+		var newsList = make([]*models.News, 0, pq.GetSize())
+		if err := r.timer.SelectContext(ctx, r.db, "getChangedSince", &newsList, getChangedSince, listArgs...); err != nil {
+			return nil, errors.Wrap(err, "newsRepo.GetChangedSince.SelectContext")
+		}
+
+		for _, n := range newsList {
+			change := &models.NewsChange{NewsID: n.NewsID, UpdatedAt: n.UpdatedAt}
+			if n.DeletedAt != nil || n.Status != models.NewsStatusPublished {
+				change.Deleted = true
+			} else {
+				change.News = n
+			}
+			changes = append(changes, change)
+		}
+	}
+
+	return &models.NewsChangesList{
+		TotalCount: totalCount,
+		TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
+		Page:       pq.GetPage(),
+		Size:       pq.GetSize(),
+		HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+		Changes:    changes,
+	}, nil
+}
+
+// Physically delete news soft deleted before given time, every batch runs in own transaction.
+// Comments, revisions and tags of purged news are removed by cascade
+func (r *newsRepo) PurgeDeleted(ctx context.Context, before time.Time, batchSize int) ([]uuid.UUID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.PurgeDeleted")
+	defer span.Finish()
+
+	purged := make([]uuid.UUID, 0)
+	for {
+		var ids []uuid.UUID
+		err := postgres.WithTx(ctx, r.db, func(tx *sqlx.Tx) error {
+			ids = make([]uuid.UUID, 0, batchSize)
+			if err := r.timer.SelectContext(ctx, tx, "purgeDeletedNews", &ids, purgeDeletedNews, before, batchSize, tenantArg(ctx)); err != nil {
+				return errors.Wrap(err, "newsRepo.PurgeDeleted.SelectContext")
+			}
+			return nil
+		})
+		if err != nil {
+			return purged, err
+		}
+
+		purged = append(purged, ids...)
+		if len(ids) < batchSize {
+			return purged, nil
+		}
+	}
+}
+
+// Recalculate stored comment counts from comments table batch by batch, returns number of news with count corrected
+func (r *newsRepo) RecomputeCommentCounts(ctx context.Context, batchSize int) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.RecomputeCommentCounts")
+	defer span.Finish()
+
+	corrected := 0
+	afterID := uuid.Nil
+	for {
+		ids := make([]uuid.UUID, 0, batchSize)
+		if err := r.timer.SelectContext(ctx, r.db, "getNewsIDsAfter", &ids, getNewsIDsAfter, afterID, batchSize, tenantArg(ctx)); err != nil {
+			return corrected, errors.Wrap(err, "newsRepo.RecomputeCommentCounts.SelectContext")
+		}
+		if len(ids) == 0 {
+			return corrected, nil
+		}
+
+		result, err := r.timer.ExecContext(ctx, r.db, "fixCommentCounts", fixCommentCounts, utils.UUIDArray(ids), tenantArg(ctx))
+		if err != nil {
+			return corrected, errors.Wrap(err, "newsRepo.RecomputeCommentCounts.ExecContext")
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return corrected, errors.Wrap(err, "newsRepo.RecomputeCommentCounts.RowsAffected")
+		}
+		corrected += int(rowsAffected)
+
+		if len(ids) < batchSize {
+			return corrected, nil
+		}
+		afterID = ids[len(ids)-1]
+	}
+}
+
+// Get next page of not deleted news with image url, pages are ordered by news id starting after afterID
+func (r *newsRepo) GetNewsImages(ctx context.Context, afterID uuid.UUID, limit int) ([]*models.NewsImage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetNewsImages")
+	defer span.Finish()
+
+	images := make([]*models.NewsImage, 0, limit)
+	if err := r.timer.SelectContext(ctx, r.db, "getNewsImages", &images, getNewsImages, afterID, limit); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetNewsImages.SelectContext")
+	}
+
+	return images, nil
+}
+
+// Record image check result, checked news in broken get image_broken flag set and the rest get it cleared
+func (r *newsRepo) SetImageBroken(ctx context.Context, checked []uuid.UUID, broken []uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.SetImageBroken")
+	defer span.Finish()
+
+	if _, err := r.timer.ExecContext(ctx, r.db, "setImageBroken", setImageBroken, utils.UUIDArray(checked), utils.UUIDArray(broken)); err != nil {
+		return errors.Wrap(err, "newsRepo.SetImageBroken.ExecContext")
+	}
+
+	return nil
+}
+
+// Get not deleted news flagged with broken image url
+func (r *newsRepo) GetBrokenImages(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetBrokenImages")
+	defer span.Finish()
+
+	var totalCount int
+	if err := r.timer.GetContext(ctx, r.db, "getBrokenImagesCount", &totalCount, getBrokenImagesCount, tenantArg(ctx)); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetBrokenImages.GetContext.totalCount")
+	}
+
+	var newsList = make([]*models.News, 0, pq.GetSize())
+	if totalCount > 0 {
+		if err := r.timer.SelectContext(ctx, r.db, "getBrokenImages", &newsList, getBrokenImages, pq.GetOffset(), pq.GetLimit(), tenantArg(ctx)); err != nil {
+			return nil, errors.Wrap(err, "newsRepo.GetBrokenImages.SelectContext")
+		}
+	}
+
+	return &models.NewsList{
+		TotalCount: totalCount,
+		TotalPages: utils.GetTotalPages(totalCount, pq.GetSize()),
+		Page:       pq.GetPage(),
+		Size:       pq.GetSize(),
+		HasMore:    utils.GetHasMore(pq.GetPage(), totalCount, pq.GetSize()),
+		News:       newsList,
+	}, nil
+}
+
+// Link news to related one, returns false when news are already linked
+func (r *newsRepo) AddRelation(ctx context.Context, fromID uuid.UUID, toID uuid.UUID) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.AddRelation")
+	defer span.Finish()
+
+	result, err := r.timer.ExecContext(ctx, r.db, "addNewsRelation", addNewsRelation, fromID, toID, tenantArg(ctx))
+	if err != nil {
+		return false, errors.Wrap(err, "newsRepo.AddRelation.ExecContext")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "newsRepo.AddRelation.RowsAffected")
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// Remove link between news
+func (r *newsRepo) RemoveRelation(ctx context.Context, fromID uuid.UUID, toID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.RemoveRelation")
+	defer span.Finish()
+
+	result, err := r.timer.ExecContext(ctx, r.db, "removeNewsRelation", removeNewsRelation, fromID, toID, tenantArg(ctx))
+	if err != nil {
+		return errors.Wrap(err, "newsRepo.RemoveRelation.ExecContext")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "newsRepo.RemoveRelation.RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return errors.Wrap(sql.ErrNoRows, "newsRepo.RemoveRelation.rowsAffected")
+	}
+
+	return nil
+}
+
+// Get published news linked from given news in order links were added
+func (r *newsRepo) GetCuratedRelated(ctx context.Context, newsID uuid.UUID) ([]*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetCuratedRelated")
+	defer span.Finish()
+
+	related := make([]*models.News, 0)
+	args := withTenant(ctx, newsID)
+	if err := r.timer.SelectContext(ctx, r.db, "getCuratedRelated", &related, getCuratedRelated, args...); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetCuratedRelated.SelectContext")
+	}
+
+	return related, nil
+}
+
+// Add counted views to news, ids and views are aligned. Deleted news are skipped
+func (r *newsRepo) AddViews(ctx context.Context, ids []uuid.UUID, views []int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.AddViews")
+	defer span.Finish()
+
+	if err := r.checkListSize("newsRepo.AddViews", len(ids)); err != nil {
+		return err
+	}
+
+	counts := make([]string, 0, len(views))
+	for _, v := range views {
+		counts = append(counts, strconv.FormatInt(v, 10))
+	}
+	if _, err := r.timer.ExecContext(ctx, r.db, "addNewsViews", addNewsViews, utils.UUIDArray(ids), utils.TextArray(counts)); err != nil {
+		return errors.Wrap(err, "newsRepo.AddViews.ExecContext")
+	}
+
+	return nil
+}
+
+// Get published news ordered by trending score, see utils.TrendingScore, news without views are ordered by recency
+func (r *newsRepo) GetTrending(ctx context.Context, gravity float64, limit int) ([]*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRepo.GetTrending")
+	defer span.Finish()
+
+	trending := make([]*models.News, 0, limit)
+	args := withTenant(ctx, gravity, limit)
+	if err := r.timer.SelectContext(ctx, r.db, "getTrendingNews", &trending, getTrendingNews, args...); err != nil {
+		return nil, errors.Wrap(err, "newsRepo.GetTrending.SelectContext")
+	}
+
+	return trending, nil
+}
@@ -2,14 +2,27 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 
+	"github.com/AleksK1NG/api-mc/config"
 	"github.com/AleksK1NG/api-mc/internal/models"
+	"github.com/AleksK1NG/api-mc/pkg/db/postgres"
+	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+	"github.com/AleksK1NG/api-mc/pkg/utils"
 )
 
 func TestNewsRepo_Create(t *testing.T) {
@@ -22,7 +35,7 @@ func TestNewsRepo_Create(t *testing.T) {
 	sqlxDB := sqlx.NewDb(db, "sqlmock")
 	defer sqlxDB.Close()
 
-	newsRepo := NewNewsRepository(sqlxDB)
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
 
 	t.Run("Create", func(t *testing.T) {
 		authorUID := uuid.New()
@@ -37,13 +50,80 @@ func TestNewsRepo_Create(t *testing.T) {
 			Content:  content,
 		}
 
-		mock.ExpectQuery(createNews).WithArgs(news.AuthorID, news.Title, news.Content, news.Category).WillReturnRows(rows)
+		mock.ExpectBegin()
+		mock.ExpectQuery(createNews).WithArgs(news.AuthorID, news.Title, news.Content, news.Category, news.Status, news.Slug, news.ContentHash, 1, nil).WillReturnRows(rows)
+		mock.ExpectExec(createNewsRevision).
+			WithArgs(uuid.Nil, authorUID, title, content, nil, nil, "", "title,content").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(createNewsEvent).WithArgs(uuid.Nil, authorUID, models.NewsActionCreate).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
 
 		createdNews, err := newsRepo.Create(context.Background(), news)
 
 		require.NoError(t, err)
 		require.NotNil(t, createdNews)
 		require.Equal(t, news.Title, createdNews.Title)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_CreateIfNotExists(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	authorUID := uuid.New()
+	slug := "sync-job-title"
+	title := "Sync job title"
+	content := "content"
+
+	t.Run("Created", func(t *testing.T) {
+		news := &models.News{AuthorID: authorUID, Title: title, Content: content, Slug: &slug}
+		rows := sqlmock.NewRows([]string{"author_id", "title", "content", "slug"}).AddRow(authorUID, title, content, slug)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(createNewsIfNotExists).
+			WithArgs(news.AuthorID, news.Title, news.Content, news.Category, news.Status, news.Slug, news.ContentHash, 1, nil).
+			WillReturnRows(rows)
+		mock.ExpectExec(createNewsRevision).
+			WithArgs(uuid.Nil, authorUID, title, content, nil, nil, "", "title,content").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(createNewsEvent).WithArgs(uuid.Nil, authorUID, models.NewsActionCreate).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		n, created, err := newsRepo.CreateIfNotExists(context.Background(), news)
+		require.NoError(t, err)
+		require.True(t, created)
+		require.Equal(t, slug, *n.Slug)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Already exists", func(t *testing.T) {
+		existingUID := uuid.New()
+		news := &models.News{AuthorID: authorUID, Title: title, Content: "other content", Slug: &slug}
+		rows := sqlmock.NewRows([]string{"news_id", "author_id", "title", "content", "slug"}).
+			AddRow(existingUID, authorUID, title, content, slug)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(createNewsIfNotExists).
+			WithArgs(news.AuthorID, news.Title, news.Content, news.Category, news.Status, news.Slug, news.ContentHash, 2, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id"}))
+		mock.ExpectQuery(getNewsBySlug).WithArgs(news.Slug, nil).WillReturnRows(rows)
+		mock.ExpectCommit()
+
+		n, created, err := newsRepo.CreateIfNotExists(context.Background(), news)
+		require.NoError(t, err)
+		require.False(t, created)
+		require.Equal(t, existingUID, n.NewsID)
+		require.Equal(t, content, n.Content)
+		require.NoError(t, mock.ExpectationsWereMet())
 	})
 }
 
@@ -57,7 +137,7 @@ func TestNewsRepo_Update(t *testing.T) {
 	sqlxDB := sqlx.NewDb(db, "sqlmock")
 	defer sqlxDB.Close()
 
-	newsRepo := NewNewsRepository(sqlxDB)
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
 
 	t.Run("Update", func(t *testing.T) {
 		newsUID := uuid.New()
@@ -72,18 +152,31 @@ func TestNewsRepo_Update(t *testing.T) {
 			Content: content,
 		}
 
+		actorUID := uuid.New()
+		prevRows := sqlmock.NewRows([]string{"news_id", "title", "content"}).AddRow(newsUID, "old title", content)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(getNewsForUpdate).WithArgs(news.NewsID).WillReturnRows(prevRows)
 		mock.ExpectQuery(updateNews).WithArgs(news.Title,
 			news.Content,
 			news.ImageURL,
 			news.Category,
 			news.NewsID,
+			news.ContentHash,
+			utils.WordCount(news.Content),
 		).WillReturnRows(rows)
+		mock.ExpectExec(createNewsRevision).
+			WithArgs(newsUID, actorUID, title, content, nil, nil, "", "title").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(createNewsEvent).WithArgs(newsUID, actorUID, models.NewsActionUpdate).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
 
-		updatedNews, err := newsRepo.Update(context.Background(), news)
+		updatedNews, err := newsRepo.Update(context.Background(), news, actorUID)
 
 		require.NoError(t, err)
 		require.NotNil(t, updateNews)
 		require.Equal(t, updatedNews, news)
+		require.NoError(t, mock.ExpectationsWereMet())
 	})
 }
 
@@ -97,14 +190,2736 @@ func TestNewsRepo_Delete(t *testing.T) {
 	sqlxDB := sqlx.NewDb(db, "sqlmock")
 	defer sqlxDB.Close()
 
-	newsRepo := NewNewsRepository(sqlxDB)
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
 
 	t.Run("Delete", func(t *testing.T) {
 		newsUID := uuid.New()
+		actorUID := uuid.New()
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteNews).WithArgs(newsUID).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(createNewsEvent).WithArgs(newsUID, actorUID, models.NewsActionDelete).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := newsRepo.Delete(context.Background(), newsUID, actorUID)
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+func TestNewsRepo_RecomputeCommentCounts(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	t.Run("Drifted count corrected", func(t *testing.T) {
+		// Second news of first batch has stored count out of sync, the only row update matches
+		firstUID := uuid.MustParse("00000000-0000-4000-8000-000000000001")
+		driftedUID := uuid.MustParse("00000000-0000-4000-8000-000000000002")
+		lastUID := uuid.MustParse("00000000-0000-4000-8000-000000000003")
+
+		mock.ExpectQuery(getNewsIDsAfter).WithArgs(uuid.Nil, 2, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id"}).AddRow(firstUID).AddRow(driftedUID))
+		mock.ExpectExec(fixCommentCounts).WithArgs(utils.UUIDArray([]uuid.UUID{firstUID, driftedUID}), nil).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(getNewsIDsAfter).WithArgs(driftedUID, 2, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id"}).AddRow(lastUID))
+		mock.ExpectExec(fixCommentCounts).WithArgs(utils.UUIDArray([]uuid.UUID{lastUID}), nil).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		corrected, err := newsRepo.RecomputeCommentCounts(context.Background(), 2)
+		require.NoError(t, err)
+		require.Equal(t, 1, corrected)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("No news", func(t *testing.T) {
+		mock.ExpectQuery(getNewsIDsAfter).WithArgs(uuid.Nil, 2, nil).WillReturnRows(sqlmock.NewRows([]string{"news_id"}))
+
+		corrected, err := newsRepo.RecomputeCommentCounts(context.Background(), 2)
+		require.NoError(t, err)
+		require.Zero(t, corrected)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Corrected batches kept on failure", func(t *testing.T) {
+		firstUID := uuid.New()
+		secondUID := uuid.New()
+
+		mock.ExpectQuery(getNewsIDsAfter).WithArgs(uuid.Nil, 2, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id"}).AddRow(firstUID).AddRow(secondUID))
+		mock.ExpectExec(fixCommentCounts).WithArgs(utils.UUIDArray([]uuid.UUID{firstUID, secondUID}), nil).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectQuery(getNewsIDsAfter).WithArgs(secondUID, 2, nil).WillReturnError(sql.ErrConnDone)
+
+		corrected, err := newsRepo.RecomputeCommentCounts(context.Background(), 2)
+		require.Error(t, err)
+		require.Equal(t, 2, corrected)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_Hooks(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+
+	newsRepo := NewNewsRepository(sqlxDB, cfg, apiLogger)
+
+	var created, deleted []uuid.UUID
+	newsRepo.Hooks().OnCreated(func(ctx context.Context, n *models.News) error {
+		created = append(created, n.NewsID)
+		return nil
+	})
+	newsRepo.Hooks().OnDeleted(func(ctx context.Context, n *models.News) error {
+		deleted = append(deleted, n.NewsID)
+		return nil
+	})
+
+	authorUID := uuid.New()
+	news := &models.News{AuthorID: authorUID, Title: "title", Content: "content"}
+
+	t.Run("Fire on commit", func(t *testing.T) {
+		created, deleted = nil, nil
+		newsUID := uuid.New()
+		rows := sqlmock.NewRows([]string{"news_id", "author_id", "title", "content"}).AddRow(newsUID, authorUID, news.Title, news.Content)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(createNews).WithArgs(news.AuthorID, news.Title, news.Content, news.Category, news.Status, news.Slug, news.ContentHash, 1, nil).WillReturnRows(rows)
+		mock.ExpectExec(createNewsRevision).
+			WithArgs(newsUID, authorUID, news.Title, news.Content, nil, nil, "", "title,content").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(createNewsEvent).WithArgs(newsUID, authorUID, models.NewsActionCreate).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
 		mock.ExpectExec(deleteNews).WithArgs(newsUID).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(createNewsEvent).WithArgs(newsUID, authorUID, models.NewsActionDelete).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		_, err := newsRepo.Create(context.Background(), news)
+		require.NoError(t, err)
+		err = newsRepo.Delete(context.Background(), newsUID, authorUID)
+		require.NoError(t, err)
+
+		require.Equal(t, []uuid.UUID{newsUID}, created)
+		require.Equal(t, []uuid.UUID{newsUID}, deleted)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Not fired on rollback", func(t *testing.T) {
+		created, deleted = nil, nil
+		newsUID := uuid.New()
+		rows := sqlmock.NewRows([]string{"news_id", "author_id", "title", "content"}).AddRow(newsUID, authorUID, news.Title, news.Content)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(createNews).WithArgs(news.AuthorID, news.Title, news.Content, news.Category, news.Status, news.Slug, news.ContentHash, 1, nil).WillReturnRows(rows)
+		mock.ExpectExec(createNewsRevision).
+			WithArgs(newsUID, authorUID, news.Title, news.Content, nil, nil, "", "title,content").
+			WillReturnError(errors.New("revision insert failed"))
+		mock.ExpectRollback()
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteNews).WithArgs(newsUID).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err := newsRepo.Create(context.Background(), news)
+		require.Error(t, err)
+		err = newsRepo.Delete(context.Background(), newsUID, authorUID)
+		require.Error(t, err)
+
+		require.Empty(t, created)
+		require.Empty(t, deleted)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Published on status change", func(t *testing.T) {
+		var published []*models.News
+		publishRepo := NewNewsRepository(sqlxDB, cfg, apiLogger)
+		publishRepo.Hooks().OnPublished(func(ctx context.Context, n *models.News) error {
+			published = append(published, n)
+			return nil
+		})
+
+		draftUID := uuid.New()
+		rows := sqlmock.NewRows([]string{"news_id", "author_id", "title", "status"}).AddRow(draftUID, authorUID, "draft", models.NewsStatusDraft)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(getNewsStatusesForUpdate).WithArgs(utils.UUIDArray([]uuid.UUID{draftUID}), nil).WillReturnRows(rows)
+		mock.ExpectExec(updateNewsStatusBatch).
+			WithArgs(models.NewsStatusPublished, utils.UUIDArray([]uuid.UUID{draftUID}), nil).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		_, err := publishRepo.UpdateStatusBatch(context.Background(), []uuid.UUID{draftUID}, models.NewsStatusPublished, false)
+		require.NoError(t, err)
+		require.Equal(t, []*models.News{{NewsID: draftUID, AuthorID: authorUID, Title: "draft", Status: models.NewsStatusPublished}}, published)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failing hook is isolated", func(t *testing.T) {
+		created, deleted = nil, nil
+		failingRepo := NewNewsRepository(sqlxDB, cfg, apiLogger)
+		var calls int
+		failingRepo.Hooks().OnCreated(func(ctx context.Context, n *models.News) error {
+			calls++
+			return errors.New("hook failed")
+		})
+		failingRepo.Hooks().OnCreated(func(ctx context.Context, n *models.News) error {
+			calls++
+			panic("hook panic")
+		})
+		failingRepo.Hooks().OnCreated(func(ctx context.Context, n *models.News) error {
+			calls++
+			return nil
+		})
+
+		newsUID := uuid.New()
+		rows := sqlmock.NewRows([]string{"news_id", "author_id", "title", "content"}).AddRow(newsUID, authorUID, news.Title, news.Content)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(createNews).WithArgs(news.AuthorID, news.Title, news.Content, news.Category, news.Status, news.Slug, news.ContentHash, 1, nil).WillReturnRows(rows)
+		mock.ExpectExec(createNewsRevision).
+			WithArgs(newsUID, authorUID, news.Title, news.Content, nil, nil, "", "title,content").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(createNewsEvent).WithArgs(newsUID, authorUID, models.NewsActionCreate).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		createdNews, err := failingRepo.Create(context.Background(), news)
+		require.NoError(t, err)
+		require.Equal(t, newsUID, createdNews.NewsID)
+		require.Equal(t, 3, calls)
+		require.Empty(t, created)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_GetTimeline(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	t.Run("GetTimeline", func(t *testing.T) {
+		jan := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+		mar := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+		apr := time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+		rows := sqlmock.NewRows([]string{"month", "count"}).
+			AddRow(jan, 3).
+			AddRow(mar, 1).
+			AddRow(apr, 7)
+
+		mock.ExpectQuery(getTimeline).WillReturnRows(rows)
+
+		timeline, err := newsRepo.GetTimeline(context.Background())
+		require.NoError(t, err)
+		require.Len(t, timeline, 3)
+		require.Equal(t, jan, timeline[0].Month)
+		require.Equal(t, 3, timeline[0].Count)
+		require.Equal(t, mar, timeline[1].Month)
+		require.Equal(t, 1, timeline[1].Count)
+		require.Equal(t, apr, timeline[2].Month)
+		require.Equal(t, 7, timeline[2].Count)
+	})
+
+	t.Run("GetTimeline empty", func(t *testing.T) {
+		mock.ExpectQuery(getTimeline).WillReturnRows(sqlmock.NewRows([]string{"month", "count"}))
+
+		timeline, err := newsRepo.GetTimeline(context.Background())
+		require.NoError(t, err)
+		require.NotNil(t, timeline)
+		require.Len(t, timeline, 0)
+	})
+}
+
+func TestNewsRepo_GetNeighbors(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	columns := []string{"news_id", "title", "content", "created_at"}
+	createdAt := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+	prevUID := uuid.New()
+	nextUID := uuid.New()
+
+	t.Run("First", func(t *testing.T) {
+		newsUID := uuid.New()
+		mock.ExpectQuery(getNewsCreatedAt).WithArgs(newsUID, nil).WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(createdAt))
+		mock.ExpectQuery(getPrevNews).WithArgs(createdAt, newsUID, nil).WillReturnRows(sqlmock.NewRows(columns))
+		mock.ExpectQuery(getNextNews).WithArgs(createdAt, newsUID, nil).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(nextUID, "next title", "next content", createdAt.Add(time.Hour)))
+
+		prev, next, err := newsRepo.GetNeighbors(context.Background(), newsUID)
+		require.NoError(t, err)
+		require.Nil(t, prev)
+		require.NotNil(t, next)
+		require.Equal(t, nextUID, next.NewsID)
+	})
+
+	t.Run("Middle", func(t *testing.T) {
+		newsUID := uuid.New()
+		mock.ExpectQuery(getNewsCreatedAt).WithArgs(newsUID, nil).WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(createdAt))
+		mock.ExpectQuery(getPrevNews).WithArgs(createdAt, newsUID, nil).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(prevUID, "prev title", "prev content", createdAt.Add(-time.Hour)))
+		mock.ExpectQuery(getNextNews).WithArgs(createdAt, newsUID, nil).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(nextUID, "next title", "next content", createdAt.Add(time.Hour)))
+
+		prev, next, err := newsRepo.GetNeighbors(context.Background(), newsUID)
+		require.NoError(t, err)
+		require.NotNil(t, prev)
+		require.NotNil(t, next)
+		require.Equal(t, prevUID, prev.NewsID)
+		require.Equal(t, nextUID, next.NewsID)
+	})
+
+	t.Run("Last", func(t *testing.T) {
+		newsUID := uuid.New()
+		mock.ExpectQuery(getNewsCreatedAt).WithArgs(newsUID, nil).WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(createdAt))
+		mock.ExpectQuery(getPrevNews).WithArgs(createdAt, newsUID, nil).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(prevUID, "prev title", "prev content", createdAt.Add(-time.Hour)))
+		mock.ExpectQuery(getNextNews).WithArgs(createdAt, newsUID, nil).WillReturnRows(sqlmock.NewRows(columns))
+
+		prev, next, err := newsRepo.GetNeighbors(context.Background(), newsUID)
+		require.NoError(t, err)
+		require.NotNil(t, prev)
+		require.Nil(t, next)
+		require.Equal(t, prevUID, prev.NewsID)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		newsUID := uuid.New()
+		mock.ExpectQuery(getNewsCreatedAt).WithArgs(newsUID, nil).WillReturnRows(sqlmock.NewRows([]string{"created_at"}))
+
+		prev, next, err := newsRepo.GetNeighbors(context.Background(), newsUID)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, sql.ErrNoRows))
+		require.Nil(t, prev)
+		require.Nil(t, next)
+	})
+}
+
+func TestNewsRepo_UpdateStatusBatch(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	t.Run("Mixed transitions", func(t *testing.T) {
+		draftUID := uuid.New()
+		publishedUID := uuid.New()
+		archivedUID := uuid.New()
+		ids := []uuid.UUID{draftUID, publishedUID, archivedUID}
+
+		rows := sqlmock.NewRows([]string{"news_id", "status"}).
+			AddRow(draftUID, models.NewsStatusDraft).
+			AddRow(publishedUID, models.NewsStatusPublished).
+			AddRow(archivedUID, models.NewsStatusArchived)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(getNewsStatusesForUpdate).WithArgs(utils.UUIDArray(ids), nil).WillReturnRows(rows)
+		mock.ExpectExec(updateNewsStatusBatch).
+			WithArgs(models.NewsStatusPublished, utils.UUIDArray([]uuid.UUID{draftUID, archivedUID}), nil).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		affected, err := newsRepo.UpdateStatusBatch(context.Background(), ids, models.NewsStatusPublished, false)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{draftUID, archivedUID}, affected)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Dry run matches real run", func(t *testing.T) {
+		draftUID := uuid.New()
+		publishedUID := uuid.New()
+		archivedUID := uuid.New()
+		ids := []uuid.UUID{draftUID, publishedUID, archivedUID}
+		newRows := func() *sqlmock.Rows {
+			return sqlmock.NewRows([]string{"news_id", "status"}).
+				AddRow(draftUID, models.NewsStatusDraft).
+				AddRow(publishedUID, models.NewsStatusPublished).
+				AddRow(archivedUID, models.NewsStatusArchived)
+		}
+
+		// Dry run only runs the select, no update is expected
+		mock.ExpectBegin()
+		mock.ExpectQuery(getNewsStatusesForUpdate).WithArgs(utils.UUIDArray(ids), nil).WillReturnRows(newRows())
+		mock.ExpectCommit()
+
+		wouldChange, err := newsRepo.UpdateStatusBatch(context.Background(), ids, models.NewsStatusPublished, true)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(getNewsStatusesForUpdate).WithArgs(utils.UUIDArray(ids), nil).WillReturnRows(newRows())
+		mock.ExpectExec(updateNewsStatusBatch).
+			WithArgs(models.NewsStatusPublished, utils.UUIDArray(wouldChange), nil).
+			WillReturnResult(sqlmock.NewResult(0, int64(len(wouldChange))))
+		mock.ExpectCommit()
+
+		changed, err := newsRepo.UpdateStatusBatch(context.Background(), ids, models.NewsStatusPublished, false)
+		require.NoError(t, err)
+		require.Equal(t, changed, wouldChange)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("No allowed transitions", func(t *testing.T) {
+		publishedUID := uuid.New()
+		ids := []uuid.UUID{publishedUID}
+
+		rows := sqlmock.NewRows([]string{"news_id", "status"}).AddRow(publishedUID, models.NewsStatusPublished)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(getNewsStatusesForUpdate).WithArgs(utils.UUIDArray(ids), nil).WillReturnRows(rows)
+		mock.ExpectCommit()
+
+		affected, err := newsRepo.UpdateStatusBatch(context.Background(), ids, models.NewsStatusPublished, false)
+		require.NoError(t, err)
+		require.Len(t, affected, 0)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_GetMyDrafts(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	pq := &utils.PaginationQuery{Size: 10, Page: 1}
+
+	t.Run("Scoped to author", func(t *testing.T) {
+		authorUID := uuid.New()
+		mock.ExpectQuery(getDraftsCountByAuthor).WithArgs(authorUID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectQuery(getDraftsByAuthor).WithArgs(authorUID, pq.GetOffset(), pq.GetLimit()).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "author_id", "title", "status"}).
+				AddRow(uuid.New(), authorUID, "first draft", models.NewsStatusDraft).
+				AddRow(uuid.New(), authorUID, "second draft", models.NewsStatusDraft))
+
+		drafts, err := newsRepo.GetMyDrafts(context.Background(), authorUID, pq)
+		require.NoError(t, err)
+		require.Equal(t, 2, drafts.TotalCount)
+		require.Len(t, drafts.News, 2)
+		for _, n := range drafts.News {
+			require.Equal(t, authorUID, n.AuthorID)
+			require.Equal(t, models.NewsStatusDraft, n.Status)
+		}
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		otherAuthorUID := uuid.New()
+		mock.ExpectQuery(getDraftsCountByAuthor).WithArgs(otherAuthorUID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		drafts, err := newsRepo.GetMyDrafts(context.Background(), otherAuthorUID, pq)
+		require.NoError(t, err)
+		require.Equal(t, 0, drafts.TotalCount)
+		require.NotNil(t, drafts.News)
+		require.Len(t, drafts.News, 0)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_GetEditedBy(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	pq := &utils.PaginationQuery{Size: 10, Page: 1}
+
+	t.Run("Only updates of user, each news once", func(t *testing.T) {
+		editorUID := uuid.New()
+		lastEditedUID := uuid.New()
+		firstEditedUID := uuid.New()
+		mock.ExpectQuery(getEditedByCount).WithArgs(editorUID, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectQuery(getEditedBy).WithArgs(editorUID, pq.GetOffset(), pq.GetLimit(), nil).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "author_id", "title", "status"}).
+				AddRow(lastEditedUID, uuid.New(), "edited last", models.NewsStatusPublished).
+				AddRow(firstEditedUID, uuid.New(), "edited first", models.NewsStatusDraft))
+
+		edited, err := newsRepo.GetEditedBy(context.Background(), editorUID, pq)
+		require.NoError(t, err)
+		require.Equal(t, 2, edited.TotalCount)
+		require.Len(t, edited.News, 2)
+		require.Equal(t, lastEditedUID, edited.News[0].NewsID)
+		require.Equal(t, firstEditedUID, edited.News[1].NewsID)
+		require.Contains(t, getEditedBy, "WHERE actor_id = $1 AND action = 'update'")
+		require.Contains(t, getEditedBy, "GROUP BY news_id")
+		require.Contains(t, getEditedBy, "ORDER BY e.edited_at DESC, n.news_id DESC")
+		require.Contains(t, getEditedByCount, "COUNT(DISTINCT e.news_id)")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Nothing edited", func(t *testing.T) {
+		readerUID := uuid.New()
+		mock.ExpectQuery(getEditedByCount).WithArgs(readerUID, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		edited, err := newsRepo.GetEditedBy(context.Background(), readerUID, pq)
+		require.NoError(t, err)
+		require.Equal(t, 0, edited.TotalCount)
+		require.NotNil(t, edited.News)
+		require.Len(t, edited.News, 0)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_GetOrphaned(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	pq := &utils.PaginationQuery{Size: 10, Page: 1}
+
+	t.Run("Orphaned news surfaced", func(t *testing.T) {
+		orphanedUID := uuid.New()
+		deletedAuthorUID := uuid.New()
+		mock.ExpectQuery(getOrphanedCount).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(getOrphaned).WithArgs(pq.GetOffset(), pq.GetLimit(), nil).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "author_id", "title", "status"}).
+				AddRow(orphanedUID, deletedAuthorUID, "orphaned news", models.NewsStatusPublished))
+
+		orphaned, err := newsRepo.GetOrphaned(context.Background(), pq)
+		require.NoError(t, err)
+		require.Equal(t, 1, orphaned.TotalCount)
+		require.Len(t, orphaned.News, 1)
+		require.Equal(t, orphanedUID, orphaned.News[0].NewsID)
+		require.Equal(t, deletedAuthorUID, orphaned.News[0].AuthorID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		mock.ExpectQuery(getOrphanedCount).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		orphaned, err := newsRepo.GetOrphaned(context.Background(), pq)
+		require.NoError(t, err)
+		require.Equal(t, 0, orphaned.TotalCount)
+		require.NotNil(t, orphaned.News)
+		require.Len(t, orphaned.News, 0)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_ReassignAuthor(t *testing.T) {
+	t.Parallel()
 
-		err := newsRepo.Delete(context.Background(), newsUID)
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	t.Run("ReassignAuthor", func(t *testing.T) {
+		orphanedUID := uuid.New()
+		ids := []uuid.UUID{orphanedUID, uuid.New()}
+		authorUID := uuid.New()
+		mock.ExpectQuery(reassignOrphanedAuthor).WithArgs(authorUID, utils.UUIDArray(ids), nil).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id"}).AddRow(orphanedUID))
+
+		affected, err := newsRepo.ReassignAuthor(context.Background(), ids, authorUID, false)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{orphanedUID}, affected)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
 
+	t.Run("Dry run does not update", func(t *testing.T) {
+		orphanedUID := uuid.New()
+		ids := []uuid.UUID{orphanedUID, uuid.New()}
+		authorUID := uuid.New()
+		mock.ExpectQuery(getOrphanedByIDs).WithArgs(utils.UUIDArray(ids), nil).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id"}).AddRow(orphanedUID))
+
+		affected, err := newsRepo.ReassignAuthor(context.Background(), ids, authorUID, true)
 		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{orphanedUID}, affected)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_GetRecentlyUpdated(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	pq := &utils.PaginationQuery{Size: 10, Page: 1}
+
+	t.Run("Ordered by update time", func(t *testing.T) {
+		now := time.Now()
+		mock.ExpectQuery(getTotalCount).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+		mock.ExpectQuery(getRecentlyUpdatedNews).WithArgs(pq.GetOffset(), pq.GetLimit(), nil).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "title", "created_at", "updated_at"}).
+				AddRow(uuid.New(), "oldest but edited", now.Add(-72*time.Hour), now).
+				AddRow(uuid.New(), "newest", now.Add(-1*time.Hour), now.Add(-1*time.Hour)).
+				AddRow(uuid.New(), "edited yesterday", now.Add(-48*time.Hour), now.Add(-24*time.Hour)))
+
+		newsList, err := newsRepo.GetRecentlyUpdated(context.Background(), pq)
+		require.NoError(t, err)
+		require.Equal(t, 3, newsList.TotalCount)
+		require.Len(t, newsList.News, 3)
+		require.Equal(t, "oldest but edited", newsList.News[0].Title)
+		for i := 1; i < len(newsList.News); i++ {
+			require.False(t, newsList.News[i].UpdatedAt.After(newsList.News[i-1].UpdatedAt))
+		}
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_GetWithoutComments(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	pq := &utils.PaginationQuery{Size: 10, Page: 1}
+
+	t.Run("Only news without comments", func(t *testing.T) {
+		uncommented := uuid.New()
+		mock.ExpectQuery(getTotalWithoutComments).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(getNewsWithoutComments).WithArgs(pq.GetOffset(), pq.GetLimit(), nil).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "title", "status"}).
+				AddRow(uncommented, "nobody commented", models.NewsStatusPublished))
+
+		newsList, err := newsRepo.GetWithoutComments(context.Background(), pq)
+		require.NoError(t, err)
+		require.Equal(t, 1, newsList.TotalCount)
+		require.Len(t, newsList.News, 1)
+		require.Equal(t, uncommented, newsList.News[0].NewsID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Everything commented", func(t *testing.T) {
+		mock.ExpectQuery(getTotalWithoutComments).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		newsList, err := newsRepo.GetWithoutComments(context.Background(), pq)
+		require.NoError(t, err)
+		require.Equal(t, 0, newsList.TotalCount)
+		require.NotNil(t, newsList.News)
+		require.Empty(t, newsList.News)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Anti join on comments of published news", func(t *testing.T) {
+		for _, query := range []string{getTotalWithoutComments, getNewsWithoutComments} {
+			require.Contains(t, query, "NOT EXISTS (SELECT 1 FROM comments c WHERE c.news_id = n.news_id)")
+			require.Contains(t, query, "n.status = 'published' AND n.deleted_at IS NULL")
+		}
+	})
+}
+
+type warnRecorder struct {
+	logger.Logger
+	warnings []string
+}
+
+func (w *warnRecorder) Warnf(template string, args ...interface{}) {
+	w.warnings = append(w.warnings, fmt.Sprintf(template, args...))
+}
+
+func TestNewsRepo_SlowQueryLog(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	cfg := &config.Config{Postgres: config.PostgresConfig{SlowQueryThreshold: 10 * time.Millisecond}}
+
+	t.Run("Slow statement is logged", func(t *testing.T) {
+		recorder := &warnRecorder{}
+		newsRepo := NewNewsRepository(sqlxDB, cfg, recorder)
+
+		newsUID := uuid.New()
+		mock.ExpectPrepare(getNewsByID)
+		mock.ExpectQuery(getNewsByID).WithArgs(newsUID).
+			WillDelayFor(50 * time.Millisecond).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "title"}).AddRow(newsUID, "title"))
+
+		_, err := newsRepo.GetNewsByID(context.Background(), newsUID)
+		require.NoError(t, err)
+		require.Len(t, recorder.warnings, 1)
+		require.Contains(t, recorder.warnings[0], "getNewsByID")
+		require.Contains(t, recorder.warnings[0], "Params: 1")
+		require.NotContains(t, recorder.warnings[0], newsUID.String())
+	})
+
+	t.Run("Fast statement is not logged", func(t *testing.T) {
+		recorder := &warnRecorder{}
+		newsRepo := NewNewsRepository(sqlxDB, cfg, recorder)
+
+		newsUID := uuid.New()
+		mock.ExpectPrepare(getNewsByID)
+		mock.ExpectQuery(getNewsByID).WithArgs(newsUID).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "title"}).AddRow(newsUID, "title"))
+
+		_, err := newsRepo.GetNewsByID(context.Background(), newsUID)
+		require.NoError(t, err)
+		require.Len(t, recorder.warnings, 0)
+	})
+}
+
+func TestNewsRepo_GetNewsByID(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	columns := []string{"news_id", "title", "content", "author", "avatar_url", "author_id"}
+
+	t.Run("Author with avatar", func(t *testing.T) {
+		newsUID := uuid.New()
+		authorUID := uuid.New()
+		avatarURL := "https://example.com/avatar.png"
+
+		mock.ExpectPrepare(getNewsByID)
+		mock.ExpectQuery(getNewsByID).WithArgs(newsUID).WillReturnRows(
+			sqlmock.NewRows(columns).AddRow(newsUID, "title", "content", "John Doe", avatarURL, authorUID))
+
+		n, err := newsRepo.GetNewsByID(context.Background(), newsUID)
+		require.NoError(t, err)
+		require.Equal(t, "John Doe", *n.Author)
+		require.NotNil(t, n.AvatarURL)
+		require.Equal(t, avatarURL, *n.AvatarURL)
+	})
+
+	t.Run("Author without avatar", func(t *testing.T) {
+		newsUID := uuid.New()
+		authorUID := uuid.New()
+
+		mock.ExpectQuery(getNewsByID).WithArgs(newsUID).WillReturnRows(
+			sqlmock.NewRows(columns).AddRow(newsUID, "title", "content", "John Doe", nil, authorUID))
+
+		n, err := newsRepo.GetNewsByID(context.Background(), newsUID)
+		require.NoError(t, err)
+		require.Equal(t, "John Doe", *n.Author)
+		require.Nil(t, n.AvatarURL)
+	})
+
+	t.Run("Deleted author", func(t *testing.T) {
+		newsUID := uuid.New()
+		authorUID := uuid.New()
+
+		mock.ExpectQuery(getNewsByID).WithArgs(newsUID).WillReturnRows(
+			sqlmock.NewRows(columns).AddRow(newsUID, "title", "content", nil, nil, authorUID))
+
+		n, err := newsRepo.GetNewsByID(context.Background(), newsUID)
+		require.NoError(t, err)
+		require.Nil(t, n.Author)
+		require.Nil(t, n.AvatarURL)
+		require.Equal(t, authorUID, n.AuthorID)
+	})
+}
+
+func TestNewsRepo_PreparedStatements(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	t.Run("Statement reused across calls", func(t *testing.T) {
+		firstUID := uuid.New()
+		secondUID := uuid.New()
+
+		prepared := mock.ExpectPrepare(getNewsByID).WillBeClosed()
+		prepared.ExpectQuery().WithArgs(firstUID).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "title"}).AddRow(firstUID, "first"))
+		prepared.ExpectQuery().WithArgs(secondUID).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "title"}).AddRow(secondUID, "second"))
+
+		first, err := newsRepo.GetNewsByID(context.Background(), firstUID)
+		require.NoError(t, err)
+		require.Equal(t, "first", first.Title)
+
+		second, err := newsRepo.GetNewsByID(context.Background(), secondUID)
+		require.NoError(t, err)
+		require.Equal(t, "second", second.Title)
+
+		require.NoError(t, newsRepo.Close())
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_FindSimilarTitles(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	t.Run("Near duplicate", func(t *testing.T) {
+		title := "Golang 1.16 released with embed package"
+		duplicateUID := uuid.New()
+		authorID := uuid.New()
+		tenantID := uuid.New()
+		mock.ExpectQuery(findSimilarTitles).WithArgs(title, 0.6, similarTitlesLimit, authorID, tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "title"}).
+				AddRow(duplicateUID, "Golang 1.16 released with the embed package"))
+
+		similar, err := newsRepo.FindSimilarTitles(context.Background(), title, 0.6, authorID, &tenantID)
+		require.NoError(t, err)
+		require.Len(t, similar, 1)
+		require.Equal(t, duplicateUID, similar[0].NewsID)
+		require.Equal(t, "Golang 1.16 released with the embed package", similar[0].Title)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_StreamSearchByTitle(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	pq := &utils.PaginationQuery{Size: 10, Page: 1}
+
+	t.Run("Rows streamed", func(t *testing.T) {
+		mock.ExpectQuery(findByTitle).WithArgs("golang", pq.GetOffset(), pq.GetLimit(), nil).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "title"}).
+				AddRow(uuid.New(), "golang first").
+				AddRow(uuid.New(), "golang second"))
+
+		var titles []string
+		err := newsRepo.StreamSearchByTitle(context.Background(), "golang", models.SearchScopeTitle, pq, func(n *models.News) error {
+			titles = append(titles, n.Title)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"golang first", "golang second"}, titles)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_StreamAuthorNews(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	authorUID := uuid.New()
+
+	t.Run("Every status streamed", func(t *testing.T) {
+		mock.ExpectQuery(getAuthorNews).WithArgs(authorUID).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "author_id", "title", "status"}).
+				AddRow(uuid.New(), authorUID, "published article", "published").
+				AddRow(uuid.New(), authorUID, "draft article", "draft"))
+
+		var statuses []string
+		err := newsRepo.StreamAuthorNews(context.Background(), authorUID, func(n *models.News) error {
+			statuses = append(statuses, n.Status)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"published", "draft"}, statuses)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_Pin(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	t.Run("Pin until", func(t *testing.T) {
+		newsUID := uuid.New()
+		until := time.Now().Add(time.Hour)
+		mock.ExpectExec(pinNews).WithArgs(newsUID, &until).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		require.NoError(t, newsRepo.Pin(context.Background(), newsUID, &until))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Unpin not found", func(t *testing.T) {
+		newsUID := uuid.New()
+		mock.ExpectExec(unpinNews).WithArgs(newsUID).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := newsRepo.Unpin(context.Background(), newsUID)
+		require.True(t, errors.Is(err, sql.ErrNoRows))
+	})
+
+	t.Run("GetFeatured", func(t *testing.T) {
+		pinnedAt := time.Now().Add(-time.Hour)
+		mock.ExpectQuery(getFeaturedNews).WithArgs(10, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "title", "pinned_at", "pinned_until"}).
+				AddRow(uuid.New(), "pinned news", pinnedAt, nil))
+
+		featured, err := newsRepo.GetFeatured(context.Background(), 10)
+		require.NoError(t, err)
+		require.Len(t, featured, 1)
+		require.Nil(t, featured[0].PinnedUntil)
+		require.True(t, featured[0].IsPinned(time.Now()))
+	})
+}
+
+func TestNewsRepo_Revisions(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	newsUID := uuid.New()
+	authorUID := uuid.New()
+	editorUID := uuid.New()
+	newsColumns := []string{"news_id", "author_id", "title", "content", "category", "status"}
+	category := "golang"
+
+	t.Run("Edits recorded", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(createNews).WithArgs(authorUID, "first title", "first content", nil, "", nil, nil, 2, nil).
+			WillReturnRows(sqlmock.NewRows(newsColumns).AddRow(newsUID, authorUID, "first title", "first content", nil, "published"))
+		mock.ExpectExec(createNewsRevision).
+			WithArgs(newsUID, authorUID, "first title", "first content", nil, nil, "published", "title,content,status").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(createNewsEvent).WithArgs(newsUID, authorUID, models.NewsActionCreate).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(getNewsForUpdate).WithArgs(newsUID).
+			WillReturnRows(sqlmock.NewRows(newsColumns).AddRow(newsUID, authorUID, "first title", "first content", nil, "published"))
+		mock.ExpectQuery(updateNews).WithArgs("", "second content", nil, &category, newsUID, nil, 2).
+			WillReturnRows(sqlmock.NewRows(newsColumns).AddRow(newsUID, authorUID, "first title", "second content", category, "published"))
+		mock.ExpectExec(createNewsRevision).
+			WithArgs(newsUID, editorUID, "first title", "second content", nil, category, "published", "content,category").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(createNewsEvent).WithArgs(newsUID, editorUID, models.NewsActionUpdate).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(getNewsForUpdate).WithArgs(newsUID).
+			WillReturnRows(sqlmock.NewRows(newsColumns).AddRow(newsUID, authorUID, "first title", "second content", category, "published"))
+		mock.ExpectQuery(updateNews).WithArgs("", "second content", nil, nil, newsUID, nil, 2).
+			WillReturnRows(sqlmock.NewRows(newsColumns).AddRow(newsUID, authorUID, "first title", "second content", category, "published"))
+		mock.ExpectCommit()
+
+		_, err := newsRepo.Create(context.Background(), &models.News{AuthorID: authorUID, Title: "first title", Content: "first content"})
+		require.NoError(t, err)
+		_, err = newsRepo.Update(context.Background(), &models.News{NewsID: newsUID, Content: "second content", Category: &category}, editorUID)
+		require.NoError(t, err)
+		_, err = newsRepo.Update(context.Background(), &models.News{NewsID: newsUID, Content: "second content"}, editorUID)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Chronological order", func(t *testing.T) {
+		created := time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC)
+		edited := created.Add(time.Hour)
+		firstUID, secondUID := uuid.New(), uuid.New()
+
+		mock.ExpectQuery(getNewsRevisions).WithArgs(newsUID).
+			WillReturnRows(sqlmock.NewRows([]string{"revision_id", "news_id", "actor_id", "actor", "changed_fields", "created_at"}).
+				AddRow(firstUID, newsUID, authorUID, "Alex Bryksin", "title,content,status", created).
+				AddRow(secondUID, newsUID, editorUID, "Jane Doe", "content,category", edited))
+
+		revisions, err := newsRepo.GetRevisions(context.Background(), newsUID)
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+		require.Equal(t, firstUID, revisions[0].RevisionID)
+		require.True(t, revisions[0].CreatedAt.Before(revisions[1].CreatedAt))
+		require.Equal(t, models.ChangedFields{"content", "category"}, revisions[1].ChangedFields)
+		require.Equal(t, editorUID, *revisions[1].ActorID)
+	})
+
+	t.Run("Snapshot", func(t *testing.T) {
+		revisionUID := uuid.New()
+		mock.ExpectQuery(getNewsRevisionByID).WithArgs(revisionUID).
+			WillReturnRows(sqlmock.NewRows([]string{"revision_id", "news_id", "actor_id", "actor", "changed_fields", "created_at", "title", "content", "image_url", "category", "status"}).
+				AddRow(revisionUID, newsUID, nil, " ", "content", time.Now(), "first title", "first content", nil, nil, "published"))
+
+		revision, err := newsRepo.GetRevision(context.Background(), revisionUID)
+		require.NoError(t, err)
+		require.Equal(t, revisionUID, revision.RevisionID)
+		require.Nil(t, revision.ActorID)
+		require.Equal(t, "first content", revision.Content)
+		require.Equal(t, models.ChangedFields{"content"}, revision.ChangedFields)
+	})
+}
+
+func TestNewsRepo_RevertTo(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	newsUID := uuid.New()
+	authorUID := uuid.New()
+	revisionUID := uuid.New()
+	revisionColumns := []string{"revision_id", "news_id", "actor_id", "actor", "changed_fields", "created_at", "title", "content", "image_url", "category", "status"}
+	newsColumns := []string{"news_id", "author_id", "title", "content", "category", "status"}
+
+	t.Run("Restores old content", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(getNewsRevisionByID).WithArgs(revisionUID).
+			WillReturnRows(sqlmock.NewRows(revisionColumns).
+				AddRow(revisionUID, newsUID, authorUID, "", "title,content", time.Now(), "old title", "old content", nil, nil, "published"))
+		mock.ExpectQuery(getNewsForUpdate).WithArgs(newsUID).
+			WillReturnRows(sqlmock.NewRows(newsColumns).AddRow(newsUID, authorUID, "old title", "new content", "golang", "published"))
+		mock.ExpectQuery(revertNews).WithArgs("old title", "old content", nil, nil, newsUID, utils.ContentHash("old content"), 2).
+			WillReturnRows(sqlmock.NewRows(newsColumns).AddRow(newsUID, authorUID, "old title", "old content", nil, "published"))
+		mock.ExpectExec(createNewsRevision).
+			WithArgs(newsUID, authorUID, "old title", "old content", nil, nil, "published", "content,category").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(createNewsEvent).WithArgs(newsUID, authorUID, models.NewsActionUpdate).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		reverted, err := newsRepo.RevertTo(context.Background(), newsUID, revisionUID, authorUID)
+		require.NoError(t, err)
+		require.Equal(t, "old content", reverted.Content)
+		require.Nil(t, reverted.Category)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Revision of other news", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(getNewsRevisionByID).WithArgs(revisionUID).
+			WillReturnRows(sqlmock.NewRows(revisionColumns).
+				AddRow(revisionUID, uuid.New(), authorUID, "", "title", time.Now(), "old title", "old content", nil, nil, "published"))
+		mock.ExpectRollback()
+
+		_, err := newsRepo.RevertTo(context.Background(), newsUID, revisionUID, authorUID)
+		require.True(t, errors.Is(err, sql.ErrNoRows))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_GetNews_EstimateCount(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	pq := &utils.PaginationQuery{Size: 10, Page: 2}
+
+	t.Run("Estimated count", func(t *testing.T) {
+		lq := &utils.ListQuery{OrderBy: "created_at", Direction: "DESC", TieBreaker: "news_id", EstimateCount: true}
+		listQuery := fmt.Sprintf(getNews, newsListBaseCondition, "created_at DESC, news_id DESC", 1, 2)
+
+		mock.ExpectQuery(getEstimatedNewsCount).WillReturnRows(sqlmock.NewRows([]string{"reltuples"}).AddRow(1000000))
+		mock.ExpectPrepare(listQuery).ExpectQuery().WithArgs(pq.GetOffset(), pq.GetLimit()).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "title"}).AddRow(uuid.New(), "golang news"))
+
+		newsList, err := newsRepo.GetNews(context.Background(), lq, pq)
+		require.NoError(t, err)
+		require.True(t, newsList.ApproximateCount)
+		require.Equal(t, 1000000, newsList.TotalCount)
+		require.Len(t, newsList.News, 1)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Zero estimate still reads page", func(t *testing.T) {
+		lq := &utils.ListQuery{OrderBy: "created_at", Direction: "DESC", TieBreaker: "news_id", EstimateCount: true}
+		listQuery := fmt.Sprintf(getNews, newsListBaseCondition, "created_at DESC, news_id DESC", 1, 2)
+
+		mock.ExpectQuery(getEstimatedNewsCount).WillReturnRows(sqlmock.NewRows([]string{"reltuples"}).AddRow(0))
+		mock.ExpectQuery(listQuery).WithArgs(pq.GetOffset(), pq.GetLimit()).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "title"}).AddRow(uuid.New(), "golang news"))
+
+		newsList, err := newsRepo.GetNews(context.Background(), lq, pq)
+		require.NoError(t, err)
+		require.True(t, newsList.ApproximateCount)
+		require.Len(t, newsList.News, 1)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Estimate is ignored with conditions", func(t *testing.T) {
+		lq := &utils.ListQuery{
+			Conditions:    []utils.ListCondition{{Column: "category", Operator: "=", Value: "rust"}},
+			OrderBy:       "title",
+			Direction:     "DESC",
+			TieBreaker:    "news_id",
+			EstimateCount: true,
+		}
+		countQuery := fmt.Sprintf(getNewsCount, newsListBaseCondition+" AND category = $1")
+
+		mock.ExpectPrepare(countQuery).ExpectQuery().WithArgs("rust").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		newsList, err := newsRepo.GetNews(context.Background(), lq, pq)
+		require.NoError(t, err)
+		require.False(t, newsList.ApproximateCount)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_GetNews(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	pq := &utils.PaginationQuery{Size: 10, Page: 2}
+
+	t.Run("Filtered and ordered", func(t *testing.T) {
+		lq := &utils.ListQuery{
+			Conditions: []utils.ListCondition{{Column: "category", Operator: "=", Value: "golang"}},
+			OrderBy:    "title",
+			Direction:  "DESC",
+			TieBreaker: "news_id",
+		}
+		countQuery := fmt.Sprintf(getNewsCount, newsListBaseCondition+" AND category = $1")
+		listQuery := fmt.Sprintf(getNews, newsListBaseCondition+" AND category = $1", "title DESC, news_id DESC", 2, 3)
+
+		mock.ExpectPrepare(countQuery).ExpectQuery().WithArgs("golang").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(11))
+		mock.ExpectPrepare(listQuery).ExpectQuery().WithArgs("golang", pq.GetOffset(), pq.GetLimit()).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "title", "category"}).AddRow(uuid.New(), "golang news", "golang"))
+
+		newsList, err := newsRepo.GetNews(context.Background(), lq, pq)
+		require.NoError(t, err)
+		require.Equal(t, 11, newsList.TotalCount)
+		require.Len(t, newsList.News, 1)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Any of categories", func(t *testing.T) {
+		categories := `{"golang","rust"}`
+		lq := &utils.ListQuery{
+			Conditions: []utils.ListCondition{{Column: "category", Operator: "=", Value: categories, ArrayType: "text"}},
+			OrderBy:    "created_at",
+			Direction:  "ASC",
+		}
+		where := newsListBaseCondition + " AND category = ANY($1::text[])"
+
+		mock.ExpectPrepare(fmt.Sprintf(getNewsCount, where)).ExpectQuery().WithArgs(categories).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectPrepare(fmt.Sprintf(getNews, where, "created_at ASC", 2, 3)).ExpectQuery().WithArgs(categories, pq.GetOffset(), pq.GetLimit()).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "category"}).AddRow(uuid.New(), "golang").AddRow(uuid.New(), "rust"))
+
+		newsList, err := newsRepo.GetNews(context.Background(), lq, pq)
+		require.NoError(t, err)
+		require.Equal(t, 2, newsList.TotalCount)
+		require.Len(t, newsList.News, 2)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Word count range", func(t *testing.T) {
+		lq := &utils.ListQuery{
+			Conditions: []utils.ListCondition{
+				{Column: "word_count", Operator: ">=", Value: 100},
+				{Column: "word_count", Operator: "<=", Value: 500},
+			},
+			OrderBy:   "created_at",
+			Direction: "DESC",
+		}
+		where := newsListBaseCondition + " AND word_count >= $1 AND word_count <= $2"
+
+		mock.ExpectPrepare(fmt.Sprintf(getNewsCount, where)).ExpectQuery().WithArgs(100, 500).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectPrepare(fmt.Sprintf(getNews, where, "created_at DESC", 3, 4)).ExpectQuery().WithArgs(100, 500, pq.GetOffset(), pq.GetLimit()).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "word_count"}).AddRow(uuid.New(), 100).AddRow(uuid.New(), 500))
+
+		newsList, err := newsRepo.GetNews(context.Background(), lq, pq)
+		require.NoError(t, err)
+		require.Equal(t, 2, newsList.TotalCount)
+		require.Equal(t, 100, newsList.News[0].WordCount)
+		require.Equal(t, 500, newsList.News[1].WordCount)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Prefetch next page", func(t *testing.T) {
+		pq := &utils.PaginationQuery{Size: 4, Page: 1}
+		lq := &utils.ListQuery{OrderBy: "title", Direction: "ASC", TieBreaker: "news_id", Prefetch: 2}
+		where := newsListBaseCondition
+		ids := make([]uuid.UUID, 0, 10)
+		for i := 0; i < 10; i++ {
+			ids = append(ids, uuid.New())
+		}
+		rows := func(from, to int) *sqlmock.Rows {
+			r := sqlmock.NewRows([]string{"news_id", "title"})
+			for i := from; i < to; i++ {
+				r.AddRow(ids[i], fmt.Sprintf("news %02d", i))
+			}
+			return r
+		}
+
+		mock.ExpectPrepare(fmt.Sprintf(getNewsCount, where)).ExpectQuery().
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(10))
+		mock.ExpectPrepare(fmt.Sprintf(getNews, where, "title ASC, news_id ASC", 1, 2)).ExpectQuery().WithArgs(0, 6).
+			WillReturnRows(rows(0, 6))
+
+		firstPage, err := newsRepo.GetNews(context.Background(), lq, pq)
+		require.NoError(t, err)
+		require.Len(t, firstPage.News, 4)
+		require.Len(t, firstPage.NextPreview, 2)
+
+		mock.ExpectQuery(fmt.Sprintf(getNewsCount, where)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(10))
+		mock.ExpectQuery(fmt.Sprintf(getNews, where, "title ASC, news_id ASC", 1, 2)).WithArgs(4, 4).WillReturnRows(rows(4, 8))
+
+		secondPage, err := newsRepo.GetNews(context.Background(), &utils.ListQuery{OrderBy: "title", Direction: "ASC", TieBreaker: "news_id"}, &utils.PaginationQuery{Size: 4, Page: 2})
+		require.NoError(t, err)
+		require.Nil(t, secondPage.NextPreview)
+		for i, n := range firstPage.NextPreview {
+			require.Equal(t, secondPage.News[i].NewsID, n.NewsID)
+			require.Equal(t, secondPage.News[i].Title, n.Title)
+		}
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Prefetch on last page", func(t *testing.T) {
+		pq := &utils.PaginationQuery{Size: 4, Page: 3}
+		lq := &utils.ListQuery{OrderBy: "updated_at", Direction: "ASC", Prefetch: 2}
+		where := newsListBaseCondition
+
+		mock.ExpectQuery(fmt.Sprintf(getNewsCount, where)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(10))
+		mock.ExpectPrepare(fmt.Sprintf(getNews, where, "updated_at ASC", 1, 2)).ExpectQuery().WithArgs(8, 6).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id"}).AddRow(uuid.New()).AddRow(uuid.New()))
+
+		newsList, err := newsRepo.GetNews(context.Background(), lq, pq)
+		require.NoError(t, err)
+		require.Len(t, newsList.News, 2)
+		require.Empty(t, newsList.NextPreview)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_GetNewsIDs(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	pq := &utils.PaginationQuery{Size: 10, Page: 1}
+
+	t.Run("Ids match full query", func(t *testing.T) {
+		lq := &utils.ListQuery{
+			Conditions: []utils.ListCondition{
+				{Column: "category", Operator: "=", Value: "golang"},
+				{Column: "word_count", Operator: ">=", Value: 100},
+			},
+			OrderBy:    "created_at",
+			Direction:  "DESC",
+			TieBreaker: "news_id",
+		}
+		where := newsListBaseCondition + " AND category = $1 AND word_count >= $2"
+		order := "created_at DESC, news_id DESC"
+		firstUID, secondUID := uuid.New(), uuid.New()
+
+		countQuery := fmt.Sprintf(getNewsCount, where)
+		mock.ExpectPrepare(countQuery).ExpectQuery().WithArgs("golang", 100).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectPrepare(fmt.Sprintf(getNews, where, order, 3, 4)).ExpectQuery().WithArgs("golang", 100, pq.GetOffset(), pq.GetLimit()).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "title"}).AddRow(firstUID, "first").AddRow(secondUID, "second"))
+
+		newsList, err := newsRepo.GetNews(context.Background(), lq, pq)
+		require.NoError(t, err)
+
+		// Count statement is already prepared by full query
+		mock.ExpectQuery(countQuery).WithArgs("golang", 100).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectPrepare(fmt.Sprintf(getNewsIDs, where, order, 3, 4)).ExpectQuery().WithArgs("golang", 100, pq.GetOffset(), pq.GetLimit()).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id"}).AddRow(firstUID).AddRow(secondUID))
+
+		idList, err := newsRepo.GetNewsIDs(context.Background(), lq, pq)
+		require.NoError(t, err)
+
+		ids := make([]uuid.UUID, 0, len(newsList.News))
+		for _, n := range newsList.News {
+			ids = append(ids, n.NewsID)
+		}
+		require.Equal(t, ids, idList.IDs)
+		require.Equal(t, newsList.TotalCount, idList.TotalCount)
+		require.Equal(t, newsList.HasMore, idList.HasMore)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		lq := &utils.ListQuery{
+			Conditions: []utils.ListCondition{{Column: "category", Operator: "=", Value: "none"}},
+			OrderBy:    "created_at",
+			Direction:  "ASC",
+		}
+		mock.ExpectPrepare(fmt.Sprintf(getNewsCount, newsListBaseCondition+" AND category = $1")).ExpectQuery().WithArgs("none").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		idList, err := newsRepo.GetNewsIDs(context.Background(), lq, pq)
+		require.NoError(t, err)
+		require.Zero(t, idList.TotalCount)
+		require.NotNil(t, idList.IDs)
+		require.Empty(t, idList.IDs)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_GetUnknownCategories(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	t.Run("Unused categories returned", func(t *testing.T) {
+		categories := []string{"golang", "cobol"}
+		mock.ExpectQuery(getUnknownCategories).WithArgs(utils.TextArray(categories), nil).
+			WillReturnRows(sqlmock.NewRows([]string{"category"}).AddRow("cobol"))
+
+		unknown, err := newsRepo.GetUnknownCategories(context.Background(), categories)
+		require.NoError(t, err)
+		require.Equal(t, []string{"cobol"}, unknown)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_ListOrderTieBreaker(t *testing.T) {
+	t.Parallel()
+
+	orderBy := regexp.MustCompile(`(?s)ORDER BY (.*?)(OFFSET|LIMIT|$)`)
+	queries := map[string]string{
+		"findByTitle":            findByTitle,
+		"getPrevNews":            getPrevNews,
+		"getNextNews":            getNextNews,
+		"getDraftsByAuthor":      getDraftsByAuthor,
+		"getOrphaned":            getOrphaned,
+		"getRecentNews":          getRecentNews,
+		"getRecentlyUpdatedNews": getRecentlyUpdatedNews,
+		"findSimilarTitles":      findSimilarTitles,
+		"getFeaturedNews":        getFeaturedNews,
+		"getChangedSince":        getChangedSince,
+		"purgeDeletedNews":       purgeDeletedNews,
+	}
+	for name, query := range queries {
+		match := orderBy.FindStringSubmatch(query)
+		require.NotNil(t, match, "%s has no ORDER BY", name)
+		require.Regexp(t, `news_id( DESC)?\s*$`, strings.TrimSpace(match[1]), "%s order does not end with news_id", name)
+	}
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	t.Run("Same timestamp pages are stable", func(t *testing.T) {
+		createdAt := time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)
+		first, second := uuid.MustParse("00000000-0000-0000-0000-000000000002"), uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		lq := &utils.ListQuery{Conditions: []utils.ListCondition{}, OrderBy: "created_at", Direction: "DESC", TieBreaker: "news_id"}
+		countQuery := fmt.Sprintf(getNewsCount, newsListBaseCondition)
+		listQuery := fmt.Sprintf(getNews, newsListBaseCondition, "created_at DESC, news_id DESC", 1, 2)
+
+		prepared := false
+		page := func(page int, newsID uuid.UUID) uuid.UUID {
+			pq := &utils.PaginationQuery{Size: 1, Page: page}
+			if !prepared {
+				mock.ExpectPrepare(countQuery)
+			}
+			mock.ExpectQuery(countQuery).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+			if !prepared {
+				mock.ExpectPrepare(listQuery)
+				prepared = true
+			}
+			mock.ExpectQuery(listQuery).WithArgs(pq.GetOffset(), pq.GetLimit()).
+				WillReturnRows(sqlmock.NewRows([]string{"news_id", "created_at"}).AddRow(newsID, createdAt))
+
+			newsList, err := newsRepo.GetNews(context.Background(), lq, pq)
+			require.NoError(t, err)
+			require.Len(t, newsList.News, 1)
+			return newsList.News[0].NewsID
+		}
+
+		for i := 0; i < 2; i++ {
+			require.Equal(t, first, page(1, first))
+			require.Equal(t, second, page(2, second))
+		}
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_GetAuthorLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	t.Run("Counts ordered with ties by name", func(t *testing.T) {
+		since := time.Now().Add(-7 * 24 * time.Hour)
+		rows := sqlmock.NewRows([]string{"author_id", "author", "avatar_url", "published_count"}).
+			AddRow(uuid.New(), "Zed Top", nil, 12).
+			AddRow(uuid.New(), "Anna Tie", nil, 5).
+			AddRow(uuid.New(), "Bob Tie", nil, 5)
+		mock.ExpectQuery(getAuthorLeaderboard).WithArgs(since, 3, nil).WillReturnRows(rows)
+
+		stats, err := newsRepo.GetAuthorLeaderboard(context.Background(), since, 3)
+		require.NoError(t, err)
+		require.Len(t, stats, 3)
+		require.Equal(t, 12, stats[0].PublishedCount)
+		require.Equal(t, []string{"Zed Top", "Anna Tie", "Bob Tie"}, []string{stats[0].Author, stats[1].Author, stats[2].Author})
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_CountByStatus(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	t.Run("Grouped counts", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"status", "count"}).
+			AddRow(models.NewsStatusDraft, 12).
+			AddRow(models.NewsStatusPublished, 340).
+			AddRow(models.NewsStatusArchived, 8)
+		mock.ExpectQuery(getCountByStatus).WithArgs(utils.TextArray(models.NewsStatuses), nil).WillReturnRows(rows)
+
+		counts, err := newsRepo.CountByStatus(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, map[string]int{"draft": 12, "published": 340, "archived": 8}, counts)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Missing statuses are zero", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"status", "count"}).AddRow(models.NewsStatusPublished, 3)
+		mock.ExpectQuery(getCountByStatus).WithArgs(utils.TextArray(models.NewsStatuses), nil).WillReturnRows(rows)
+
+		counts, err := newsRepo.CountByStatus(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, map[string]int{"draft": 0, "published": 3, "archived": 0}, counts)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_GetAuthorStatusCounts(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	t.Run("Mixed statuses", func(t *testing.T) {
+		authorID := uuid.New()
+		rows := sqlmock.NewRows([]string{"status", "count"}).
+			AddRow(models.NewsStatusDraft, 2).
+			AddRow(models.NewsStatusPublished, 5)
+		mock.ExpectQuery(getAuthorStatusCounts).WithArgs(utils.TextArray(models.NewsStatuses), authorID, nil).WillReturnRows(rows)
+
+		counts, err := newsRepo.GetAuthorStatusCounts(context.Background(), authorID)
+		require.NoError(t, err)
+		require.Equal(t, map[string]int{"draft": 2, "published": 5, "archived": 0}, counts)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_QueryCostGuard(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	cfg := &config.Config{Postgres: config.PostgresConfig{ExplainListQueries: true, ExplainCostThreshold: 1000}}
+	pq := &utils.PaginationQuery{Size: 10, Page: 1}
+	title := "title"
+
+	expectSearch := func(plan string) {
+		mock.ExpectQuery(findByTitleCount).WithArgs(title, nil).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery("EXPLAIN (FORMAT JSON) "+findByTitle).WithArgs(title, pq.GetOffset(), pq.GetLimit(), nil).
+			WillReturnRows(sqlmock.NewRows([]string{"QUERY PLAN"}).AddRow([]byte(plan)))
+		mock.ExpectQuery(findByTitle).WithArgs(title, pq.GetOffset(), pq.GetLimit(), nil).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "title"}).AddRow(uuid.New(), title))
+	}
+
+	t.Run("Full scan above threshold is logged", func(t *testing.T) {
+		recorder := &warnRecorder{}
+		newsRepo := NewNewsRepository(sqlxDB, cfg, recorder)
+		expectSearch(`[{"Plan": {"Node Type": "Seq Scan", "Total Cost": 25480.5}}]`)
+
+		_, err := newsRepo.SearchByTitle(context.Background(), title, models.SearchScopeTitle, pq)
+		require.NoError(t, err)
+		require.Len(t, recorder.warnings, 1)
+		require.Contains(t, recorder.warnings[0], "findByTitle")
+		require.Contains(t, recorder.warnings[0], "Seq Scan")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Indexed plan below threshold is not logged", func(t *testing.T) {
+		recorder := &warnRecorder{}
+		newsRepo := NewNewsRepository(sqlxDB, cfg, recorder)
+		expectSearch(`[{"Plan": {"Node Type": "Index Scan", "Total Cost": 8.3}}]`)
+
+		_, err := newsRepo.SearchByTitle(context.Background(), title, models.SearchScopeTitle, pq)
+		require.NoError(t, err)
+		require.Len(t, recorder.warnings, 0)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Disabled guard does not explain", func(t *testing.T) {
+		newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+		mock.ExpectQuery(findByTitleCount).WithArgs(title, nil).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(findByTitle).WithArgs(title, pq.GetOffset(), pq.GetLimit(), nil).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "title"}).AddRow(uuid.New(), title))
+
+		_, err := newsRepo.SearchByTitle(context.Background(), title, models.SearchScopeTitle, pq)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_SearchByTitle_Scopes(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	pq := &utils.PaginationQuery{Size: 10, Page: 1}
+	inTitle := uuid.New()
+	inContent := uuid.New()
+
+	for _, tc := range []struct {
+		scope      models.SearchScope
+		countQuery string
+		listQuery  string
+		matches    []uuid.UUID
+	}{
+		{scope: models.SearchScopeTitle, countQuery: findByTitleCount, listQuery: findByTitle, matches: []uuid.UUID{inTitle}},
+		{scope: models.SearchScopeContent, countQuery: findByContentCount, listQuery: findByContent, matches: []uuid.UUID{inContent}},
+		{scope: models.SearchScopeAll, countQuery: findByTextCount, listQuery: findByText, matches: []uuid.UUID{inTitle, inContent}},
+	} {
+		t.Run(string(tc.scope), func(t *testing.T) {
+			rows := sqlmock.NewRows([]string{"news_id"})
+			for _, id := range tc.matches {
+				rows.AddRow(id)
+			}
+			mock.ExpectQuery(tc.countQuery).WithArgs("golang", nil).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(len(tc.matches)))
+			mock.ExpectQuery(tc.listQuery).WithArgs("golang", pq.GetOffset(), pq.GetLimit(), nil).WillReturnRows(rows)
+
+			newsList, err := newsRepo.SearchByTitle(context.Background(), "golang", tc.scope, pq)
+			require.NoError(t, err)
+			require.Equal(t, len(tc.matches), newsList.TotalCount)
+			ids := make([]uuid.UUID, 0, len(newsList.News))
+			for _, n := range newsList.News {
+				ids = append(ids, n.NewsID)
+			}
+			require.Equal(t, tc.matches, ids)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+
+	t.Run("Title is weighted over content", func(t *testing.T) {
+		migration, err := ioutil.ReadFile("../../../migrations/18_news_search_vector.up.sql")
+		require.NoError(t, err)
+		weighted := "setweight(to_tsvector('simple', title), 'A') || setweight(to_tsvector('simple', content), 'B')"
+		require.Contains(t, string(migration), "news_text_vector_idx ON news\n    USING GIN (("+weighted+"))")
+
+		for _, query := range []string{findByTextCount, findByText} {
+			require.Contains(t, query, "("+weighted+") @@ plainto_tsquery('simple', $1)")
+		}
+		// Default ts_rank weights give A lexemes 1.0 and B lexemes 0.4, so title match ranks first
+		require.Contains(t, findByText, "ORDER BY ts_rank("+weighted+", plainto_tsquery('simple', $1)) DESC")
+	})
+
+	t.Run("Full text queries share published filter", func(t *testing.T) {
+		for _, query := range []string{findByContentCount, findByContent, findByTextCount, findByText} {
+			require.Contains(t, query, "status = 'published' AND deleted_at IS NULL")
+			require.Contains(t, query, "@@ plainto_tsquery('simple', $1)")
+		}
+	})
+}
+
+func TestNewsRepo_CountSearchByTitle(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	pq := &utils.PaginationQuery{Size: 10, Page: 1}
+
+	for _, tc := range []struct {
+		scope      models.SearchScope
+		countQuery string
+		listQuery  string
+	}{
+		{scope: models.SearchScopeTitle, countQuery: findByTitleCount, listQuery: findByTitle},
+		{scope: models.SearchScopeContent, countQuery: findByContentCount, listQuery: findByContent},
+		{scope: models.SearchScopeAll, countQuery: findByTextCount, listQuery: findByText},
+	} {
+		t.Run(string(tc.scope), func(t *testing.T) {
+			mock.ExpectQuery(tc.countQuery).WithArgs("golang", nil).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(27))
+			mock.ExpectQuery(tc.listQuery).WithArgs("golang", pq.GetOffset(), pq.GetLimit(), nil).
+				WillReturnRows(sqlmock.NewRows([]string{"news_id"}).AddRow(uuid.New()))
+			newsList, err := newsRepo.SearchByTitle(context.Background(), "golang", tc.scope, pq)
+			require.NoError(t, err)
+
+			mock.ExpectQuery(tc.countQuery).WithArgs("golang", nil).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(27))
+			count, err := newsRepo.CountSearchByTitle(context.Background(), "golang", tc.scope)
+			require.NoError(t, err)
+			require.Equal(t, newsList.TotalCount, count)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestNewsRepo_SearchByTitle_Tenant(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	pq := &utils.PaginationQuery{Size: 10, Page: 1}
+	tenantID := uuid.New()
+	ctx := context.WithValue(context.Background(), utils.TenantCtxKey{}, tenantID)
+
+	t.Run("Search is scoped to tenant", func(t *testing.T) {
+		mock.ExpectQuery(findByTitleCount).WithArgs("golang", tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(findByTitle).WithArgs("golang", pq.GetOffset(), pq.GetLimit(), tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "tenant_id"}).AddRow(uuid.New(), tenantID))
+
+		newsList, err := newsRepo.SearchByTitle(ctx, "golang", models.SearchScopeTitle, pq)
+		require.NoError(t, err)
+		require.Len(t, newsList.News, 1)
+		require.Equal(t, tenantID, *newsList.News[0].TenantID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Count is scoped to tenant", func(t *testing.T) {
+		mock.ExpectQuery(findByTextCount).WithArgs("golang", tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		count, err := newsRepo.CountSearchByTitle(ctx, "golang", models.SearchScopeAll)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Unscoped request", func(t *testing.T) {
+		mock.ExpectQuery(findByTitleCount).WithArgs("golang", nil).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		_, err := newsRepo.SearchByTitle(context.Background(), "golang", models.SearchScopeTitle, pq)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_SharedLists_Tenant(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	pq := &utils.PaginationQuery{Size: 10, Page: 1}
+	tenantID := uuid.New()
+	ctx := context.WithValue(context.Background(), utils.TenantCtxKey{}, tenantID)
+
+	t.Run("Timeline is scoped to tenant", func(t *testing.T) {
+		mock.ExpectQuery(getTimeline).WithArgs(tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"month", "count"}).AddRow(time.Now(), 2))
+
+		timeline, err := newsRepo.GetTimeline(ctx)
+		require.NoError(t, err)
+		require.Len(t, timeline, 1)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Activity is scoped to tenant", func(t *testing.T) {
+		mock.ExpectQuery(getActivityCount).WithArgs("", tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(getActivityFeed).
+			WithArgs("", pq.GetOffset(), pq.GetLimit(), tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"event_id", "action"}).AddRow(uuid.New(), models.NewsActionCreate))
+
+		events, err := newsRepo.GetActivityFeed(ctx, "", pq)
+		require.NoError(t, err)
+		require.Len(t, events.Events, 1)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Status counts are scoped to tenant", func(t *testing.T) {
+		mock.ExpectQuery(getCountByStatus).
+			WithArgs(utils.TextArray(models.NewsStatuses), tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"status", "count"}).AddRow(models.NewsStatusPublished, 3))
+
+		counts, err := newsRepo.CountByStatus(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 3, counts[models.NewsStatusPublished])
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Changes are scoped to tenant", func(t *testing.T) {
+		since := time.Now().Add(-time.Hour)
+		mock.ExpectQuery(getChangedSinceCount).WithArgs(since, tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		changes, err := newsRepo.GetChangedSince(ctx, since, pq)
+		require.NoError(t, err)
+		require.Equal(t, 0, changes.TotalCount)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Slugs are looked up within tenant", func(t *testing.T) {
+		slugs := []string{"shared-slug"}
+		mock.ExpectQuery(getNewsBySlugs).WithArgs(utils.TextArray(slugs), tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "slug", "tenant_id"}).AddRow(uuid.New(), slugs[0], tenantID))
+
+		news, err := newsRepo.GetNewsBySlugs(ctx, slugs)
+		require.NoError(t, err)
+		require.Len(t, news, 1)
+		require.Equal(t, tenantID, *news[0].TenantID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Batch status update skips news of other tenant", func(t *testing.T) {
+		ids := []uuid.UUID{uuid.New(), uuid.New()}
+		mock.ExpectBegin()
+		mock.ExpectQuery(getNewsStatusesForUpdate).WithArgs(utils.UUIDArray(ids), tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "status"}))
+		mock.ExpectCommit()
+
+		affected, err := newsRepo.UpdateStatusBatch(ctx, ids, models.NewsStatusArchived, false)
+		require.NoError(t, err)
+		require.Empty(t, affected)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Maintenance is scoped to tenant", func(t *testing.T) {
+		ids := []uuid.UUID{uuid.New()}
+		mock.ExpectQuery(getOrphanedByIDs).WithArgs(utils.UUIDArray(ids), tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id"}))
+		mock.ExpectQuery(getBrokenImagesCount).WithArgs(tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		reassigned, err := newsRepo.ReassignAuthor(ctx, ids, uuid.New(), true)
+		require.NoError(t, err)
+		require.Empty(t, reassigned)
+		broken, err := newsRepo.GetBrokenImages(ctx, pq)
+		require.NoError(t, err)
+		require.Equal(t, 0, broken.TotalCount)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Relation is added within tenant", func(t *testing.T) {
+		fromUID := uuid.New()
+		toUID := uuid.New()
+		mock.ExpectExec(addNewsRelation).WithArgs(fromUID, toUID, tenantID).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		added, err := newsRepo.AddRelation(ctx, fromUID, toUID)
+		require.NoError(t, err)
+		require.False(t, added)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("News outside tenant", func(t *testing.T) {
+		ids := []uuid.UUID{uuid.New(), uuid.New()}
+		mock.ExpectQuery(getNewsOutsideTenant).WithArgs(utils.UUIDArray(ids), tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id"}).AddRow(ids[1]))
+
+		outside, err := newsRepo.GetOutsideTenant(ctx, ids, tenantID)
+		require.NoError(t, err)
+		require.Equal(t, ids[1:], outside)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Every tenant scoped query binds tenant", func(t *testing.T) {
+		for placeholder, queries := range map[string][]string{
+			"$1": {getTotalCount, getTimeline, getOrphanedCount, getTotalWithoutComments, getBrokenImagesCount},
+			"$2": {
+				getUnknownCategories, findByTitleCount, findByContentCount, findByTextCount, getNewsCreatedAt,
+				getNewsStatusesForUpdate, getEditedByCount, getOrphanedByIDs, getFeaturedNews, getActivityCount,
+				getCountByStatus, getChangedSinceCount, getLatestPerCategory, getLatestUpdatePerAuthor, getCuratedRelated,
+				fixCommentCounts,
+			},
+			"$3": {
+				getPrevNews, getNextNews, updateNewsStatusBatch, getOrphaned, reassignOrphanedAuthor, getRecentNews,
+				getRecentlyUpdatedNews, getNewsWithoutComments, getAuthorLeaderboard, getAuthorStatusCounts, addTagToMany,
+				getTrendingNews, getBrokenImages, getNewsIDsAfter, purgeDeletedNews, addNewsRelation, removeNewsRelation,
+			},
+			"$4": {findByTitle, findByContent, findByText, getEditedBy, getActivityFeed, getChangedSince, getCategoryNewsAfter},
+		} {
+			for _, query := range queries {
+				require.Regexp(t, `\(\`+placeholder+`::uuid IS NULL OR (\w+\.)?tenant_id = \`+placeholder+`\)`, query)
+			}
+		}
+	})
+}
+
+func TestNewsRepo_GetNewsBySlugs(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	t.Run("One query for all slugs", func(t *testing.T) {
+		slugs := []string{"first", "unknown", "second"}
+		rows := sqlmock.NewRows([]string{"news_id", "title", "content", "slug"}).
+			AddRow(uuid.New(), "First", "Content", "first").
+			AddRow(uuid.New(), "Second", "Content", "second")
+		mock.ExpectQuery(getNewsBySlugs).WithArgs(utils.TextArray(slugs), nil).WillReturnRows(rows)
+
+		news, err := newsRepo.GetNewsBySlugs(context.Background(), slugs)
+		require.NoError(t, err)
+		require.Len(t, news, 2)
+		require.Equal(t, "first", *news[0].Slug)
+		require.Equal(t, "second", *news[1].Slug)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_GetBySlugsOrdered(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	t.Run("Missing slugs interleaved", func(t *testing.T) {
+		slugs := []string{"missing-first", "second", "missing-middle", "first", "missing-last"}
+		rows := sqlmock.NewRows([]string{"news_id", "title", "content", "slug"}).
+			AddRow(uuid.New(), "First", "Content", "first").
+			AddRow(uuid.New(), "Second", "Content", "second")
+		mock.ExpectQuery(getNewsBySlugs).WithArgs(utils.TextArray(slugs), nil).WillReturnRows(rows)
+
+		news, err := newsRepo.GetBySlugsOrdered(context.Background(), slugs)
+		require.NoError(t, err)
+		require.Len(t, news, len(slugs))
+		require.Nil(t, news[0])
+		require.Equal(t, "second", *news[1].Slug)
+		require.Nil(t, news[2])
+		require.Equal(t, "first", *news[3].Slug)
+		require.Nil(t, news[4])
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Repeated slug", func(t *testing.T) {
+		slugs := []string{"first", "first"}
+		rows := sqlmock.NewRows([]string{"news_id", "title", "content", "slug"}).
+			AddRow(uuid.New(), "First", "Content", "first")
+		mock.ExpectQuery(getNewsBySlugs).WithArgs(utils.TextArray(slugs), nil).WillReturnRows(rows)
+
+		news, err := newsRepo.GetBySlugsOrdered(context.Background(), slugs)
+		require.NoError(t, err)
+		require.Len(t, news, 2)
+		require.Equal(t, news[0], news[1])
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("None found", func(t *testing.T) {
+		slugs := []string{"missing"}
+		mock.ExpectQuery(getNewsBySlugs).WithArgs(utils.TextArray(slugs), nil).WillReturnRows(sqlmock.NewRows([]string{"news_id", "slug"}))
+
+		news, err := newsRepo.GetBySlugsOrdered(context.Background(), slugs)
+		require.NoError(t, err)
+		require.Equal(t, []*models.NewsBase{nil}, news)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_MaxListParams(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	cfg := &config.Config{Postgres: config.PostgresConfig{MaxListParams: 3}}
+	newsRepo := NewNewsRepository(sqlxDB, cfg, logger.NewApiLogger(nil))
+
+	t.Run("At limit", func(t *testing.T) {
+		slugs := []string{"first", "second", "third"}
+		mock.ExpectQuery(getNewsBySlugs).WithArgs(utils.TextArray(slugs), nil).WillReturnRows(sqlmock.NewRows([]string{"news_id", "slug"}))
+
+		_, err := newsRepo.GetNewsBySlugs(context.Background(), slugs)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Beyond limit", func(t *testing.T) {
+		_, err := newsRepo.GetNewsBySlugs(context.Background(), []string{"first", "second", "third", "fourth"})
+		require.True(t, errors.Is(err, postgres.ErrBatchTooLarge))
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+
+		ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+		_, err = newsRepo.UpdateStatusBatch(context.Background(), ids, models.NewsStatusPublished, false)
+		require.True(t, errors.Is(err, postgres.ErrBatchTooLarge))
+		_, err = newsRepo.AddTagToMany(context.Background(), ids, "golang")
+		require.True(t, errors.Is(err, postgres.ErrBatchTooLarge))
+		_, err = newsRepo.ReassignAuthor(context.Background(), ids, uuid.New(), true)
+		require.True(t, errors.Is(err, postgres.ErrBatchTooLarge))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_GetLatestPerCategory(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	t.Run("One row per category", func(t *testing.T) {
+		categories := []string{"empty", "sport", "tech"}
+		sportUID := uuid.New()
+		techUID := uuid.New()
+		rows := sqlmock.NewRows([]string{"news_id", "title", "category", "created_at"}).
+			AddRow(sportUID, "Latest sport", "sport", time.Now()).
+			AddRow(techUID, "Latest tech", "tech", time.Now().Add(-time.Hour))
+		mock.ExpectQuery(getLatestPerCategory).WithArgs(utils.TextArray(categories), nil).WillReturnRows(rows)
+
+		latest, err := newsRepo.GetLatestPerCategory(context.Background(), categories)
+		require.NoError(t, err)
+		require.Len(t, latest, 2)
+		require.Equal(t, sportUID, latest["sport"].NewsID)
+		require.Equal(t, techUID, latest["tech"].NewsID)
+		require.NotContains(t, latest, "empty")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Latest item wins", func(t *testing.T) {
+		require.Contains(t, getLatestPerCategory, "DISTINCT ON (category)")
+		require.Contains(t, getLatestPerCategory, "ORDER BY category, created_at DESC, news_id DESC")
+	})
+}
+
+func TestNewsRepo_GetLatestUpdatePerAuthor(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	t.Run("One row per author", func(t *testing.T) {
+		firstAuthorUID := uuid.New()
+		secondAuthorUID := uuid.New()
+		silentAuthorUID := uuid.New()
+		authorIDs := []uuid.UUID{firstAuthorUID, secondAuthorUID, silentAuthorUID}
+		firstUID := uuid.New()
+		secondUID := uuid.New()
+		rows := sqlmock.NewRows([]string{"news_id", "author_id", "title", "updated_at"}).
+			AddRow(firstUID, firstAuthorUID, "Latest of first", time.Now()).
+			AddRow(secondUID, secondAuthorUID, "Latest of second", time.Now().Add(-time.Hour))
+		mock.ExpectQuery(getLatestUpdatePerAuthor).WithArgs(utils.UUIDArray(authorIDs), nil).WillReturnRows(rows)
+
+		latest, err := newsRepo.GetLatestUpdatePerAuthor(context.Background(), authorIDs)
+		require.NoError(t, err)
+		require.Len(t, latest, 2)
+		require.Equal(t, firstUID, latest[firstAuthorUID].NewsID)
+		require.Equal(t, secondUID, latest[secondAuthorUID].NewsID)
+		require.NotContains(t, latest, silentAuthorUID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Latest by update wins", func(t *testing.T) {
+		require.Contains(t, getLatestUpdatePerAuthor, "DISTINCT ON (author_id)")
+		require.Contains(t, getLatestUpdatePerAuthor, "ORDER BY author_id, updated_at DESC, news_id DESC")
+	})
+}
+
+func TestNewsRepo_GetActivityFeed(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	pq := &utils.PaginationQuery{Size: 10, Page: 1}
+
+	t.Run("Newest first", func(t *testing.T) {
+		newsUID := uuid.New()
+		actorUID := uuid.New()
+		newerUID := uuid.New()
+		olderUID := uuid.New()
+		rows := sqlmock.NewRows([]string{"event_id", "action", "news_id", "title", "actor_id", "actor", "created_at"}).
+			AddRow(newerUID, models.NewsActionUpdate, newsUID, "Edited title", actorUID, "Alex K", time.Now()).
+			AddRow(olderUID, models.NewsActionCreate, newsUID, "First title", nil, nil, time.Now().Add(-time.Hour))
+		mock.ExpectQuery(getActivityCount).WithArgs("", nil).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectQuery(getActivityFeed).WithArgs("", 0, 10, nil).WillReturnRows(rows)
+
+		feed, err := newsRepo.GetActivityFeed(context.Background(), "", pq)
+		require.NoError(t, err)
+		require.Equal(t, 2, feed.TotalCount)
+		require.Len(t, feed.Events, 2)
+		require.Equal(t, newerUID, feed.Events[0].EventID)
+		require.Equal(t, &actorUID, feed.Events[0].ActorID)
+		require.Nil(t, feed.Events[1].ActorID)
+		require.Contains(t, getActivityFeed, "ORDER BY created_at DESC, event_id DESC")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Filtered by action", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"event_id", "action", "news_id", "title", "actor_id", "actor", "created_at"}).
+			AddRow(uuid.New(), models.NewsActionDelete, uuid.New(), "Removed", uuid.New(), "Alex K", time.Now())
+		mock.ExpectQuery(getActivityCount).WithArgs(models.NewsActionDelete, nil).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(getActivityFeed).WithArgs(models.NewsActionDelete, 0, 10, nil).WillReturnRows(rows)
+
+		feed, err := newsRepo.GetActivityFeed(context.Background(), models.NewsActionDelete, pq)
+		require.NoError(t, err)
+		require.Len(t, feed.Events, 1)
+		require.Equal(t, models.NewsActionDelete, feed.Events[0].Action)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Empty feed skips select", func(t *testing.T) {
+		mock.ExpectQuery(getActivityCount).WithArgs(models.NewsActionCreate, nil).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		feed, err := newsRepo.GetActivityFeed(context.Background(), models.NewsActionCreate, pq)
+		require.NoError(t, err)
+		require.Empty(t, feed.Events)
+		require.False(t, feed.HasMore)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_Relations(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	fromUID := uuid.New()
+	toUID := uuid.New()
+
+	t.Run("AddRelation", func(t *testing.T) {
+		mock.ExpectExec(addNewsRelation).WithArgs(fromUID, toUID, nil).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		added, err := newsRepo.AddRelation(context.Background(), fromUID, toUID)
+		require.NoError(t, err)
+		require.True(t, added)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AddRelation duplicate", func(t *testing.T) {
+		mock.ExpectExec(addNewsRelation).WithArgs(fromUID, toUID, nil).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		added, err := newsRepo.AddRelation(context.Background(), fromUID, toUID)
+		require.NoError(t, err)
+		require.False(t, added)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetCuratedRelated", func(t *testing.T) {
+		mock.ExpectQuery(getCuratedRelated).WithArgs(fromUID, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "title"}).AddRow(toUID, "Related"))
+
+		related, err := newsRepo.GetCuratedRelated(context.Background(), fromUID)
+		require.NoError(t, err)
+		require.Len(t, related, 1)
+		require.Equal(t, toUID, related[0].NewsID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RemoveRelation", func(t *testing.T) {
+		mock.ExpectExec(removeNewsRelation).WithArgs(fromUID, toUID, nil).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		require.NoError(t, newsRepo.RemoveRelation(context.Background(), fromUID, toUID))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RemoveRelation missing", func(t *testing.T) {
+		mock.ExpectExec(removeNewsRelation).WithArgs(fromUID, toUID, nil).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := newsRepo.RemoveRelation(context.Background(), fromUID, toUID)
+		require.True(t, errors.Is(err, sql.ErrNoRows))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_ContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	newsUID := uuid.New()
+
+	t.Run("Client disconnected", func(t *testing.T) {
+		mock.ExpectQuery(getNewsRevisions).WithArgs(newsUID).
+			WillDelayFor(time.Second).
+			WillReturnRows(sqlmock.NewRows([]string{"revision_id"}))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		revisions, err := newsRepo.GetRevisions(ctx, newsUID)
+		require.Nil(t, revisions)
+		require.True(t, errors.Is(err, context.Canceled))
+		require.Equal(t, httpErrors.StatusClientClosedRequest, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Deadline exceeded", func(t *testing.T) {
+		mock.ExpectQuery(getNewsRevisions).WithArgs(newsUID).
+			WillDelayFor(time.Second).
+			WillReturnRows(sqlmock.NewRows([]string{"revision_id"}))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		revisions, err := newsRepo.GetRevisions(ctx, newsUID)
+		require.Nil(t, revisions)
+		require.True(t, errors.Is(err, context.DeadlineExceeded))
+		require.Equal(t, http.StatusGatewayTimeout, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsRepo_Metadata(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	newsUID := uuid.New()
+	metadata := models.Metadata(`{"crm": {"id": 7}, "source": "import"}`)
+
+	t.Run("Store", func(t *testing.T) {
+		mock.ExpectExec(setNewsMetadata).WithArgs(newsUID, string(metadata)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		require.NoError(t, newsRepo.SetMetadata(context.Background(), newsUID, metadata))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Store for missing news", func(t *testing.T) {
+		mock.ExpectExec(setNewsMetadata).WithArgs(newsUID, nil).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := newsRepo.SetMetadata(context.Background(), newsUID, nil)
+		require.True(t, errors.Is(err, sql.ErrNoRows))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Retrieve", func(t *testing.T) {
+		mock.ExpectQuery(getNewsMetadata).WithArgs(newsUID).
+			WillReturnRows(sqlmock.NewRows([]string{"metadata"}).AddRow([]byte(metadata)))
+
+		stored, err := newsRepo.GetMetadata(context.Background(), newsUID)
+		require.NoError(t, err)
+		require.JSONEq(t, string(metadata), string(stored))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Retrieve unset", func(t *testing.T) {
+		mock.ExpectQuery(getNewsMetadata).WithArgs(newsUID).
+			WillReturnRows(sqlmock.NewRows([]string{"metadata"}).AddRow(nil))
+
+		stored, err := newsRepo.GetMetadata(context.Background(), newsUID)
+		require.NoError(t, err)
+		require.Nil(t, stored)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Containment query", func(t *testing.T) {
+		filter := `{"source": "import"}`
+		lq := &utils.ListQuery{
+			Conditions: []utils.ListCondition{{Column: "metadata", Operator: "@>", Value: filter}},
+			OrderBy:    "created_at",
+			Direction:  "ASC",
+		}
+		where := newsListBaseCondition + " AND metadata @> $1"
+		pq := &utils.PaginationQuery{Size: 10, Page: 1}
+
+		mock.ExpectPrepare(fmt.Sprintf(getNewsCount, where)).ExpectQuery().WithArgs(filter).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectPrepare(fmt.Sprintf(getNews, where, "created_at ASC", 2, 3)).ExpectQuery().WithArgs(filter, pq.GetOffset(), pq.GetLimit()).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "metadata"}).AddRow(newsUID, []byte(metadata)))
+
+		newsList, err := newsRepo.GetNews(context.Background(), lq, pq)
+		require.NoError(t, err)
+		require.Len(t, newsList.News, 1)
+		require.JSONEq(t, string(metadata), string(newsList.News[0].Metadata))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_Translations(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	newsID := uuid.New()
+
+	t.Run("Set", func(t *testing.T) {
+		translation := &models.NewsTranslation{NewsID: newsID, Locale: "pt-BR", Title: "Título", Content: "Conteúdo"}
+		mock.ExpectQuery(setNewsTranslation).WithArgs(newsID, "pt-BR", "Título", "Conteúdo").
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "locale", "title", "content"}).AddRow(newsID, "pt-BR", "Título", "Conteúdo"))
+
+		saved, err := newsRepo.SetTranslation(context.Background(), translation)
+		require.NoError(t, err)
+		require.Equal(t, "pt-BR", saved.Locale)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Get missing", func(t *testing.T) {
+		mock.ExpectQuery(getNewsTranslation).WithArgs(newsID, "fr").WillReturnRows(sqlmock.NewRows([]string{"news_id"}))
+
+		_, err := newsRepo.GetTranslation(context.Background(), newsID, "fr")
+		require.True(t, errors.Is(err, sql.ErrNoRows))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_ReadingPosition(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	userID := uuid.New()
+	newsID := uuid.New()
+	columns := []string{"user_id", "news_id", "position", "updated_at"}
+
+	t.Run("Save", func(t *testing.T) {
+		mock.ExpectQuery(saveReadingPosition).WithArgs(userID, newsID, 0.25).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(userID, newsID, 0.25, time.Now()))
+
+		saved, err := newsRepo.SaveReadingPosition(context.Background(), &models.ReadingPosition{UserID: userID, NewsID: newsID, Position: 0.25})
+		require.NoError(t, err)
+		require.Equal(t, 0.25, saved.Position)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		mock.ExpectQuery(getReadingPosition).WithArgs(userID, newsID).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(userID, newsID, 0.75, time.Now()))
+
+		position, err := newsRepo.GetReadingPosition(context.Background(), userID, newsID)
+		require.NoError(t, err)
+		require.Equal(t, userID, position.UserID)
+		require.Equal(t, 0.75, position.Position)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Get missing", func(t *testing.T) {
+		otherUserID := uuid.New()
+		mock.ExpectQuery(getReadingPosition).WithArgs(otherUserID, newsID).WillReturnRows(sqlmock.NewRows(columns))
+
+		_, err := newsRepo.GetReadingPosition(context.Background(), otherUserID, newsID)
+		require.True(t, errors.Is(err, sql.ErrNoRows))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_SetTags(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	newsUID := uuid.New()
+
+	t.Run("Replace tags", func(t *testing.T) {
+		names := `{"go","c++"}`
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteNewsTags).WithArgs(newsUID).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(createTags).WithArgs(names).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(addNewsTags).WithArgs(newsUID, names).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		require.NoError(t, newsRepo.SetTags(context.Background(), newsUID, []string{"go", "c++"}))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Clear tags", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(deleteNewsTags).WithArgs(newsUID).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		require.NoError(t, newsRepo.SetTags(context.Background(), newsUID, nil))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Get tags", func(t *testing.T) {
+		mock.ExpectQuery(getNewsTags).WithArgs(newsUID).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("c++").AddRow("go"))
+
+		tags, err := newsRepo.GetTags(context.Background(), newsUID)
+		require.NoError(t, err)
+		require.Equal(t, []string{"c++", "go"}, tags)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_AddTagToMany(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	t.Run("News already having tag are not counted", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(createTags).WithArgs(`{"go"}`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(addTagToMany).WithArgs(utils.UUIDArray(ids), "go", nil).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		affected, err := newsRepo.AddTagToMany(context.Background(), ids, "go")
+		require.NoError(t, err)
+		require.Equal(t, 2, affected)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failed insert is rolled back", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(createTags).WithArgs(`{"go"}`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(addTagToMany).WithArgs(utils.UUIDArray(ids), "go", nil).WillReturnError(errors.New("deadlock detected"))
+		mock.ExpectRollback()
+
+		_, err := newsRepo.AddTagToMany(context.Background(), ids, "go")
+		require.Error(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_GetChangedSince(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	since := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	createdUID, updatedUID, deletedUID, archivedUID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	authorUID := uuid.New()
+	deletedAt := since.Add(3 * time.Hour)
+
+	columns := []string{"news_id", "author_id", "title", "content", "status", "created_at", "updated_at", "deleted_at"}
+	rows := sqlmock.NewRows(columns).
+		AddRow(createdUID, authorUID, "created", "content", "published", since.Add(time.Hour), since.Add(time.Hour), nil).
+		AddRow(updatedUID, authorUID, "updated", "content", "published", since.Add(-time.Hour), since.Add(2*time.Hour), nil).
+		AddRow(deletedUID, authorUID, "deleted", "content", "published", since.Add(-time.Hour), deletedAt, deletedAt).
+		AddRow(archivedUID, authorUID, "archived", "content", "archived", since.Add(-time.Hour), since.Add(4*time.Hour), nil)
+
+	pq := &utils.PaginationQuery{Size: 10, Page: 1}
+	mock.ExpectQuery(getChangedSinceCount).WithArgs(since, nil).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+	mock.ExpectQuery(getChangedSince).WithArgs(since, 0, 10, nil).WillReturnRows(rows)
+
+	changes, err := newsRepo.GetChangedSince(context.Background(), since, pq)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, 4, changes.TotalCount)
+	require.False(t, changes.HasMore)
+	require.Len(t, changes.Changes, 4)
+
+	created, updated, deleted, archived := changes.Changes[0], changes.Changes[1], changes.Changes[2], changes.Changes[3]
+	require.Equal(t, createdUID, created.NewsID)
+	require.False(t, created.Deleted)
+	require.Equal(t, "created", created.News.Title)
+
+	require.Equal(t, updatedUID, updated.NewsID)
+	require.False(t, updated.Deleted)
+	require.Equal(t, since.Add(2*time.Hour), updated.UpdatedAt)
+
+	require.Equal(t, deletedUID, deleted.NewsID)
+	require.True(t, deleted.Deleted)
+	require.Nil(t, deleted.News)
+	require.Equal(t, deletedAt, deleted.UpdatedAt)
+
+	require.Equal(t, archivedUID, archived.NewsID)
+	require.True(t, archived.Deleted)
+	require.Nil(t, archived.News)
+}
+
+func TestNewsRepo_PurgeDeleted(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+	before := time.Now().Add(-30 * 24 * time.Hour)
+
+	t.Run("Batches until exhausted", func(t *testing.T) {
+		first, second, third := uuid.New(), uuid.New(), uuid.New()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(purgeDeletedNews).WithArgs(before, 2, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id"}).AddRow(first).AddRow(second))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectQuery(purgeDeletedNews).WithArgs(before, 2, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id"}).AddRow(third))
+		mock.ExpectCommit()
+
+		ids, err := newsRepo.PurgeDeleted(context.Background(), before, 2)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{first, second, third}, ids)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Nothing to purge", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(purgeDeletedNews).WithArgs(before, 2, nil).WillReturnRows(sqlmock.NewRows([]string{"news_id"}))
+		mock.ExpectCommit()
+
+		ids, err := newsRepo.PurgeDeleted(context.Background(), before, 2)
+		require.NoError(t, err)
+		require.Empty(t, ids)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failed batch keeps committed ones", func(t *testing.T) {
+		first := uuid.New()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(purgeDeletedNews).WithArgs(before, 1, nil).WillReturnRows(sqlmock.NewRows([]string{"news_id"}).AddRow(first))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectQuery(purgeDeletedNews).WithArgs(before, 1, nil).WillReturnError(errors.New("connection reset"))
+		mock.ExpectRollback()
+
+		ids, err := newsRepo.PurgeDeleted(context.Background(), before, 1)
+		require.Error(t, err)
+		require.Equal(t, []uuid.UUID{first}, ids)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_ImageCheck(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	t.Run("GetNewsImages", func(t *testing.T) {
+		afterUID := uuid.New()
+		newsUID := uuid.New()
+		mock.ExpectQuery(getNewsImages).WithArgs(afterUID, 100).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "image_url"}).AddRow(newsUID, "http://images.test/a.png"))
+
+		images, err := newsRepo.GetNewsImages(context.Background(), afterUID, 100)
+		require.NoError(t, err)
+		require.Equal(t, []*models.NewsImage{{NewsID: newsUID, ImageURL: "http://images.test/a.png"}}, images)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SetImageBroken", func(t *testing.T) {
+		checked := []uuid.UUID{uuid.New(), uuid.New()}
+		broken := checked[1:]
+		mock.ExpectExec(setImageBroken).WithArgs(utils.UUIDArray(checked), utils.UUIDArray(broken)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		require.NoError(t, newsRepo.SetImageBroken(context.Background(), checked, broken))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetBrokenImages", func(t *testing.T) {
+		pq := &utils.PaginationQuery{Size: 10, Page: 1}
+		newsUID := uuid.New()
+		mock.ExpectQuery(getBrokenImagesCount).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(getBrokenImages).WithArgs(pq.GetOffset(), pq.GetLimit(), nil).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "image_url", "image_broken"}).AddRow(newsUID, "http://images.test/a.png", true))
+
+		newsList, err := newsRepo.GetBrokenImages(context.Background(), pq)
+		require.NoError(t, err)
+		require.Equal(t, 1, newsList.TotalCount)
+		require.Len(t, newsList.News, 1)
+		require.True(t, newsList.News[0].ImageBroken)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_GetTrending(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{}, logger.NewApiLogger(nil))
+
+	t.Run("Order of score kept", func(t *testing.T) {
+		newerUID := uuid.New()
+		olderUID := uuid.New()
+		unreadUID := uuid.New()
+		rows := sqlmock.NewRows([]string{"news_id", "title", "views", "created_at"}).
+			AddRow(newerUID, "Newer", 500, time.Now().Add(-2*time.Hour)).
+			AddRow(olderUID, "Older", 2000, time.Now().Add(-48*time.Hour)).
+			AddRow(unreadUID, "Unread", 0, time.Now())
+		mock.ExpectQuery(getTrendingNews).WithArgs(1.8, 3, nil).WillReturnRows(rows)
+
+		trending, err := newsRepo.GetTrending(context.Background(), 1.8, 3)
+		require.NoError(t, err)
+		require.Len(t, trending, 3)
+		require.Equal(t, newerUID, trending[0].NewsID)
+		require.Equal(t, int64(500), trending[0].Views)
+		require.Equal(t, olderUID, trending[1].NewsID)
+		require.Equal(t, unreadUID, trending[2].NewsID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Zero views ordered by recency", func(t *testing.T) {
+		require.Contains(t, getTrendingNews, "GREATEST(EXTRACT(EPOCH FROM now() - created_at) / 3600, 0) + 2, $1) DESC")
+		require.Contains(t, getTrendingNews, "created_at DESC, news_id DESC")
+	})
+}
+
+func TestNewsRepo_AddViews(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	defer sqlxDB.Close()
+
+	newsRepo := NewNewsRepository(sqlxDB, &config.Config{Postgres: config.PostgresConfig{MaxListParams: 2}}, logger.NewApiLogger(nil))
+
+	t.Run("Add views", func(t *testing.T) {
+		ids := []uuid.UUID{uuid.New(), uuid.New()}
+		mock.ExpectExec(addNewsViews).
+			WithArgs(utils.UUIDArray(ids), utils.TextArray([]string{"3", "1"})).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		require.NoError(t, newsRepo.AddViews(context.Background(), ids, []int64{3, 1}))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Beyond limit", func(t *testing.T) {
+		err := newsRepo.AddViews(context.Background(), []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}, []int64{1, 1, 1})
+		require.ErrorIs(t, err, postgres.ErrBatchTooLarge)
 	})
 }
@@ -2,25 +2,30 @@ package repository
 
 import (
 	"context"
-	"encoding/json"
+	"strconv"
 	"time"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
 
+	"github.com/AleksK1NG/api-mc/config"
 	"github.com/AleksK1NG/api-mc/internal/models"
 	"github.com/AleksK1NG/api-mc/internal/news"
+	"github.com/AleksK1NG/api-mc/pkg/utils"
 )
 
 // News redis repository
 type newsRedisRepo struct {
 	redisClient *redis.Client
+	cfg         *config.Config
+	codec       cacheCodec
 }
 
 // News redis repository constructor
-func NewNewsRedisRepo(redisClient *redis.Client) news.RedisRepository {
-	return &newsRedisRepo{redisClient: redisClient}
+func NewNewsRedisRepo(redisClient *redis.Client, cfg *config.Config) news.RedisRepository {
+	return &newsRedisRepo{redisClient: redisClient, cfg: cfg, codec: newCacheCodec(cfg.Redis.FastCacheCodec)}
 }
 
 // Get new by id
@@ -33,23 +38,146 @@ func (n *newsRedisRepo) GetNewsByIDCtx(ctx context.Context, key string) (*models
 		return nil, errors.Wrap(err, "newsRedisRepo.GetNewsByIDCtx.redisClient.Get")
 	}
 	newsBase := &models.NewsBase{}
-	if err = json.Unmarshal(newsBytes, newsBase); err != nil {
+	if err = n.codec.Unmarshal(newsBytes, newsBase); err != nil {
 		return nil, errors.Wrap(err, "newsRedisRepo.GetNewsByIDCtx.json.Unmarshal")
 	}
 
 	return newsBase, nil
 }
 
+// Get raw cached value and its remaining time to live in one transaction, nil value on miss.
+// TTL is negative for keys without expiry
+func (n *newsRedisRepo) GetRawCtx(ctx context.Context, key string) ([]byte, time.Duration, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.GetRawCtx")
+	defer span.Finish()
+
+	var getCmd *redis.StringCmd
+	var ttlCmd *redis.DurationCmd
+	_, err := n.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		getCmd = pipe.Get(ctx, key)
+		ttlCmd = pipe.TTL(ctx, key)
+		return nil
+	})
+	if errors.Is(err, redis.Nil) {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "newsRedisRepo.GetRawCtx.redisClient.TxPipelined")
+	}
+
+	value, err := getCmd.Bytes()
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "newsRedisRepo.GetRawCtx.Get")
+	}
+
+	return value, ttlCmd.Val(), nil
+}
+
+// News cached with moment it stops being fresh, stored flat so plain news readers can decode it too
+type freshNews struct {
+	*models.NewsBase
+	FreshUntil time.Time `json:"fresh_until"`
+}
+
+// Get news by id with moment it stops being fresh, zero moment for news cached without it
+func (n *newsRedisRepo) GetNewsWithFreshnessCtx(ctx context.Context, key string) (*models.NewsBase, time.Time, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.GetNewsWithFreshnessCtx")
+	defer span.Finish()
+
+	newsBytes, err := n.redisClient.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, time.Time{}, errors.Wrap(err, "newsRedisRepo.GetNewsWithFreshnessCtx.redisClient.Get")
+	}
+	cached := &freshNews{NewsBase: &models.NewsBase{}}
+	if err = n.codec.Unmarshal(newsBytes, cached); err != nil {
+		return nil, time.Time{}, errors.Wrap(err, "newsRedisRepo.GetNewsWithFreshnessCtx.json.Unmarshal")
+	}
+
+	return cached.NewsBase, cached.FreshUntil, nil
+}
+
+// Cache news item with moment it stops being fresh, seconds is hard ttl after which news is gone
+func (n *newsRedisRepo) SetNewsWithFreshnessCtx(ctx context.Context, key string, seconds int, freshUntil time.Time, news *models.NewsBase) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.SetNewsWithFreshnessCtx")
+	defer span.Finish()
+
+	newsBytes, err := n.codec.Marshal(&freshNews{NewsBase: news, FreshUntil: freshUntil})
+	if err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetNewsWithFreshnessCtx.json.Marshal")
+	}
+	if err = n.redisClient.Set(ctx, key, newsBytes, n.getTTL(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetNewsWithFreshnessCtx.redisClient.Set")
+	}
+	return nil
+}
+
+// Get many news with one MGET, result is aligned with keys and has nil for missing keys
+func (n *newsRedisRepo) GetNewsByKeysCtx(ctx context.Context, keys []string) ([]*models.NewsBase, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.GetNewsByKeysCtx")
+	defer span.Finish()
+
+	values, err := n.redisClient.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetNewsByKeysCtx.redisClient.MGet")
+	}
+
+	news := make([]*models.NewsBase, len(values))
+	for i, value := range values {
+		newsStr, ok := value.(string)
+		if !ok {
+			continue
+		}
+		newsBase := &models.NewsBase{}
+		if err = n.codec.Unmarshal([]byte(newsStr), newsBase); err != nil {
+			return nil, errors.Wrap(err, "newsRedisRepo.GetNewsByKeysCtx.json.Unmarshal")
+		}
+		news[i] = newsBase
+	}
+
+	return news, nil
+}
+
+// Get many news ids with one MGET, result is aligned with keys and has empty string for missing keys
+func (n *newsRedisRepo) GetNewsIDsCtx(ctx context.Context, keys []string) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.GetNewsIDsCtx")
+	defer span.Finish()
+
+	values, err := n.redisClient.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetNewsIDsCtx.redisClient.MGet")
+	}
+
+	ids := make([]string, len(values))
+	for i, value := range values {
+		if id, ok := value.(string); ok {
+			ids[i] = id
+		}
+	}
+
+	return ids, nil
+}
+
+// Cache news id, e.g. under news slug
+func (n *newsRedisRepo) SetNewsIDCtx(ctx context.Context, key string, seconds int, newsID string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.SetNewsIDCtx")
+	defer span.Finish()
+
+	if err := n.redisClient.Set(ctx, key, newsID, n.getTTL(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetNewsIDCtx.redisClient.Set")
+	}
+	return nil
+}
+
 // Cache news item
 func (n *newsRedisRepo) SetNewsCtx(ctx context.Context, key string, seconds int, news *models.NewsBase) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.SetNewsCtx")
 	defer span.Finish()
 
-	newsBytes, err := json.Marshal(news)
+	newsBytes, err := n.codec.Marshal(news)
 	if err != nil {
 		return errors.Wrap(err, "newsRedisRepo.SetNewsCtx.json.Marshal")
 	}
-	if err = n.redisClient.Set(ctx, key, newsBytes, time.Second*time.Duration(seconds)).Err(); err != nil {
+	if err = n.redisClient.Set(ctx, key, newsBytes, n.getTTL(seconds)).Err(); err != nil {
 		return errors.Wrap(err, "newsRedisRepo.SetNewsCtx.redisClient.Set")
 	}
 	return nil
@@ -65,3 +193,523 @@ func (n *newsRedisRepo) DeleteNewsCtx(ctx context.Context, key string) error {
 	}
 	return nil
 }
+
+// Get news timeline
+func (n *newsRedisRepo) GetTimelineCtx(ctx context.Context, key string) ([]*models.TimelineBucket, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.GetTimelineCtx")
+	defer span.Finish()
+
+	timelineBytes, err := n.redisClient.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetTimelineCtx.redisClient.Get")
+	}
+	var timeline []*models.TimelineBucket
+	if err = n.codec.Unmarshal(timelineBytes, &timeline); err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetTimelineCtx.json.Unmarshal")
+	}
+
+	return timeline, nil
+}
+
+// Cache news timeline
+func (n *newsRedisRepo) SetTimelineCtx(ctx context.Context, key string, seconds int, timeline []*models.TimelineBucket) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.SetTimelineCtx")
+	defer span.Finish()
+
+	timelineBytes, err := n.codec.Marshal(timeline)
+	if err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetTimelineCtx.json.Marshal")
+	}
+	if err = n.redisClient.Set(ctx, key, timelineBytes, n.getTTL(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetTimelineCtx.redisClient.Set")
+	}
+	return nil
+}
+
+// Get featured news
+func (n *newsRedisRepo) GetFeaturedCtx(ctx context.Context, key string) ([]*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.GetFeaturedCtx")
+	defer span.Finish()
+
+	featuredBytes, err := n.redisClient.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetFeaturedCtx.redisClient.Get")
+	}
+	var featured []*models.News
+	if err = n.codec.Unmarshal(featuredBytes, &featured); err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetFeaturedCtx.json.Unmarshal")
+	}
+
+	return featured, nil
+}
+
+// Cache featured news
+func (n *newsRedisRepo) SetFeaturedCtx(ctx context.Context, key string, seconds int, featured []*models.News) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.SetFeaturedCtx")
+	defer span.Finish()
+
+	featuredBytes, err := n.codec.Marshal(featured)
+	if err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetFeaturedCtx.json.Marshal")
+	}
+	if err = n.redisClient.Set(ctx, key, featuredBytes, n.getTTL(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetFeaturedCtx.redisClient.Set")
+	}
+	return nil
+}
+
+// Get latest news per category
+func (n *newsRedisRepo) GetLatestPerCategoryCtx(ctx context.Context, key string) (map[string]*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.GetLatestPerCategoryCtx")
+	defer span.Finish()
+
+	latestBytes, err := n.redisClient.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetLatestPerCategoryCtx.redisClient.Get")
+	}
+	var latest map[string]*models.News
+	if err = n.codec.Unmarshal(latestBytes, &latest); err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetLatestPerCategoryCtx.json.Unmarshal")
+	}
+
+	return latest, nil
+}
+
+// Cache latest news per category
+func (n *newsRedisRepo) SetLatestPerCategoryCtx(ctx context.Context, key string, seconds int, latest map[string]*models.News) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.SetLatestPerCategoryCtx")
+	defer span.Finish()
+
+	latestBytes, err := n.codec.Marshal(latest)
+	if err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetLatestPerCategoryCtx.json.Marshal")
+	}
+	if err = n.redisClient.Set(ctx, key, latestBytes, n.getTTL(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetLatestPerCategoryCtx.redisClient.Set")
+	}
+	return nil
+}
+
+// Get latest updated news per author
+func (n *newsRedisRepo) GetLatestPerAuthorCtx(ctx context.Context, key string) (map[uuid.UUID]*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.GetLatestPerAuthorCtx")
+	defer span.Finish()
+
+	latestBytes, err := n.redisClient.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetLatestPerAuthorCtx.redisClient.Get")
+	}
+	var latest map[uuid.UUID]*models.News
+	if err = n.codec.Unmarshal(latestBytes, &latest); err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetLatestPerAuthorCtx.json.Unmarshal")
+	}
+
+	return latest, nil
+}
+
+// Cache latest updated news per author
+func (n *newsRedisRepo) SetLatestPerAuthorCtx(ctx context.Context, key string, seconds int, latest map[uuid.UUID]*models.News) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.SetLatestPerAuthorCtx")
+	defer span.Finish()
+
+	latestBytes, err := n.codec.Marshal(latest)
+	if err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetLatestPerAuthorCtx.json.Marshal")
+	}
+	if err = n.redisClient.Set(ctx, key, latestBytes, n.getTTL(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetLatestPerAuthorCtx.redisClient.Set")
+	}
+	return nil
+}
+
+// Get curated related news
+func (n *newsRedisRepo) GetRelatedCtx(ctx context.Context, key string) ([]*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.GetRelatedCtx")
+	defer span.Finish()
+
+	relatedBytes, err := n.redisClient.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetRelatedCtx.redisClient.Get")
+	}
+	var related []*models.News
+	if err = n.codec.Unmarshal(relatedBytes, &related); err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetRelatedCtx.json.Unmarshal")
+	}
+
+	return related, nil
+}
+
+// Cache curated related news
+func (n *newsRedisRepo) SetRelatedCtx(ctx context.Context, key string, seconds int, related []*models.News) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.SetRelatedCtx")
+	defer span.Finish()
+
+	relatedBytes, err := n.codec.Marshal(related)
+	if err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetRelatedCtx.json.Marshal")
+	}
+	if err = n.redisClient.Set(ctx, key, relatedBytes, n.getTTL(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetRelatedCtx.redisClient.Set")
+	}
+	return nil
+}
+
+// Delete lock only while it is still held by given user
+var releaseEditLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// Take edit lock with SET NX, holder renews its own lock. False when lock is held by other user
+func (n *newsRedisRepo) AcquireEditLockCtx(ctx context.Context, key string, userID string, ttl time.Duration) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.AcquireEditLockCtx")
+	defer span.Finish()
+
+	acquired, err := n.redisClient.SetNX(ctx, key, userID, ttl).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "newsRedisRepo.AcquireEditLockCtx.redisClient.SetNX")
+	}
+	if acquired {
+		return true, nil
+	}
+
+	holder, err := n.redisClient.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return n.AcquireEditLockCtx(ctx, key, userID, ttl)
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "newsRedisRepo.AcquireEditLockCtx.redisClient.Get")
+	}
+	if holder != userID {
+		return false, nil
+	}
+	if err = n.redisClient.Expire(ctx, key, ttl).Err(); err != nil {
+		return false, errors.Wrap(err, "newsRedisRepo.AcquireEditLockCtx.redisClient.Expire")
+	}
+	return true, nil
+}
+
+// Release edit lock held by user, false when lock is missing or held by other user
+func (n *newsRedisRepo) ReleaseEditLockCtx(ctx context.Context, key string, userID string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.ReleaseEditLockCtx")
+	defer span.Finish()
+
+	deleted, err := releaseEditLockScript.Run(ctx, n.redisClient, []string{key}, userID).Int()
+	if err != nil {
+		return false, errors.Wrap(err, "newsRedisRepo.ReleaseEditLockCtx.releaseEditLockScript.Run")
+	}
+	return deleted > 0, nil
+}
+
+// Get edit lock holder and remaining ttl, empty holder when lock is free
+func (n *newsRedisRepo) GetEditLockCtx(ctx context.Context, key string) (string, time.Duration, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.GetEditLockCtx")
+	defer span.Finish()
+
+	var getCmd *redis.StringCmd
+	var ttlCmd *redis.DurationCmd
+	_, err := n.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		getCmd = pipe.Get(ctx, key)
+		ttlCmd = pipe.PTTL(ctx, key)
+		return nil
+	})
+	if errors.Is(err, redis.Nil) {
+		return "", 0, nil
+	}
+	if err != nil {
+		return "", 0, errors.Wrap(err, "newsRedisRepo.GetEditLockCtx.redisClient.TxPipelined")
+	}
+
+	return getCmd.Val(), ttlCmd.Val(), nil
+}
+
+// Get trending news
+func (n *newsRedisRepo) GetTrendingCtx(ctx context.Context, key string) ([]*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.GetTrendingCtx")
+	defer span.Finish()
+
+	trendingBytes, err := n.redisClient.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetTrendingCtx.redisClient.Get")
+	}
+	var trending []*models.News
+	if err = n.codec.Unmarshal(trendingBytes, &trending); err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetTrendingCtx.json.Unmarshal")
+	}
+
+	return trending, nil
+}
+
+// Cache trending news
+func (n *newsRedisRepo) SetTrendingCtx(ctx context.Context, key string, seconds int, trending []*models.News) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.SetTrendingCtx")
+	defer span.Finish()
+
+	trendingBytes, err := n.codec.Marshal(trending)
+	if err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetTrendingCtx.json.Marshal")
+	}
+	if err = n.redisClient.Set(ctx, key, trendingBytes, n.getTTL(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetTrendingCtx.redisClient.Set")
+	}
+	return nil
+}
+
+// Get cached activity feed page
+func (n *newsRedisRepo) GetActivityFeedCtx(ctx context.Context, key string) (*models.NewsEventList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.GetActivityFeedCtx")
+	defer span.Finish()
+
+	feedBytes, err := n.redisClient.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetActivityFeedCtx.redisClient.Get")
+	}
+	feed := &models.NewsEventList{}
+	if err = n.codec.Unmarshal(feedBytes, feed); err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetActivityFeedCtx.json.Unmarshal")
+	}
+
+	return feed, nil
+}
+
+// Cache activity feed page
+func (n *newsRedisRepo) SetActivityFeedCtx(ctx context.Context, key string, seconds int, feed *models.NewsEventList) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.SetActivityFeedCtx")
+	defer span.Finish()
+
+	feedBytes, err := n.codec.Marshal(feed)
+	if err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetActivityFeedCtx.json.Marshal")
+	}
+	if err = n.redisClient.Set(ctx, key, feedBytes, n.getTTL(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetActivityFeedCtx.redisClient.Set")
+	}
+	return nil
+}
+
+// Get cached news list page
+func (n *newsRedisRepo) GetNewsListCtx(ctx context.Context, key string) (*models.NewsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.GetNewsListCtx")
+	defer span.Finish()
+
+	listBytes, err := n.redisClient.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetNewsListCtx.redisClient.Get")
+	}
+	newsList := &models.NewsList{}
+	if err = n.codec.Unmarshal(listBytes, newsList); err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetNewsListCtx.json.Unmarshal")
+	}
+
+	return newsList, nil
+}
+
+// Cache news list page
+func (n *newsRedisRepo) SetNewsListCtx(ctx context.Context, key string, seconds int, newsList *models.NewsList) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.SetNewsListCtx")
+	defer span.Finish()
+
+	listBytes, err := n.codec.Marshal(newsList)
+	if err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetNewsListCtx.json.Marshal")
+	}
+	if err = n.redisClient.Set(ctx, key, listBytes, n.getTTL(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetNewsListCtx.redisClient.Set")
+	}
+	return nil
+}
+
+// Get author leaderboard
+func (n *newsRedisRepo) GetLeaderboardCtx(ctx context.Context, key string) ([]*models.AuthorStat, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.GetLeaderboardCtx")
+	defer span.Finish()
+
+	statsBytes, err := n.redisClient.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetLeaderboardCtx.redisClient.Get")
+	}
+	var stats []*models.AuthorStat
+	if err = n.codec.Unmarshal(statsBytes, &stats); err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetLeaderboardCtx.json.Unmarshal")
+	}
+
+	return stats, nil
+}
+
+// Cache author leaderboard
+func (n *newsRedisRepo) SetLeaderboardCtx(ctx context.Context, key string, seconds int, stats []*models.AuthorStat) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.SetLeaderboardCtx")
+	defer span.Finish()
+
+	statsBytes, err := n.codec.Marshal(stats)
+	if err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetLeaderboardCtx.json.Marshal")
+	}
+	if err = n.redisClient.Set(ctx, key, statsBytes, n.getTTL(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetLeaderboardCtx.redisClient.Set")
+	}
+	return nil
+}
+
+// Get news counts per status
+func (n *newsRedisRepo) GetStatusCountsCtx(ctx context.Context, key string) (map[string]int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.GetStatusCountsCtx")
+	defer span.Finish()
+
+	countsBytes, err := n.redisClient.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetStatusCountsCtx.redisClient.Get")
+	}
+	var counts map[string]int
+	if err = n.codec.Unmarshal(countsBytes, &counts); err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetStatusCountsCtx.json.Unmarshal")
+	}
+
+	return counts, nil
+}
+
+// Cache news counts per status
+func (n *newsRedisRepo) SetStatusCountsCtx(ctx context.Context, key string, seconds int, counts map[string]int) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.SetStatusCountsCtx")
+	defer span.Finish()
+
+	countsBytes, err := n.codec.Marshal(counts)
+	if err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetStatusCountsCtx.json.Marshal")
+	}
+	if err = n.redisClient.Set(ctx, key, countsBytes, n.getTTL(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetStatusCountsCtx.redisClient.Set")
+	}
+	return nil
+}
+
+// Get cached news translation
+func (n *newsRedisRepo) GetTranslationCtx(ctx context.Context, key string) (*models.NewsTranslation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.GetTranslationCtx")
+	defer span.Finish()
+
+	translationBytes, err := n.redisClient.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetTranslationCtx.redisClient.Get")
+	}
+	translation := &models.NewsTranslation{}
+	if err = n.codec.Unmarshal(translationBytes, translation); err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetTranslationCtx.json.Unmarshal")
+	}
+
+	return translation, nil
+}
+
+// Cache news translation
+func (n *newsRedisRepo) SetTranslationCtx(ctx context.Context, key string, seconds int, translation *models.NewsTranslation) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.SetTranslationCtx")
+	defer span.Finish()
+
+	translationBytes, err := n.codec.Marshal(translation)
+	if err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetTranslationCtx.json.Marshal")
+	}
+	if err = n.redisClient.Set(ctx, key, translationBytes, n.getTTL(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetTranslationCtx.redisClient.Set")
+	}
+	return nil
+}
+
+// Get cached reading position
+func (n *newsRedisRepo) GetReadingPositionCtx(ctx context.Context, key string) (*models.ReadingPosition, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.GetReadingPositionCtx")
+	defer span.Finish()
+
+	positionBytes, err := n.redisClient.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetReadingPositionCtx.redisClient.Get")
+	}
+	position := &models.ReadingPosition{}
+	if err = n.codec.Unmarshal(positionBytes, position); err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetReadingPositionCtx.json.Unmarshal")
+	}
+
+	return position, nil
+}
+
+// Cache reading position
+func (n *newsRedisRepo) SetReadingPositionCtx(ctx context.Context, key string, seconds int, position *models.ReadingPosition) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.SetReadingPositionCtx")
+	defer span.Finish()
+
+	positionBytes, err := n.codec.Marshal(position)
+	if err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetReadingPositionCtx.json.Marshal")
+	}
+	if err = n.redisClient.Set(ctx, key, positionBytes, n.getTTL(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetReadingPositionCtx.redisClient.Set")
+	}
+	return nil
+}
+
+// Get rendered news feed
+func (n *newsRedisRepo) GetFeedCtx(ctx context.Context, key string) ([]byte, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.GetFeedCtx")
+	defer span.Finish()
+
+	feedBytes, err := n.redisClient.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.GetFeedCtx.redisClient.Get")
+	}
+
+	return feedBytes, nil
+}
+
+// Cache rendered news feed
+func (n *newsRedisRepo) SetFeedCtx(ctx context.Context, key string, seconds int, feed []byte) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.SetFeedCtx")
+	defer span.Finish()
+
+	if err := n.redisClient.Set(ctx, key, feed, n.getTTL(seconds)).Err(); err != nil {
+		return errors.Wrap(err, "newsRedisRepo.SetFeedCtx.redisClient.Set")
+	}
+	return nil
+}
+
+// Add views of news to counters hash, counters live until PopViewsCtx takes them
+func (n *newsRedisRepo) IncrViewsCtx(ctx context.Context, key string, newsID string, views int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.IncrViewsCtx")
+	defer span.Finish()
+
+	if err := n.redisClient.HIncrBy(ctx, key, newsID, views).Err(); err != nil {
+		return errors.Wrap(err, "newsRedisRepo.IncrViewsCtx.redisClient.HIncrBy")
+	}
+	return nil
+}
+
+// Take all view counters in one transaction, so views counted meanwhile go to the next pop
+func (n *newsRedisRepo) PopViewsCtx(ctx context.Context, key string) (map[string]int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsRedisRepo.PopViewsCtx")
+	defer span.Finish()
+
+	var getCmd *redis.StringStringMapCmd
+	_, err := n.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		getCmd = pipe.HGetAll(ctx, key)
+		pipe.Del(ctx, key)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "newsRedisRepo.PopViewsCtx.redisClient.TxPipelined")
+	}
+
+	views := make(map[string]int64, len(getCmd.Val()))
+	for newsID, count := range getCmd.Val() {
+		value, err := strconv.ParseInt(count, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "newsRedisRepo.PopViewsCtx.ParseInt: %s", newsID)
+		}
+		views[newsID] = value
+	}
+	return views, nil
+}
+
+// Get cache ttl spread by configured jitter, so entries written together don't expire together
+func (n *newsRedisRepo) getTTL(seconds int) time.Duration {
+	return utils.JitterTTL(time.Second*time.Duration(seconds), n.cfg.Redis.TTLJitterPercent)
+}
@@ -2,14 +2,19 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/alicebob/miniredis"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
+	"github.com/AleksK1NG/api-mc/config"
 	"github.com/AleksK1NG/api-mc/internal/models"
 	"github.com/AleksK1NG/api-mc/internal/news"
 )
@@ -23,7 +28,7 @@ func SetupRedis() news.RedisRepository {
 		Addr: mr.Addr(),
 	})
 
-	newsRedisRepo := NewNewsRedisRepo(client)
+	newsRedisRepo := NewNewsRedisRepo(client, &config.Config{})
 	return newsRedisRepo
 }
 
@@ -76,6 +81,38 @@ func TestNewsRedisRepo_GetNewsByIDCtx(t *testing.T) {
 	})
 }
 
+func TestNewsRedisRepo_GetNewsByKeysCtx(t *testing.T) {
+	t.Parallel()
+
+	newsRedisRepo := SetupRedis()
+
+	t.Run("Aligned with keys", func(t *testing.T) {
+		n := &models.NewsBase{NewsID: uuid.New(), Title: "Title", Content: "Content"}
+		require.NoError(t, newsRedisRepo.SetNewsCtx(context.Background(), "first", 10, n))
+
+		news, err := newsRedisRepo.GetNewsByKeysCtx(context.Background(), []string{"missing", "first"})
+		require.NoError(t, err)
+		require.Len(t, news, 2)
+		require.Nil(t, news[0])
+		require.Equal(t, n.NewsID, news[1].NewsID)
+	})
+}
+
+func TestNewsRedisRepo_GetNewsIDsCtx(t *testing.T) {
+	t.Parallel()
+
+	newsRedisRepo := SetupRedis()
+
+	t.Run("Aligned with keys", func(t *testing.T) {
+		newsID := uuid.New().String()
+		require.NoError(t, newsRedisRepo.SetNewsIDCtx(context.Background(), "slug:first", 10, newsID))
+
+		ids, err := newsRedisRepo.GetNewsIDsCtx(context.Background(), []string{"slug:first", "slug:missing"})
+		require.NoError(t, err)
+		require.Equal(t, []string{newsID, ""}, ids)
+	})
+}
+
 func TestNewsRedisRepo_DeleteNewsCtx(t *testing.T) {
 	t.Parallel()
 
@@ -89,3 +126,363 @@ func TestNewsRedisRepo_DeleteNewsCtx(t *testing.T) {
 		require.Nil(t, err)
 	})
 }
+
+func TestNewsRedisRepo_SetNewsCtx_TTLJitter(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	cfg := &config.Config{Redis: config.RedisConfig{TTLJitterPercent: 20}}
+	newsRedisRepo := NewNewsRedisRepo(client, cfg)
+
+	t.Run("TTL within jittered range", func(t *testing.T) {
+		seconds := 100
+		minTTL := 80 * time.Second
+		maxTTL := 120 * time.Second
+
+		for i := 0; i < 20; i++ {
+			key := fmt.Sprintf("key-%d", i)
+			err := newsRedisRepo.SetNewsCtx(context.Background(), key, seconds, &models.NewsBase{NewsID: uuid.New()})
+			require.NoError(t, err)
+
+			ttl := mr.TTL(key)
+			require.GreaterOrEqual(t, int64(ttl), int64(minTTL))
+			require.LessOrEqual(t, int64(ttl), int64(maxTTL))
+		}
+	})
+}
+
+func TestNewsRedisRepo_NewsWithFreshness(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	newsRedisRepo := NewNewsRedisRepo(client, &config.Config{})
+
+	t.Run("Fresh until is kept with news", func(t *testing.T) {
+		n := &models.NewsBase{NewsID: uuid.New(), Title: "Title"}
+		freshUntil := time.Now().Add(time.Minute).UTC().Truncate(time.Second)
+		require.NoError(t, newsRedisRepo.SetNewsWithFreshnessCtx(context.Background(), "fresh", 100, freshUntil, n))
+
+		cached, cachedFreshUntil, err := newsRedisRepo.GetNewsWithFreshnessCtx(context.Background(), "fresh")
+		require.NoError(t, err)
+		require.Equal(t, n.NewsID, cached.NewsID)
+		require.True(t, freshUntil.Equal(cachedFreshUntil))
+		require.Equal(t, 100*time.Second, mr.TTL("fresh"))
+
+		plain, err := newsRedisRepo.GetNewsByIDCtx(context.Background(), "fresh")
+		require.NoError(t, err)
+		require.Equal(t, n.Title, plain.Title)
+	})
+
+	t.Run("News cached without fresh until", func(t *testing.T) {
+		n := &models.NewsBase{NewsID: uuid.New()}
+		require.NoError(t, newsRedisRepo.SetNewsCtx(context.Background(), "plain", 100, n))
+
+		cached, freshUntil, err := newsRedisRepo.GetNewsWithFreshnessCtx(context.Background(), "plain")
+		require.NoError(t, err)
+		require.Equal(t, n.NewsID, cached.NewsID)
+		require.True(t, freshUntil.IsZero())
+	})
+
+	t.Run("Hard expired", func(t *testing.T) {
+		require.NoError(t, newsRedisRepo.SetNewsWithFreshnessCtx(context.Background(), "expiring", 10, time.Now(), &models.NewsBase{NewsID: uuid.New()}))
+		mr.FastForward(11 * time.Second)
+
+		cached, _, err := newsRedisRepo.GetNewsWithFreshnessCtx(context.Background(), "expiring")
+		require.True(t, errors.Is(err, redis.Nil))
+		require.Nil(t, cached)
+	})
+}
+
+func TestNewsRedisRepo_LatestPerCategoryCtx(t *testing.T) {
+	t.Parallel()
+
+	newsRedisRepo := SetupRedis()
+
+	latest := map[string]*models.News{
+		"sport": {NewsID: uuid.New(), Title: "Sport"},
+		"tech":  {NewsID: uuid.New(), Title: "Tech"},
+	}
+	require.NoError(t, newsRedisRepo.SetLatestPerCategoryCtx(context.Background(), "latest", 10, latest))
+
+	cached, err := newsRedisRepo.GetLatestPerCategoryCtx(context.Background(), "latest")
+	require.NoError(t, err)
+	require.Len(t, cached, 2)
+	require.Equal(t, latest["sport"].NewsID, cached["sport"].NewsID)
+	require.Equal(t, latest["tech"].NewsID, cached["tech"].NewsID)
+}
+
+func TestNewsRedisRepo_LatestPerAuthorCtx(t *testing.T) {
+	t.Parallel()
+
+	newsRedisRepo := SetupRedis()
+
+	authorUID := uuid.New()
+	latest := map[uuid.UUID]*models.News{authorUID: {NewsID: uuid.New(), AuthorID: authorUID, Title: "Latest"}}
+	require.NoError(t, newsRedisRepo.SetLatestPerAuthorCtx(context.Background(), "latest-author", 10, latest))
+
+	cached, err := newsRedisRepo.GetLatestPerAuthorCtx(context.Background(), "latest-author")
+	require.NoError(t, err)
+	require.Len(t, cached, 1)
+	require.Equal(t, latest[authorUID].NewsID, cached[authorUID].NewsID)
+}
+
+func TestNewsRedisRepo_GetRawCtx(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	newsRedisRepo := NewNewsRedisRepo(client, &config.Config{})
+
+	t.Run("Cached entry with TTL", func(t *testing.T) {
+		n := &models.NewsBase{NewsID: uuid.New(), Title: "Title"}
+		require.NoError(t, newsRedisRepo.SetNewsCtx(context.Background(), "cached", 100, n))
+
+		value, ttl, err := newsRedisRepo.GetRawCtx(context.Background(), "cached")
+		require.NoError(t, err)
+		require.Contains(t, string(value), n.NewsID.String())
+		require.Equal(t, 100*time.Second, ttl)
+	})
+
+	t.Run("Missing entry", func(t *testing.T) {
+		value, ttl, err := newsRedisRepo.GetRawCtx(context.Background(), "missing")
+		require.NoError(t, err)
+		require.Nil(t, value)
+		require.Zero(t, ttl)
+	})
+
+	t.Run("Expired entry", func(t *testing.T) {
+		require.NoError(t, newsRedisRepo.SetNewsCtx(context.Background(), "expiring", 10, &models.NewsBase{NewsID: uuid.New()}))
+		mr.FastForward(11 * time.Second)
+
+		value, _, err := newsRedisRepo.GetRawCtx(context.Background(), "expiring")
+		require.NoError(t, err)
+		require.Nil(t, value)
+	})
+
+	t.Run("Entry without expiry", func(t *testing.T) {
+		require.NoError(t, mr.Set("persistent", `{"news_id":"x"}`))
+
+		value, ttl, err := newsRedisRepo.GetRawCtx(context.Background(), "persistent")
+		require.NoError(t, err)
+		require.Equal(t, `{"news_id":"x"}`, string(value))
+		require.Less(t, int64(ttl), int64(0))
+	})
+}
+
+func TestNewsRedisRepo_EditLock(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	newsRedisRepo := NewNewsRedisRepo(client, &config.Config{})
+	ctx := context.Background()
+
+	t.Run("Acquire", func(t *testing.T) {
+		acquired, err := newsRedisRepo.AcquireEditLockCtx(ctx, "lock:acquire", "user-1", time.Minute)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		holder, ttl, err := newsRedisRepo.GetEditLockCtx(ctx, "lock:acquire")
+		require.NoError(t, err)
+		require.Equal(t, "user-1", holder)
+		require.Equal(t, time.Minute, ttl)
+	})
+
+	t.Run("Contention by other user", func(t *testing.T) {
+		acquired, err := newsRedisRepo.AcquireEditLockCtx(ctx, "lock:contention", "user-1", time.Minute)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		acquired, err = newsRedisRepo.AcquireEditLockCtx(ctx, "lock:contention", "user-2", time.Minute)
+		require.NoError(t, err)
+		require.False(t, acquired)
+
+		released, err := newsRedisRepo.ReleaseEditLockCtx(ctx, "lock:contention", "user-2")
+		require.NoError(t, err)
+		require.False(t, released)
+
+		holder, _, err := newsRedisRepo.GetEditLockCtx(ctx, "lock:contention")
+		require.NoError(t, err)
+		require.Equal(t, "user-1", holder)
+
+		released, err = newsRedisRepo.ReleaseEditLockCtx(ctx, "lock:contention", "user-1")
+		require.NoError(t, err)
+		require.True(t, released)
+
+		holder, _, err = newsRedisRepo.GetEditLockCtx(ctx, "lock:contention")
+		require.NoError(t, err)
+		require.Empty(t, holder)
+	})
+
+	t.Run("Holder renews lock", func(t *testing.T) {
+		acquired, err := newsRedisRepo.AcquireEditLockCtx(ctx, "lock:renew", "user-1", time.Minute)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		acquired, err = newsRedisRepo.AcquireEditLockCtx(ctx, "lock:renew", "user-1", 5*time.Minute)
+		require.NoError(t, err)
+		require.True(t, acquired)
+		require.Equal(t, 5*time.Minute, mr.TTL("lock:renew"))
+	})
+
+	t.Run("Expiry", func(t *testing.T) {
+		acquired, err := newsRedisRepo.AcquireEditLockCtx(ctx, "lock:expiry", "user-1", 10*time.Second)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		mr.FastForward(11 * time.Second)
+
+		holder, _, err := newsRedisRepo.GetEditLockCtx(ctx, "lock:expiry")
+		require.NoError(t, err)
+		require.Empty(t, holder)
+
+		acquired, err = newsRedisRepo.AcquireEditLockCtx(ctx, "lock:expiry", "user-2", 10*time.Second)
+		require.NoError(t, err)
+		require.True(t, acquired)
+	})
+}
+
+func TestNewsRedisRepo_FastCacheCodec(t *testing.T) {
+	t.Parallel()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	stdRepo := NewNewsRedisRepo(client, &config.Config{})
+	fastRepo := NewNewsRedisRepo(client, &config.Config{Redis: config.RedisConfig{FastCacheCodec: true}})
+	n := benchmarkNewsBase()
+
+	t.Run("Fast codec reads news written by std one", func(t *testing.T) {
+		require.NoError(t, stdRepo.SetNewsCtx(context.Background(), "std", 10, n))
+
+		cached, err := fastRepo.GetNewsByIDCtx(context.Background(), "std")
+		require.NoError(t, err)
+		require.Equal(t, n, cached)
+	})
+
+	t.Run("Std codec reads news written by fast one", func(t *testing.T) {
+		require.NoError(t, fastRepo.SetNewsCtx(context.Background(), "fast", 10, n))
+
+		cached, err := stdRepo.GetNewsByIDCtx(context.Background(), "fast")
+		require.NoError(t, err)
+		require.Equal(t, n, cached)
+	})
+
+	t.Run("Same bytes", func(t *testing.T) {
+		stdBytes, err := newCacheCodec(false).Marshal(n)
+		require.NoError(t, err)
+		fastBytes, err := newCacheCodec(true).Marshal(n)
+		require.NoError(t, err)
+		require.JSONEq(t, string(stdBytes), string(fastBytes))
+	})
+}
+
+func BenchmarkNewsRedisRepo_GetNewsByIDCtx(b *testing.B) {
+	mr, err := miniredis.Run()
+	require.NoError(b, err)
+	defer mr.Close()
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	for _, fast := range []bool{false, true} {
+		newsRedisRepo := NewNewsRedisRepo(client, &config.Config{Redis: config.RedisConfig{FastCacheCodec: fast}})
+		require.NoError(b, newsRedisRepo.SetNewsCtx(context.Background(), "key", 60, benchmarkNewsBase()))
+
+		b.Run(fmt.Sprintf("FastCacheCodec=%t", fast), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := newsRedisRepo.GetNewsByIDCtx(context.Background(), "key"); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// Cache codec alone, without redis round trip
+func BenchmarkCacheCodec_Unmarshal(b *testing.B) {
+	data, err := newCacheCodec(false).Marshal(benchmarkNewsBase())
+	require.NoError(b, err)
+
+	for _, fast := range []bool{false, true} {
+		codec := newCacheCodec(fast)
+		b.Run(fmt.Sprintf("FastCacheCodec=%t", fast), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := codec.Unmarshal(data, &models.NewsBase{}); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// News with every cached field set
+func benchmarkNewsBase() *models.NewsBase {
+	imageURL := "https://example.com/image.png"
+	category := "golang"
+	slug := "cache-hit-path"
+	author := "John Doe"
+	avatarURL := "https://example.com/avatar.png"
+	tenantID := uuid.New()
+	return &models.NewsBase{
+		NewsID:             uuid.New(),
+		AuthorID:           uuid.New(),
+		Title:              "Cache hit path of news by id",
+		Content:            strings.Repeat("<p>Cached news content paragraph with some words.</p>", 40),
+		ImageURL:           &imageURL,
+		Category:           &category,
+		Status:             models.NewsStatusPublished,
+		Slug:               &slug,
+		Metadata:           models.Metadata(`{"source":"rss","priority":1}`),
+		Author:             &author,
+		AvatarURL:          &avatarURL,
+		UpdatedAt:          time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC),
+		TenantID:           &tenantID,
+		ReadingTimeMinutes: 2,
+	}
+}
+
+func TestNewsRedisRepo_Views(t *testing.T) {
+	t.Parallel()
+
+	newsRedisRepo := SetupRedis()
+	ctx := context.Background()
+	firstID := uuid.New().String()
+	secondID := uuid.New().String()
+
+	require.NoError(t, newsRedisRepo.IncrViewsCtx(ctx, "views", firstID, 1))
+	require.NoError(t, newsRedisRepo.IncrViewsCtx(ctx, "views", firstID, 2))
+	require.NoError(t, newsRedisRepo.IncrViewsCtx(ctx, "views", secondID, 1))
+
+	views, err := newsRedisRepo.PopViewsCtx(ctx, "views")
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{firstID: 3, secondID: 1}, views)
+
+	views, err = newsRedisRepo.PopViewsCtx(ctx, "views")
+	require.NoError(t, err)
+	require.Empty(t, views)
+}
@@ -0,0 +1,64 @@
+//go:build integration
+// +build integration
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/jackc/pgx/stdlib" // pgx driver
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/config"
+	"github.com/AleksK1NG/api-mc/internal/models"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+	"github.com/AleksK1NG/api-mc/pkg/utils"
+)
+
+// Runs against migrated database given by POSTGRES_TEST_DSN, see make test_integration
+func TestNewsRepo_SearchByTitle_TitleOutranksContent(t *testing.T) {
+	dsn := os.Getenv("POSTGRES_TEST_DSN")
+	if dsn == "" {
+		t.Skip("POSTGRES_TEST_DSN is not set")
+	}
+
+	db, err := sqlx.Connect("pgx", dsn)
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.Background()
+	term := fmt.Sprintf("rankterm%d", time.Now().UnixNano())
+
+	var authorID uuid.UUID
+	require.NoError(t, db.GetContext(ctx, &authorID,
+		`INSERT INTO users (first_name, last_name, email, password) VALUES ('Rank', 'Tester', $1, 'password') RETURNING user_id`,
+		term+"@example.com"))
+	defer func() {
+		_, err := db.ExecContext(ctx, `DELETE FROM news WHERE author_id = $1`, authorID)
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, `DELETE FROM users WHERE user_id = $1`, authorID)
+		require.NoError(t, err)
+	}()
+
+	insertNews := `INSERT INTO news (author_id, title, content, status, created_at, updated_at)
+					VALUES ($1, $2, $3, 'published', $4, $4) RETURNING news_id`
+	// Body only match is newer, so it would come first if ranking ignored weights
+	var titleMatchID, bodyMatchID uuid.UUID
+	require.NoError(t, db.GetContext(ctx, &titleMatchID, insertNews,
+		authorID, term+" released", "Release notes of the new version", time.Now().Add(-time.Hour)))
+	require.NoError(t, db.GetContext(ctx, &bodyMatchID, insertNews,
+		authorID, "Weekly digest", "This week also brought "+term+" and more", time.Now()))
+
+	newsRepo := NewNewsRepository(db, &config.Config{}, logger.NewApiLogger(nil))
+	newsList, err := newsRepo.SearchByTitle(ctx, term, models.SearchScopeAll, &utils.PaginationQuery{Size: 10, Page: 1})
+	require.NoError(t, err)
+	require.Len(t, newsList.News, 2)
+	require.Equal(t, titleMatchID, newsList.News[0].NewsID)
+	require.Equal(t, bodyMatchID, newsList.News[1].NewsID)
+}
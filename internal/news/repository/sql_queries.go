@@ -1,17 +1,28 @@
 package repository
 
 const (
-	createNews = `INSERT INTO news (author_id, title, content, image_url, category, created_at) 
-					VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($4, ''), now()) 
+	createNews = `INSERT INTO news (author_id, title, content, image_url, category, status, slug, content_hash, word_count, tenant_id, created_at, updated_at) 
+					VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($4, ''), COALESCE(NULLIF($5, ''), 'published'), NULLIF($6, ''), $7, $8, $9, now(), now()) 
 					RETURNING *`
 
+	createNewsIfNotExists = `INSERT INTO news (author_id, title, content, image_url, category, status, slug, content_hash, word_count, tenant_id, created_at, updated_at) 
+					VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($4, ''), COALESCE(NULLIF($5, ''), 'published'), $6, $7, $8, $9, now(), now()) 
+					ON CONFLICT ((COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::uuid)), slug) DO NOTHING
+					RETURNING *`
+
+	getNewsBySlug = `SELECT * FROM news WHERE slug = $1 AND tenant_id IS NOT DISTINCT FROM $2 AND deleted_at IS NULL`
+
+	getNewsByContentHash = `SELECT * FROM news WHERE content_hash = $1 AND tenant_id IS NOT DISTINCT FROM $2 AND deleted_at IS NULL`
+
 	updateNews = `UPDATE news 
 					SET title = COALESCE(NULLIF($1, ''), title),
 						content = COALESCE(NULLIF($2, ''), content), 
 					    image_url = COALESCE(NULLIF($3, ''), image_url), 
 					    category = COALESCE(NULLIF($4, ''), category), 
+					    content_hash = COALESCE($6, content_hash),
+					    word_count = COALESCE($7, word_count),
 					    updated_at = now() 
-					WHERE news_id = $5
+					WHERE news_id = $5 AND deleted_at IS NULL
 					RETURNING *`
 
 	getNewsByID = `SELECT n.news_id,
@@ -20,27 +31,381 @@ const (
        n.updated_at,
        n.image_url,
        n.category,
-       CONCAT(u.first_name, ' ', u.last_name) as author,
-       u.user_id as author_id
+       n.status,
+       n.slug,
+       n.metadata,
+       CASE WHEN u.user_id IS NULL THEN NULL ELSE CONCAT(u.first_name, ' ', u.last_name) END as author,
+       u.avatar as avatar_url,
+       n.author_id,
+       n.tenant_id
+FROM news n
+         LEFT JOIN users u on u.user_id = n.author_id
+WHERE news_id = $1 AND n.deleted_at IS NULL`
+
+	getUnknownCategories = `SELECT c.category
+FROM unnest($1::text[]) AS c(category)
+WHERE NOT EXISTS(SELECT 1 FROM news n WHERE n.category = c.category AND n.deleted_at IS NULL AND ($2::uuid IS NULL OR n.tenant_id = $2))`
+
+	getNewsBySlugs = `SELECT n.news_id,
+       n.title,
+       n.content,
+       n.updated_at,
+       n.image_url,
+       n.category,
+       n.status,
+       n.slug,
+       n.metadata,
+       CASE WHEN u.user_id IS NULL THEN NULL ELSE CONCAT(u.first_name, ' ', u.last_name) END as author,
+       u.avatar as avatar_url,
+       n.author_id,
+       n.tenant_id
 FROM news n
          LEFT JOIN users u on u.user_id = n.author_id
-WHERE news_id = $1`
+WHERE n.slug = ANY($1::text[]) AND n.tenant_id IS NOT DISTINCT FROM $2 AND n.status = 'published' AND n.deleted_at IS NULL`
+
+	deleteNews = `UPDATE news SET deleted_at = now(), updated_at = now(), slug = NULL WHERE news_id = $1 AND deleted_at IS NULL`
 
-	deleteNews = `DELETE FROM news WHERE news_id = $1`
+	getTotalCount = `SELECT COUNT(news_id) FROM news WHERE status = 'published' AND deleted_at IS NULL AND ($1::uuid IS NULL OR tenant_id = $1)`
 
-	getTotalCount = `SELECT COUNT(news_id) FROM news`
+	getNewsCount = `SELECT COUNT(news_id) FROM news WHERE %s`
 
-	getNews = `SELECT news_id, author_id, title, content, image_url, category, updated_at, created_at 
+	// Row estimate kept by autovacuum and ANALYZE, includes drafts and deleted news, -1 when table was never analyzed
+	getEstimatedNewsCount = `SELECT GREATEST(reltuples, 0)::bigint FROM pg_class WHERE oid = 'news'::regclass`
+
+	getNews = `SELECT news_id, author_id, title, content, image_url, category, status, metadata, updated_at, created_at 
 				FROM news 
-				ORDER BY created_at, updated_at OFFSET $1 LIMIT $2`
+				WHERE %s
+				ORDER BY %s OFFSET $%d LIMIT $%d`
+
+	getNewsIDs = `SELECT news_id FROM news WHERE %s ORDER BY %s OFFSET $%d LIMIT $%d`
+
+	newsListBaseCondition = `status = 'published' AND deleted_at IS NULL`
 
 	findByTitleCount = `SELECT COUNT(*)
 					FROM news
-					WHERE title ILIKE '%' || $1 || '%'`
+					WHERE status = 'published' AND deleted_at IS NULL AND ($2::uuid IS NULL OR tenant_id = $2) AND title ILIKE '%' || $1 || '%'`
+
+	findByTitle = `SELECT news_id, author_id, title, content, image_url, category, status, updated_at, created_at
+					FROM news
+					WHERE status = 'published' AND deleted_at IS NULL AND ($4::uuid IS NULL OR tenant_id = $4) AND title ILIKE '%' || $1 || '%'
+					ORDER BY title, created_at, updated_at, news_id
+					OFFSET $2 LIMIT $3`
+
+	findByContentCount = `SELECT COUNT(*)
+					FROM news
+					WHERE status = 'published' AND deleted_at IS NULL AND ($2::uuid IS NULL OR tenant_id = $2)
+					AND to_tsvector('simple', content) @@ plainto_tsquery('simple', $1)`
+
+	findByContent = `SELECT news_id, author_id, title, content, image_url, category, status, updated_at, created_at
+					FROM news
+					WHERE status = 'published' AND deleted_at IS NULL AND ($4::uuid IS NULL OR tenant_id = $4)
+					AND to_tsvector('simple', content) @@ plainto_tsquery('simple', $1)
+					ORDER BY ts_rank(to_tsvector('simple', content), plainto_tsquery('simple', $1)) DESC, created_at DESC, news_id DESC
+					OFFSET $2 LIMIT $3`
+
+	// Title weighs more than content, expression must match news_text_vector_idx
+	findByTextCount = `SELECT COUNT(*)
+					FROM news
+					WHERE status = 'published' AND deleted_at IS NULL AND ($2::uuid IS NULL OR tenant_id = $2)
+					AND (setweight(to_tsvector('simple', title), 'A') || setweight(to_tsvector('simple', content), 'B')) @@ plainto_tsquery('simple', $1)`
+
+	findByText = `SELECT news_id, author_id, title, content, image_url, category, status, updated_at, created_at
+					FROM news
+					WHERE status = 'published' AND deleted_at IS NULL AND ($4::uuid IS NULL OR tenant_id = $4)
+					AND (setweight(to_tsvector('simple', title), 'A') || setweight(to_tsvector('simple', content), 'B')) @@ plainto_tsquery('simple', $1)
+					ORDER BY ts_rank(setweight(to_tsvector('simple', title), 'A') || setweight(to_tsvector('simple', content), 'B'), plainto_tsquery('simple', $1)) DESC,
+					         created_at DESC, news_id DESC
+					OFFSET $2 LIMIT $3`
+
+	getTimeline = `SELECT date_trunc('month', created_at) AS month, COUNT(news_id) AS count
+					FROM news
+					WHERE status = 'published' AND deleted_at IS NULL AND ($1::uuid IS NULL OR tenant_id = $1)
+					GROUP BY month
+					ORDER BY month`
+
+	getNewsCreatedAt = `SELECT created_at FROM news WHERE news_id = $1 AND deleted_at IS NULL AND ($2::uuid IS NULL OR tenant_id = $2)`
+
+	getPrevNews = `SELECT news_id, author_id, title, content, image_url, category, status, updated_at, created_at
+					FROM news
+					WHERE status = 'published' AND deleted_at IS NULL AND ($3::uuid IS NULL OR tenant_id = $3) AND (created_at, news_id) < ($1, $2)
+					ORDER BY created_at DESC, news_id DESC
+					LIMIT 1`
+
+	getNextNews = `SELECT news_id, author_id, title, content, image_url, category, status, updated_at, created_at
+					FROM news
+					WHERE status = 'published' AND deleted_at IS NULL AND ($3::uuid IS NULL OR tenant_id = $3) AND (created_at, news_id) > ($1, $2)
+					ORDER BY created_at, news_id
+					LIMIT 1`
+
+	getNewsStatusesForUpdate = `SELECT news_id, author_id, title, status FROM news WHERE news_id = ANY($1::uuid[]) AND deleted_at IS NULL AND ($2::uuid IS NULL OR tenant_id = $2) FOR UPDATE`
+
+	updateNewsStatusBatch = `UPDATE news SET status = $1, updated_at = now() WHERE news_id = ANY($2::uuid[]) AND ($3::uuid IS NULL OR tenant_id = $3)`
+
+	getDraftsCountByAuthor = `SELECT COUNT(news_id) FROM news WHERE status = 'draft' AND author_id = $1 AND deleted_at IS NULL`
+
+	getAuthorNews = `SELECT news_id, author_id, title, content, image_url, category, status, updated_at, created_at
+					FROM news
+					WHERE author_id = $1 AND deleted_at IS NULL
+					ORDER BY created_at, news_id`
 
-	findByTitle = `SELECT news_id, author_id, title, content, image_url, category, updated_at, created_at
+	getDraftsByAuthor = `SELECT news_id, author_id, title, content, image_url, category, status, updated_at, created_at
 					FROM news
-					WHERE title ILIKE '%' || $1 || '%'
-					ORDER BY title, created_at, updated_at
+					WHERE status = 'draft' AND author_id = $1 AND deleted_at IS NULL
+					ORDER BY updated_at DESC, news_id DESC
 					OFFSET $2 LIMIT $3`
+
+	getEditedByCount = `SELECT COUNT(DISTINCT e.news_id)
+					FROM news_events e
+					JOIN news n ON n.news_id = e.news_id
+					WHERE e.actor_id = $1 AND e.action = 'update' AND n.deleted_at IS NULL AND ($2::uuid IS NULL OR n.tenant_id = $2)`
+
+	getEditedBy = `SELECT n.news_id, n.author_id, n.title, n.content, n.image_url, n.category, n.status, n.updated_at, n.created_at
+					FROM news n
+					JOIN (SELECT news_id, MAX(created_at) AS edited_at
+						FROM news_events
+						WHERE actor_id = $1 AND action = 'update'
+						GROUP BY news_id) e ON e.news_id = n.news_id
+					WHERE n.deleted_at IS NULL AND ($4::uuid IS NULL OR n.tenant_id = $4)
+					ORDER BY e.edited_at DESC, n.news_id DESC
+					OFFSET $2 LIMIT $3`
+
+	getOrphanedCount = `SELECT COUNT(n.news_id)
+					FROM news n
+					WHERE n.deleted_at IS NULL AND ($1::uuid IS NULL OR n.tenant_id = $1) AND NOT EXISTS (SELECT 1 FROM users u WHERE u.user_id = n.author_id)`
+
+	getOrphaned = `SELECT n.news_id, n.author_id, n.title, n.content, n.image_url, n.category, n.status, n.updated_at, n.created_at
+					FROM news n
+					WHERE n.deleted_at IS NULL AND ($3::uuid IS NULL OR n.tenant_id = $3) AND NOT EXISTS (SELECT 1 FROM users u WHERE u.user_id = n.author_id)
+					ORDER BY n.created_at, n.news_id
+					OFFSET $1 LIMIT $2`
+
+	reassignOrphanedAuthor = `UPDATE news n SET author_id = $1, updated_at = now()
+					WHERE n.news_id = ANY($2::uuid[]) AND n.deleted_at IS NULL AND ($3::uuid IS NULL OR n.tenant_id = $3)
+					  AND NOT EXISTS (SELECT 1 FROM users u WHERE u.user_id = n.author_id)
+					RETURNING n.news_id`
+
+	getOrphanedByIDs = `SELECT n.news_id
+					FROM news n
+					WHERE n.news_id = ANY($1::uuid[]) AND n.deleted_at IS NULL AND ($2::uuid IS NULL OR n.tenant_id = $2)
+					  AND NOT EXISTS (SELECT 1 FROM users u WHERE u.user_id = n.author_id)`
+
+	getNewsOutsideTenant = `SELECT news_id FROM news WHERE news_id = ANY($1::uuid[]) AND tenant_id IS DISTINCT FROM $2 AND deleted_at IS NULL ORDER BY news_id`
+
+	getRecentNews = `SELECT news_id, author_id, title, content, image_url, category, status, updated_at, created_at
+					FROM news
+					WHERE status = 'published' AND deleted_at IS NULL AND ($3::uuid IS NULL OR tenant_id = $3) AND ($1 = '' OR category = $1)
+					ORDER BY created_at DESC, news_id DESC
+					LIMIT $2`
+
+	getRecentlyUpdatedNews = `SELECT news_id, author_id, title, content, image_url, category, status, updated_at, created_at
+					FROM news
+					WHERE status = 'published' AND deleted_at IS NULL AND ($3::uuid IS NULL OR tenant_id = $3)
+					ORDER BY updated_at DESC, news_id DESC
+					OFFSET $1 LIMIT $2`
+
+	getTotalWithoutComments = `SELECT COUNT(n.news_id) FROM news n
+					WHERE n.status = 'published' AND n.deleted_at IS NULL AND ($1::uuid IS NULL OR n.tenant_id = $1)
+					AND NOT EXISTS (SELECT 1 FROM comments c WHERE c.news_id = n.news_id)`
+
+	getNewsWithoutComments = `SELECT n.news_id, n.author_id, n.title, n.content, n.image_url, n.category, n.status, n.updated_at, n.created_at
+					FROM news n
+					WHERE n.status = 'published' AND n.deleted_at IS NULL AND ($3::uuid IS NULL OR n.tenant_id = $3)
+					AND NOT EXISTS (SELECT 1 FROM comments c WHERE c.news_id = n.news_id)
+					ORDER BY n.created_at DESC, n.news_id DESC
+					OFFSET $1 LIMIT $2`
+
+	findSimilarTitles = `SELECT news_id, title
+					FROM news
+					WHERE deleted_at IS NULL AND (status = 'published' OR author_id = $4) AND tenant_id IS NOT DISTINCT FROM $5
+					AND similarity(title, $1) >= $2
+					ORDER BY similarity(title, $1) DESC, created_at DESC, news_id DESC
+					LIMIT $3`
+
+	pinNews = `UPDATE news SET pinned_at = now(), pinned_until = $2 WHERE news_id = $1 AND deleted_at IS NULL`
+
+	unpinNews = `UPDATE news SET pinned_at = NULL, pinned_until = NULL WHERE news_id = $1 AND deleted_at IS NULL`
+
+	getFeaturedNews = `SELECT news_id, author_id, title, content, image_url, category, status, updated_at, created_at, pinned_at, pinned_until
+					FROM news
+					WHERE status = 'published' AND deleted_at IS NULL AND ($2::uuid IS NULL OR tenant_id = $2) AND pinned_at IS NOT NULL AND (pinned_until IS NULL OR pinned_until > now())
+					ORDER BY pinned_at DESC, news_id DESC
+					LIMIT $1`
+
+	getNewsForUpdate = `SELECT news_id, author_id, title, content, image_url, category, status, updated_at, created_at
+					FROM news
+					WHERE news_id = $1 AND deleted_at IS NULL
+					FOR UPDATE`
+
+	createNewsRevision = `INSERT INTO news_revisions (news_id, actor_id, title, content, image_url, category, status, changed_fields, created_at)
+					VALUES ($1, $2, $3, $4, $5, $6, $7, $8, now())`
+
+	createNewsEvent = `INSERT INTO news_events (news_id, actor_id, action, title, created_at)
+					SELECT news_id, $2, $3, title, now() FROM news WHERE news_id = $1`
+
+	getActivityCount = `SELECT COUNT(event_id) FROM news_activity WHERE ($1 = '' OR action = $1) AND ($2::uuid IS NULL OR tenant_id = $2)`
+
+	getActivityFeed = `SELECT event_id, action, news_id, title, actor_id, actor, created_at
+					FROM news_activity
+					WHERE ($1 = '' OR action = $1) AND ($4::uuid IS NULL OR tenant_id = $4)
+					ORDER BY created_at DESC, event_id DESC
+					OFFSET $2 LIMIT $3`
+
+	getNewsRevisions = `SELECT r.revision_id, r.news_id, r.actor_id, CONCAT(u.first_name, ' ', u.last_name) AS actor, r.changed_fields, r.created_at
+					FROM news_revisions r
+					         LEFT JOIN users u ON u.user_id = r.actor_id
+					WHERE r.news_id = $1
+					ORDER BY r.created_at, r.revision_id`
+
+	getNewsRevisionByID = `SELECT r.revision_id, r.news_id, r.actor_id, CONCAT(u.first_name, ' ', u.last_name) AS actor, r.changed_fields, r.created_at,
+					       r.title, r.content, r.image_url, r.category, r.status
+					FROM news_revisions r
+					         LEFT JOIN users u ON u.user_id = r.actor_id
+					WHERE r.revision_id = $1`
+
+	revertNews = `UPDATE news
+					SET title = $1,
+						content = $2,
+						image_url = $3,
+						category = $4,
+						content_hash = $6,
+						word_count = $7,
+						updated_at = now()
+					WHERE news_id = $5 AND deleted_at IS NULL
+					RETURNING *`
+
+	getAuthorLeaderboard = `SELECT u.user_id AS author_id,
+					       CONCAT(u.first_name, ' ', u.last_name) AS author,
+					       u.avatar AS avatar_url,
+					       COUNT(n.news_id) AS published_count
+					FROM news n
+					         JOIN users u ON u.user_id = n.author_id
+					WHERE n.status = 'published' AND n.deleted_at IS NULL AND n.created_at >= $1 AND ($3::uuid IS NULL OR n.tenant_id = $3)
+					GROUP BY u.user_id, u.first_name, u.last_name, u.avatar
+					ORDER BY published_count DESC, author, author_id
+					LIMIT $2`
+
+	getCountByStatus = `SELECT s.status, COUNT(n.news_id) AS count
+					FROM unnest($1::text[]) AS s(status)
+					         LEFT JOIN news n ON n.status = s.status AND n.deleted_at IS NULL AND ($2::uuid IS NULL OR n.tenant_id = $2)
+					GROUP BY s.status`
+
+	getAuthorStatusCounts = `SELECT s.status, COUNT(n.news_id) AS count
+					FROM unnest($1::text[]) AS s(status)
+					         LEFT JOIN news n ON n.status = s.status AND n.author_id = $2 AND n.deleted_at IS NULL AND ($3::uuid IS NULL OR n.tenant_id = $3)
+					GROUP BY s.status`
+
+	deleteNewsTags = `DELETE FROM news_tags WHERE news_id = $1`
+
+	createTags = `INSERT INTO tags (name) SELECT unnest($1::text[]) ON CONFLICT (name) DO NOTHING`
+
+	addNewsTags = `INSERT INTO news_tags (news_id, tag_id)
+					SELECT $1, tag_id FROM tags WHERE name = ANY($2::text[])
+					ON CONFLICT DO NOTHING`
+
+	setNewsMetadata = `UPDATE news SET metadata = $2, updated_at = now() WHERE news_id = $1 AND deleted_at IS NULL`
+
+	getNewsMetadata = `SELECT metadata FROM news WHERE news_id = $1 AND deleted_at IS NULL`
+
+	addTagToMany = `INSERT INTO news_tags (news_id, tag_id)
+					SELECT n.news_id, t.tag_id FROM news n JOIN tags t ON t.name = $2
+					WHERE n.news_id = ANY($1::uuid[]) AND n.deleted_at IS NULL AND ($3::uuid IS NULL OR n.tenant_id = $3)
+					ON CONFLICT DO NOTHING`
+
+	getNewsTags = `SELECT t.name FROM news_tags nt JOIN tags t ON t.tag_id = nt.tag_id WHERE nt.news_id = $1 ORDER BY t.name`
+
+	getChangedSinceCount = `SELECT COUNT(news_id) FROM news WHERE updated_at > $1 AND ($2::uuid IS NULL OR tenant_id = $2)`
+
+	getChangedSince = `SELECT news_id, author_id, title, content, image_url, category, status, updated_at, created_at, deleted_at
+					FROM news
+					WHERE updated_at > $1 AND ($4::uuid IS NULL OR tenant_id = $4)
+					ORDER BY updated_at, news_id
+					OFFSET $2 LIMIT $3`
+
+	getLatestPerCategory = `SELECT DISTINCT ON (category) news_id, author_id, title, content, image_url, category, status, updated_at, created_at
+					FROM news
+					WHERE status = 'published' AND deleted_at IS NULL AND ($2::uuid IS NULL OR tenant_id = $2) AND category = ANY($1::text[])
+					ORDER BY category, created_at DESC, news_id DESC`
+
+	getLatestUpdatePerAuthor = `SELECT DISTINCT ON (author_id) news_id, author_id, title, content, image_url, category, status, updated_at, created_at
+					FROM news
+					WHERE status = 'published' AND deleted_at IS NULL AND ($2::uuid IS NULL OR tenant_id = $2) AND author_id = ANY($1::uuid[])
+					ORDER BY author_id, updated_at DESC, news_id DESC`
+
+	addNewsRelation = `INSERT INTO news_relations (from_news_id, to_news_id)
+					SELECT f.news_id, t.news_id
+					FROM news f
+					         JOIN news t ON t.news_id = $2 AND t.tenant_id IS NOT DISTINCT FROM f.tenant_id
+					WHERE f.news_id = $1 AND ($3::uuid IS NULL OR f.tenant_id = $3)
+					ON CONFLICT DO NOTHING`
+
+	removeNewsRelation = `DELETE FROM news_relations r
+					USING news n
+					WHERE n.news_id = r.from_news_id AND r.from_news_id = $1 AND r.to_news_id = $2 AND ($3::uuid IS NULL OR n.tenant_id = $3)`
+
+	getCuratedRelated = `SELECT n.news_id, n.author_id, n.title, n.content, n.image_url, n.category, n.status, n.updated_at, n.created_at
+					FROM news_relations r
+					JOIN news n ON n.news_id = r.to_news_id
+					WHERE r.from_news_id = $1 AND n.status = 'published' AND n.deleted_at IS NULL AND ($2::uuid IS NULL OR n.tenant_id = $2)
+					ORDER BY r.created_at, n.news_id`
+
+	addNewsViews = `UPDATE news n SET views = n.views + v.views
+					FROM unnest($1::uuid[], $2::bigint[]) AS v(news_id, views)
+					WHERE n.news_id = v.news_id AND n.deleted_at IS NULL`
+
+	getTrendingNews = `SELECT news_id, author_id, title, content, image_url, category, status, views, updated_at, created_at
+					FROM news
+					WHERE status = 'published' AND deleted_at IS NULL AND ($3::uuid IS NULL OR tenant_id = $3)
+					ORDER BY views / power(GREATEST(EXTRACT(EPOCH FROM now() - created_at) / 3600, 0) + 2, $1) DESC,
+					         created_at DESC, news_id DESC
+					LIMIT $2`
+
+	getNewsImages = `SELECT news_id, image_url
+					FROM news
+					WHERE image_url IS NOT NULL AND image_url <> '' AND deleted_at IS NULL AND news_id > $1
+					ORDER BY news_id
+					LIMIT $2`
+
+	setImageBroken = `UPDATE news SET image_broken = (news_id = ANY($2::uuid[])) WHERE news_id = ANY($1::uuid[])`
+
+	getBrokenImagesCount = `SELECT COUNT(news_id) FROM news WHERE image_broken AND deleted_at IS NULL AND ($1::uuid IS NULL OR tenant_id = $1)`
+
+	getBrokenImages = `SELECT news_id, author_id, title, content, image_url, category, status, image_broken, updated_at, created_at
+					FROM news
+					WHERE image_broken AND deleted_at IS NULL AND ($3::uuid IS NULL OR tenant_id = $3)
+					ORDER BY created_at, news_id
+					OFFSET $1 LIMIT $2`
+
+	getNewsIDsAfter = `SELECT news_id FROM news WHERE news_id > $1 AND ($3::uuid IS NULL OR tenant_id = $3) ORDER BY news_id LIMIT $2`
+
+	setNewsTranslation = `INSERT INTO news_translations (news_id, locale, title, content, created_at, updated_at)
+					VALUES ($1, $2, $3, $4, now(), now())
+					ON CONFLICT (news_id, locale) DO UPDATE SET title = EXCLUDED.title, content = EXCLUDED.content, updated_at = now()
+					RETURNING news_id, locale, title, content, updated_at`
+
+	getNewsTranslation = `SELECT news_id, locale, title, content, updated_at FROM news_translations WHERE news_id = $1 AND locale = $2`
+
+	saveReadingPosition = `INSERT INTO reading_positions (user_id, news_id, position, updated_at)
+					VALUES ($1, $2, $3, now())
+					ON CONFLICT (user_id, news_id) DO UPDATE SET position = EXCLUDED.position, updated_at = now()
+					RETURNING user_id, news_id, position, updated_at`
+
+	getReadingPosition = `SELECT user_id, news_id, position, updated_at FROM reading_positions WHERE user_id = $1 AND news_id = $2`
+
+	getCategoryNewsAfter = `SELECT * FROM news WHERE category = $1 AND deleted_at IS NULL AND news_id > $2 AND ($4::uuid IS NULL OR tenant_id = $4) ORDER BY news_id LIMIT $3`
+
+	fixCommentCounts = `UPDATE news n
+					SET comment_count = c.actual
+					FROM (SELECT b.news_id, COUNT(cm.comment_id) AS actual
+					      FROM unnest($1::uuid[]) AS b(news_id)
+					               LEFT JOIN comments cm ON cm.news_id = b.news_id
+					      GROUP BY b.news_id) c
+					WHERE n.news_id = c.news_id AND n.comment_count <> c.actual AND ($2::uuid IS NULL OR n.tenant_id = $2)`
+
+	purgeDeletedNews = `DELETE FROM news
+					WHERE news_id IN (SELECT news_id
+					                  FROM news
+					                  WHERE deleted_at < $1 AND ($3::uuid IS NULL OR tenant_id = $3)
+					                  ORDER BY deleted_at, news_id
+					                  LIMIT $2 FOR UPDATE SKIP LOCKED)
+					RETURNING news_id`
 )
@@ -0,0 +1,289 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/AleksK1NG/api-mc/internal/models"
+	"github.com/AleksK1NG/api-mc/internal/news"
+	"github.com/AleksK1NG/api-mc/pkg/utils"
+)
+
+// News cache which can be turned off at runtime, when off every lookup is a miss and writes are dropped.
+// Deletes always pass through, so entries invalidated while off are not served stale once cache is back on
+type newsSwitchCacheRepo struct {
+	redisRepo   news.RedisRepository
+	cacheSwitch *utils.CacheSwitch
+}
+
+// News switchable cache repository constructor, wraps redis repository
+func NewNewsSwitchCacheRepo(redisRepo news.RedisRepository, cacheSwitch *utils.CacheSwitch) news.RedisRepository {
+	return &newsSwitchCacheRepo{redisRepo: redisRepo, cacheSwitch: cacheSwitch}
+}
+
+func (n *newsSwitchCacheRepo) GetNewsByIDCtx(ctx context.Context, key string) (*models.NewsBase, error) {
+	if !n.cacheSwitch.Enabled() {
+		return nil, nil
+	}
+	return n.redisRepo.GetNewsByIDCtx(ctx, key)
+}
+
+func (n *newsSwitchCacheRepo) SetNewsCtx(ctx context.Context, key string, seconds int, news *models.NewsBase) error {
+	if !n.cacheSwitch.Enabled() {
+		return nil
+	}
+	return n.redisRepo.SetNewsCtx(ctx, key, seconds, news)
+}
+
+func (n *newsSwitchCacheRepo) DeleteNewsCtx(ctx context.Context, key string) error {
+	return n.redisRepo.DeleteNewsCtx(ctx, key)
+}
+
+func (n *newsSwitchCacheRepo) GetNewsByKeysCtx(ctx context.Context, keys []string) ([]*models.NewsBase, error) {
+	if !n.cacheSwitch.Enabled() {
+		return make([]*models.NewsBase, len(keys)), nil
+	}
+	return n.redisRepo.GetNewsByKeysCtx(ctx, keys)
+}
+
+func (n *newsSwitchCacheRepo) GetNewsIDsCtx(ctx context.Context, keys []string) ([]string, error) {
+	if !n.cacheSwitch.Enabled() {
+		return make([]string, len(keys)), nil
+	}
+	return n.redisRepo.GetNewsIDsCtx(ctx, keys)
+}
+
+func (n *newsSwitchCacheRepo) SetNewsIDCtx(ctx context.Context, key string, seconds int, newsID string) error {
+	if !n.cacheSwitch.Enabled() {
+		return nil
+	}
+	return n.redisRepo.SetNewsIDCtx(ctx, key, seconds, newsID)
+}
+
+func (n *newsSwitchCacheRepo) GetRawCtx(ctx context.Context, key string) ([]byte, time.Duration, error) {
+	if !n.cacheSwitch.Enabled() {
+		return nil, 0, nil
+	}
+	return n.redisRepo.GetRawCtx(ctx, key)
+}
+
+func (n *newsSwitchCacheRepo) GetNewsWithFreshnessCtx(ctx context.Context, key string) (*models.NewsBase, time.Time, error) {
+	if !n.cacheSwitch.Enabled() {
+		return nil, time.Time{}, nil
+	}
+	return n.redisRepo.GetNewsWithFreshnessCtx(ctx, key)
+}
+
+func (n *newsSwitchCacheRepo) SetNewsWithFreshnessCtx(ctx context.Context, key string, seconds int, freshUntil time.Time, news *models.NewsBase) error {
+	if !n.cacheSwitch.Enabled() {
+		return nil
+	}
+	return n.redisRepo.SetNewsWithFreshnessCtx(ctx, key, seconds, freshUntil, news)
+}
+
+func (n *newsSwitchCacheRepo) GetLatestPerCategoryCtx(ctx context.Context, key string) (map[string]*models.News, error) {
+	if !n.cacheSwitch.Enabled() {
+		return nil, nil
+	}
+	return n.redisRepo.GetLatestPerCategoryCtx(ctx, key)
+}
+
+func (n *newsSwitchCacheRepo) SetLatestPerCategoryCtx(ctx context.Context, key string, seconds int, latest map[string]*models.News) error {
+	if !n.cacheSwitch.Enabled() {
+		return nil
+	}
+	return n.redisRepo.SetLatestPerCategoryCtx(ctx, key, seconds, latest)
+}
+
+func (n *newsSwitchCacheRepo) GetLatestPerAuthorCtx(ctx context.Context, key string) (map[uuid.UUID]*models.News, error) {
+	if !n.cacheSwitch.Enabled() {
+		return nil, nil
+	}
+	return n.redisRepo.GetLatestPerAuthorCtx(ctx, key)
+}
+
+func (n *newsSwitchCacheRepo) SetLatestPerAuthorCtx(ctx context.Context, key string, seconds int, latest map[uuid.UUID]*models.News) error {
+	if !n.cacheSwitch.Enabled() {
+		return nil
+	}
+	return n.redisRepo.SetLatestPerAuthorCtx(ctx, key, seconds, latest)
+}
+
+func (n *newsSwitchCacheRepo) GetRelatedCtx(ctx context.Context, key string) ([]*models.News, error) {
+	if !n.cacheSwitch.Enabled() {
+		return nil, nil
+	}
+	return n.redisRepo.GetRelatedCtx(ctx, key)
+}
+
+func (n *newsSwitchCacheRepo) SetRelatedCtx(ctx context.Context, key string, seconds int, related []*models.News) error {
+	if !n.cacheSwitch.Enabled() {
+		return nil
+	}
+	return n.redisRepo.SetRelatedCtx(ctx, key, seconds, related)
+}
+
+// Edit locks are not cache, they work while cache is disabled too
+func (n *newsSwitchCacheRepo) AcquireEditLockCtx(ctx context.Context, key string, userID string, ttl time.Duration) (bool, error) {
+	return n.redisRepo.AcquireEditLockCtx(ctx, key, userID, ttl)
+}
+
+func (n *newsSwitchCacheRepo) ReleaseEditLockCtx(ctx context.Context, key string, userID string) (bool, error) {
+	return n.redisRepo.ReleaseEditLockCtx(ctx, key, userID)
+}
+
+func (n *newsSwitchCacheRepo) GetEditLockCtx(ctx context.Context, key string) (string, time.Duration, error) {
+	return n.redisRepo.GetEditLockCtx(ctx, key)
+}
+
+func (n *newsSwitchCacheRepo) GetTrendingCtx(ctx context.Context, key string) ([]*models.News, error) {
+	if !n.cacheSwitch.Enabled() {
+		return nil, nil
+	}
+	return n.redisRepo.GetTrendingCtx(ctx, key)
+}
+
+func (n *newsSwitchCacheRepo) SetTrendingCtx(ctx context.Context, key string, seconds int, trending []*models.News) error {
+	if !n.cacheSwitch.Enabled() {
+		return nil
+	}
+	return n.redisRepo.SetTrendingCtx(ctx, key, seconds, trending)
+}
+
+func (n *newsSwitchCacheRepo) GetActivityFeedCtx(ctx context.Context, key string) (*models.NewsEventList, error) {
+	if !n.cacheSwitch.Enabled() {
+		return nil, nil
+	}
+	return n.redisRepo.GetActivityFeedCtx(ctx, key)
+}
+
+func (n *newsSwitchCacheRepo) SetActivityFeedCtx(ctx context.Context, key string, seconds int, feed *models.NewsEventList) error {
+	if !n.cacheSwitch.Enabled() {
+		return nil
+	}
+	return n.redisRepo.SetActivityFeedCtx(ctx, key, seconds, feed)
+}
+
+func (n *newsSwitchCacheRepo) GetNewsListCtx(ctx context.Context, key string) (*models.NewsList, error) {
+	if !n.cacheSwitch.Enabled() {
+		return nil, nil
+	}
+	return n.redisRepo.GetNewsListCtx(ctx, key)
+}
+
+func (n *newsSwitchCacheRepo) SetNewsListCtx(ctx context.Context, key string, seconds int, newsList *models.NewsList) error {
+	if !n.cacheSwitch.Enabled() {
+		return nil
+	}
+	return n.redisRepo.SetNewsListCtx(ctx, key, seconds, newsList)
+}
+
+func (n *newsSwitchCacheRepo) GetTimelineCtx(ctx context.Context, key string) ([]*models.TimelineBucket, error) {
+	if !n.cacheSwitch.Enabled() {
+		return nil, nil
+	}
+	return n.redisRepo.GetTimelineCtx(ctx, key)
+}
+
+func (n *newsSwitchCacheRepo) SetTimelineCtx(ctx context.Context, key string, seconds int, timeline []*models.TimelineBucket) error {
+	if !n.cacheSwitch.Enabled() {
+		return nil
+	}
+	return n.redisRepo.SetTimelineCtx(ctx, key, seconds, timeline)
+}
+
+func (n *newsSwitchCacheRepo) GetFeedCtx(ctx context.Context, key string) ([]byte, error) {
+	if !n.cacheSwitch.Enabled() {
+		return nil, nil
+	}
+	return n.redisRepo.GetFeedCtx(ctx, key)
+}
+
+func (n *newsSwitchCacheRepo) SetFeedCtx(ctx context.Context, key string, seconds int, feed []byte) error {
+	if !n.cacheSwitch.Enabled() {
+		return nil
+	}
+	return n.redisRepo.SetFeedCtx(ctx, key, seconds, feed)
+}
+
+func (n *newsSwitchCacheRepo) GetFeaturedCtx(ctx context.Context, key string) ([]*models.News, error) {
+	if !n.cacheSwitch.Enabled() {
+		return nil, nil
+	}
+	return n.redisRepo.GetFeaturedCtx(ctx, key)
+}
+
+func (n *newsSwitchCacheRepo) SetFeaturedCtx(ctx context.Context, key string, seconds int, featured []*models.News) error {
+	if !n.cacheSwitch.Enabled() {
+		return nil
+	}
+	return n.redisRepo.SetFeaturedCtx(ctx, key, seconds, featured)
+}
+
+func (n *newsSwitchCacheRepo) GetLeaderboardCtx(ctx context.Context, key string) ([]*models.AuthorStat, error) {
+	if !n.cacheSwitch.Enabled() {
+		return nil, nil
+	}
+	return n.redisRepo.GetLeaderboardCtx(ctx, key)
+}
+
+func (n *newsSwitchCacheRepo) SetLeaderboardCtx(ctx context.Context, key string, seconds int, stats []*models.AuthorStat) error {
+	if !n.cacheSwitch.Enabled() {
+		return nil
+	}
+	return n.redisRepo.SetLeaderboardCtx(ctx, key, seconds, stats)
+}
+
+func (n *newsSwitchCacheRepo) GetStatusCountsCtx(ctx context.Context, key string) (map[string]int, error) {
+	if !n.cacheSwitch.Enabled() {
+		return nil, nil
+	}
+	return n.redisRepo.GetStatusCountsCtx(ctx, key)
+}
+
+func (n *newsSwitchCacheRepo) SetStatusCountsCtx(ctx context.Context, key string, seconds int, counts map[string]int) error {
+	if !n.cacheSwitch.Enabled() {
+		return nil
+	}
+	return n.redisRepo.SetStatusCountsCtx(ctx, key, seconds, counts)
+}
+
+func (n *newsSwitchCacheRepo) GetTranslationCtx(ctx context.Context, key string) (*models.NewsTranslation, error) {
+	if !n.cacheSwitch.Enabled() {
+		return nil, nil
+	}
+	return n.redisRepo.GetTranslationCtx(ctx, key)
+}
+
+func (n *newsSwitchCacheRepo) SetTranslationCtx(ctx context.Context, key string, seconds int, translation *models.NewsTranslation) error {
+	if !n.cacheSwitch.Enabled() {
+		return nil
+	}
+	return n.redisRepo.SetTranslationCtx(ctx, key, seconds, translation)
+}
+
+func (n *newsSwitchCacheRepo) GetReadingPositionCtx(ctx context.Context, key string) (*models.ReadingPosition, error) {
+	if !n.cacheSwitch.Enabled() {
+		return nil, nil
+	}
+	return n.redisRepo.GetReadingPositionCtx(ctx, key)
+}
+
+// Position is written through on save, so dropped write has to drop the old cached position as well
+func (n *newsSwitchCacheRepo) SetReadingPositionCtx(ctx context.Context, key string, seconds int, position *models.ReadingPosition) error {
+	if !n.cacheSwitch.Enabled() {
+		return n.redisRepo.DeleteNewsCtx(ctx, key)
+	}
+	return n.redisRepo.SetReadingPositionCtx(ctx, key, seconds, position)
+}
+
+// View counters are not cache, so they are kept while cache is off
+func (n *newsSwitchCacheRepo) IncrViewsCtx(ctx context.Context, key string, newsID string, views int64) error {
+	return n.redisRepo.IncrViewsCtx(ctx, key, newsID, views)
+}
+
+func (n *newsSwitchCacheRepo) PopViewsCtx(ctx context.Context, key string) (map[string]int64, error) {
+	return n.redisRepo.PopViewsCtx(ctx, key)
+}
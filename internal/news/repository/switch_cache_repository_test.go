@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/internal/models"
+	"github.com/AleksK1NG/api-mc/pkg/utils"
+)
+
+func TestNewsSwitchCacheRepo(t *testing.T) {
+	t.Parallel()
+
+	redisRepo := SetupRedis()
+	cacheSwitch := utils.NewCacheSwitch(true)
+	switchRepo := NewNewsSwitchCacheRepo(redisRepo, cacheSwitch)
+
+	key := "key"
+	n := &models.NewsBase{NewsID: uuid.New(), Title: "Title", Content: "Content"}
+
+	t.Run("Enabled", func(t *testing.T) {
+		require.NoError(t, switchRepo.SetNewsCtx(context.Background(), key, 10, n))
+
+		cached, err := switchRepo.GetNewsByIDCtx(context.Background(), key)
+		require.NoError(t, err)
+		require.Equal(t, n.NewsID, cached.NewsID)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		cacheSwitch.Set(false)
+		defer cacheSwitch.Set(true)
+
+		cached, err := switchRepo.GetNewsByIDCtx(context.Background(), key)
+		require.NoError(t, err)
+		require.Nil(t, cached)
+
+		otherKey := "other"
+		require.NoError(t, switchRepo.SetNewsCtx(context.Background(), otherKey, 10, n))
+		_, err = redisRepo.GetNewsByIDCtx(context.Background(), otherKey)
+		require.Error(t, err)
+
+		timeline, err := switchRepo.GetTimelineCtx(context.Background(), key)
+		require.NoError(t, err)
+		require.Nil(t, timeline)
+	})
+
+	t.Run("Enabled again", func(t *testing.T) {
+		cached, err := switchRepo.GetNewsByIDCtx(context.Background(), key)
+		require.NoError(t, err)
+		require.NotNil(t, cached)
+	})
+
+	t.Run("Delete passes through when disabled", func(t *testing.T) {
+		require.NoError(t, switchRepo.SetNewsCtx(context.Background(), key, 10, n))
+		positionKey := "position"
+		position := &models.ReadingPosition{NewsID: n.NewsID, Position: 0.5}
+		require.NoError(t, switchRepo.SetReadingPositionCtx(context.Background(), positionKey, 10, position))
+
+		cacheSwitch.Set(false)
+		require.NoError(t, switchRepo.DeleteNewsCtx(context.Background(), key))
+		require.NoError(t, switchRepo.SetReadingPositionCtx(context.Background(), positionKey, 10, &models.ReadingPosition{NewsID: n.NewsID, Position: 0.9}))
+		cacheSwitch.Set(true)
+
+		_, err := switchRepo.GetNewsByIDCtx(context.Background(), key)
+		require.Error(t, err)
+		_, err = switchRepo.GetReadingPositionCtx(context.Background(), positionKey)
+		require.Error(t, err)
+	})
+}
@@ -3,6 +3,8 @@ package news
 
 import (
 	"context"
+	"net/url"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -13,9 +15,64 @@ import (
 // News use case
 type UseCase interface {
 	Create(ctx context.Context, news *models.News) (*models.News, error)
+	CreateIfNotExists(ctx context.Context, news *models.News) (*models.News, bool, error)
 	Update(ctx context.Context, news *models.News) (*models.News, error)
 	GetNewsByID(ctx context.Context, newsID uuid.UUID) (*models.NewsBase, error)
 	Delete(ctx context.Context, newsID uuid.UUID) error
-	GetNews(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error)
-	SearchByTitle(ctx context.Context, title string, query *utils.PaginationQuery) (*models.NewsList, error)
+	GetNews(ctx context.Context, pq *utils.PaginationQuery, params url.Values) (*models.NewsList, error)
+	GetNewsIDs(ctx context.Context, pq *utils.PaginationQuery, params url.Values) (*models.NewsIDList, error)
+	SearchByTitle(ctx context.Context, title string, scope string, query *utils.PaginationQuery) (*models.NewsList, error)
+	CountSearchByTitle(ctx context.Context, title string, scope string) (*models.NewsSearchCount, error)
+	GetTimeline(ctx context.Context) ([]*models.TimelineBucket, error)
+	GetNeighbors(ctx context.Context, newsID uuid.UUID) (prev *models.News, next *models.News, err error)
+	UpdateStatusBatch(ctx context.Context, ids []uuid.UUID, status string, dryRun bool) (*models.NewsBatchResult, error)
+	GetMyDrafts(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error)
+	GetEditedByMe(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error)
+	StreamAuthorNews(ctx context.Context, authorID uuid.UUID, fn func(n *models.News) error) error
+	GetOrphaned(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error)
+	ReassignAuthor(ctx context.Context, ids []uuid.UUID, authorID uuid.UUID, dryRun bool) (*models.NewsBatchResult, error)
+	GetFeed(ctx context.Context, category string, format string, baseURL string) ([]byte, error)
+	GetRecentlyUpdated(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error)
+	GetWithoutComments(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error)
+	CreatePreviewToken(ctx context.Context, newsID uuid.UUID) (*models.NewsPreviewToken, error)
+	GetPreview(ctx context.Context, newsID uuid.UUID, token string) (*models.NewsBase, error)
+	StreamNews(ctx context.Context, pq *utils.PaginationQuery, params url.Values, fn func(n *models.News) error) error
+	StreamSearchByTitle(ctx context.Context, title string, scope string, pq *utils.PaginationQuery, fn func(n *models.News) error) error
+	Pin(ctx context.Context, newsID uuid.UUID, until *time.Time) error
+	Unpin(ctx context.Context, newsID uuid.UUID) error
+	GetFeatured(ctx context.Context) ([]*models.News, error)
+	GetRevisions(ctx context.Context, newsID uuid.UUID) ([]*models.NewsRevision, error)
+	GetRevision(ctx context.Context, revisionID uuid.UUID) (*models.NewsRevisionSnapshot, error)
+	RevertTo(ctx context.Context, newsID uuid.UUID, revisionID uuid.UUID) (*models.News, error)
+	GetAuthorLeaderboard(ctx context.Context, window time.Duration, limit int) ([]*models.AuthorStat, error)
+	CountByStatus(ctx context.Context) (map[string]int, error)
+	GetAuthorStatusCounts(ctx context.Context, authorID uuid.UUID) (map[string]int, error)
+	GetBySlugs(ctx context.Context, slugs []string) ([]*models.NewsBase, error)
+	SetMetadata(ctx context.Context, newsID uuid.UUID, metadata models.Metadata) (*models.NewsMetadata, error)
+	GetMetadata(ctx context.Context, newsID uuid.UUID) (*models.NewsMetadata, error)
+	GetCacheEntry(ctx context.Context, newsID uuid.UUID) (*models.NewsCacheEntry, error)
+	SetTags(ctx context.Context, newsID uuid.UUID, tags []string) (*models.NewsTags, error)
+	GetTags(ctx context.Context, newsID uuid.UUID) (*models.NewsTags, error)
+	AddTagToMany(ctx context.Context, ids []uuid.UUID, tag string) (*models.NewsTagBatchResult, error)
+	SetTranslation(ctx context.Context, newsID uuid.UUID, locale string, translation *models.NewsTranslation) (*models.NewsTranslation, error)
+	GetTranslation(ctx context.Context, newsID uuid.UUID, locale string) (*models.NewsTranslation, error)
+	SaveReadingPosition(ctx context.Context, newsID uuid.UUID, position float64) (*models.ReadingPosition, error)
+	GetReadingPosition(ctx context.Context, newsID uuid.UUID) (*models.ReadingPosition, error)
+	GetNewsByIDInLocale(ctx context.Context, newsID uuid.UUID, locale string) (*models.NewsBase, error)
+	GetChangedSince(ctx context.Context, since time.Time, pq *utils.PaginationQuery) (*models.NewsChangesList, error)
+	GetActivityFeed(ctx context.Context, action string, pq *utils.PaginationQuery) (*models.NewsEventList, error)
+	PurgeDeleted(ctx context.Context, olderThan time.Duration) (int, error)
+	RecomputeCommentCounts(ctx context.Context) (int, error)
+	FlushViews(ctx context.Context) (int, error)
+	GetBrokenImages(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error)
+	GetLatestPerCategory(ctx context.Context, categories []string) (map[string]*models.News, error)
+	GetLatestUpdatePerAuthor(ctx context.Context, authorIDs []uuid.UUID) (map[uuid.UUID]*models.News, error)
+	AddRelation(ctx context.Context, fromID uuid.UUID, toID uuid.UUID) error
+	RemoveRelation(ctx context.Context, fromID uuid.UUID, toID uuid.UUID) error
+	GetCuratedRelated(ctx context.Context, newsID uuid.UUID) ([]*models.News, error)
+	GetTrending(ctx context.Context, limit int) ([]*models.News, error)
+	Summarize(ctx context.Context, list *models.NewsList) (*models.NewsSummaryList, error)
+	AcquireEditLock(ctx context.Context, newsID uuid.UUID, ttl time.Duration) (*models.NewsEditLock, error)
+	ReleaseEditLock(ctx context.Context, newsID uuid.UUID) error
+	GetEditLock(ctx context.Context, newsID uuid.UUID) (*models.NewsEditLock, error)
 }
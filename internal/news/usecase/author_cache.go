@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/AleksK1NG/api-mc/internal/models"
+)
+
+// Fetch users by ids in one lookup, unknown ids are left out of result
+type authorsLoader func(ctx context.Context, ids []uuid.UUID) ([]*models.User, error)
+
+// Authors resolved while rendering one list, each author is fetched at most once however many rows it has
+type authorCache struct {
+	load  authorsLoader
+	names map[uuid.UUID]string
+	// Authors already looked up, including ones which no longer exist
+	fetched map[uuid.UUID]struct{}
+}
+
+func newAuthorCache(load authorsLoader) *authorCache {
+	return &authorCache{load: load, names: make(map[uuid.UUID]string), fetched: make(map[uuid.UUID]struct{})}
+}
+
+// Fetch authors of given news not looked up yet, all of them in one call of loader
+func (c *authorCache) Load(ctx context.Context, newsLists ...[]*models.News) error {
+	missing := make([]uuid.UUID, 0)
+	for _, newsList := range newsLists {
+		for _, n := range newsList {
+			if _, ok := c.fetched[n.AuthorID]; ok || n.AuthorID == uuid.Nil {
+				continue
+			}
+			c.fetched[n.AuthorID] = struct{}{}
+			missing = append(missing, n.AuthorID)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	authors, err := c.load(ctx, missing)
+	if err != nil {
+		for _, id := range missing {
+			delete(c.fetched, id)
+		}
+		return err
+	}
+	for _, author := range authors {
+		c.names[author.UserID] = strings.TrimSpace(author.FirstName + " " + author.LastName)
+	}
+	return nil
+}
+
+// Full name of loaded author, empty when author no longer exists
+func (c *authorCache) Name(authorID uuid.UUID) string {
+	return c.names[authorID]
+}
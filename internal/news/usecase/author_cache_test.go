@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/internal/models"
+)
+
+func TestAuthorCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("List dominated by one author", func(t *testing.T) {
+		authorID := uuid.New()
+		otherAuthorID := uuid.New()
+		list := make([]*models.News, 0, 20)
+		for i := 0; i < 19; i++ {
+			list = append(list, &models.News{NewsID: uuid.New(), AuthorID: authorID})
+		}
+		list = append(list, &models.News{NewsID: uuid.New(), AuthorID: otherAuthorID})
+
+		var calls [][]uuid.UUID
+		authors := newAuthorCache(func(_ context.Context, ids []uuid.UUID) ([]*models.User, error) {
+			calls = append(calls, ids)
+			return []*models.User{{UserID: authorID, FirstName: "Jane", LastName: "Doe"}, {UserID: otherAuthorID, FirstName: "John"}}, nil
+		})
+
+		require.NoError(t, authors.Load(context.Background(), list))
+		require.Equal(t, [][]uuid.UUID{{authorID, otherAuthorID}}, calls)
+		require.Equal(t, "Jane Doe", authors.Name(authorID))
+		require.Equal(t, "John", authors.Name(otherAuthorID))
+	})
+
+	t.Run("Loaded authors are not fetched again", func(t *testing.T) {
+		authorID := uuid.New()
+		goneAuthorID := uuid.New()
+
+		calls := 0
+		authors := newAuthorCache(func(_ context.Context, ids []uuid.UUID) ([]*models.User, error) {
+			calls++
+			return []*models.User{{UserID: authorID, FirstName: "Jane"}}, nil
+		})
+
+		page := []*models.News{{AuthorID: authorID}, {AuthorID: goneAuthorID}, {AuthorID: authorID}}
+		require.NoError(t, authors.Load(context.Background(), page))
+		require.NoError(t, authors.Load(context.Background(), page, []*models.News{{AuthorID: goneAuthorID}}))
+		require.Equal(t, 1, calls)
+		require.Equal(t, "Jane", authors.Name(authorID))
+		require.Empty(t, authors.Name(goneAuthorID))
+	})
+
+	t.Run("Failed lookup is retried", func(t *testing.T) {
+		authorID := uuid.New()
+
+		calls := 0
+		authors := newAuthorCache(func(_ context.Context, ids []uuid.UUID) ([]*models.User, error) {
+			calls++
+			if calls == 1 {
+				return nil, errors.New("users lookup failed")
+			}
+			return []*models.User{{UserID: authorID, FirstName: "Jane"}}, nil
+		})
+
+		page := []*models.News{{AuthorID: authorID}}
+		require.Error(t, authors.Load(context.Background(), page))
+		require.NoError(t, authors.Load(context.Background(), page))
+		require.Equal(t, 2, calls)
+		require.Equal(t, "Jane", authors.Name(authorID))
+	})
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/AleksK1NG/api-mc/internal/news"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+)
+
+// Background job reconciling stored news comment counts with comments table
+type CommentCountJob struct {
+	newsUC   news.UseCase
+	interval time.Duration
+	logger   logger.Logger
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// Comment count job constructor
+func NewCommentCountJob(newsUC news.UseCase, interval time.Duration, logger logger.Logger) *CommentCountJob {
+	return &CommentCountJob{newsUC: newsUC, interval: interval, logger: logger}
+}
+
+// Run reconciliation every interval until Close
+func (j *CommentCountJob) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	j.cancel = cancel
+	j.done = make(chan struct{})
+
+	go func() {
+		defer close(j.done)
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+
+			corrected, err := j.newsUC.RecomputeCommentCounts(ctx)
+			if err != nil {
+				j.logger.Errorf("CommentCountJob.RecomputeCommentCounts: %v", err)
+				continue
+			}
+			if corrected > 0 {
+				j.logger.Warnf("CommentCountJob done, Corrected: %d", corrected)
+			}
+		}
+	}()
+}
+
+// Stop job and wait for running reconciliation to finish
+func (j *CommentCountJob) Close() error {
+	if j.cancel == nil {
+		return nil
+	}
+	j.cancel()
+	<-j.done
+	return nil
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/AleksK1NG/api-mc/config"
+	"github.com/AleksK1NG/api-mc/internal/news"
+	"github.com/AleksK1NG/api-mc/pkg/imagecheck"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+)
+
+const (
+	imageCheckBatchSize       = 100
+	defaultImageCheckInterval = 24 * time.Hour
+)
+
+// Background job flagging news whose image url no longer resolves
+type ImageCheckJob struct {
+	newsRepo news.Repository
+	checker  *imagecheck.Checker
+	interval time.Duration
+	logger   logger.Logger
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// Image check job constructor, zero interval uses default one
+func NewImageCheckJob(cfg *config.Config, newsRepo news.Repository, client imagecheck.HTTPClient, logger logger.Logger) *ImageCheckJob {
+	checker := imagecheck.NewChecker(client, cfg.ImageCheck.Timeout, cfg.ImageCheck.Concurrency, cfg.ImageCheck.RequestsPerSecond)
+	interval := cfg.ImageCheck.Interval
+	if interval <= 0 {
+		interval = defaultImageCheckInterval
+	}
+	return &ImageCheckJob{newsRepo: newsRepo, checker: checker, interval: interval, logger: logger}
+}
+
+// Run check right away and then every interval until Close
+func (j *ImageCheckJob) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	j.cancel = cancel
+	j.done = make(chan struct{})
+
+	go func() {
+		defer close(j.done)
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+		for {
+			broken, err := j.CheckAll(ctx)
+			if err != nil {
+				j.logger.Errorf("ImageCheckJob.CheckAll: %v", err)
+			} else {
+				j.logger.Infof("ImageCheckJob done, Broken: %d", broken)
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop job and wait for running check to finish
+func (j *ImageCheckJob) Close() error {
+	if j.cancel == nil {
+		return nil
+	}
+	j.cancel()
+	<-j.done
+	return nil
+}
+
+// Check images of all news batch by batch, returns number of news found with broken image
+func (j *ImageCheckJob) CheckAll(ctx context.Context) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ImageCheckJob.CheckAll")
+	defer span.Finish()
+
+	brokenCount := 0
+	afterID := uuid.Nil
+	for {
+		images, err := j.newsRepo.GetNewsImages(ctx, afterID, imageCheckBatchSize)
+		if err != nil {
+			return brokenCount, err
+		}
+		if len(images) == 0 {
+			return brokenCount, nil
+		}
+
+		urls := make([]string, 0, len(images))
+		for _, image := range images {
+			urls = append(urls, image.ImageURL)
+		}
+		result := j.checker.Check(ctx, urls)
+
+		checked := make([]uuid.UUID, 0, len(images))
+		broken := make([]uuid.UUID, 0)
+		for _, image := range images {
+			isBroken, ok := result[image.ImageURL]
+			if !ok {
+				continue
+			}
+			checked = append(checked, image.NewsID)
+			if isBroken {
+				broken = append(broken, image.NewsID)
+			}
+		}
+		if len(checked) > 0 {
+			if err = j.newsRepo.SetImageBroken(ctx, checked, broken); err != nil {
+				return brokenCount, err
+			}
+		}
+		brokenCount += len(broken)
+
+		if err = ctx.Err(); err != nil {
+			return brokenCount, err
+		}
+		if len(images) < imageCheckBatchSize {
+			return brokenCount, nil
+		}
+		afterID = images[len(images)-1].NewsID
+	}
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/config"
+	"github.com/AleksK1NG/api-mc/internal/models"
+	"github.com/AleksK1NG/api-mc/internal/news/mock"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+)
+
+type fakeImageClient map[string]int
+
+func (f fakeImageClient) Do(req *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: f[req.URL.String()], Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+}
+
+func TestImageCheckJob_CheckAll(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{ImageCheck: config.ImageCheckConfig{Timeout: time.Second, Concurrency: 2}}
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	client := fakeImageClient{
+		"http://images.test/ok.png":      http.StatusOK,
+		"http://images.test/missing.png": http.StatusNotFound,
+	}
+	job := NewImageCheckJob(cfg, mockNewsRepo, client, logger.NewApiLogger(nil))
+
+	okUID := uuid.New()
+	missingUID := uuid.New()
+	mockNewsRepo.EXPECT().GetNewsImages(gomock.Any(), uuid.Nil, imageCheckBatchSize).Return([]*models.NewsImage{
+		{NewsID: okUID, ImageURL: "http://images.test/ok.png"},
+		{NewsID: missingUID, ImageURL: "http://images.test/missing.png"},
+	}, nil)
+	mockNewsRepo.EXPECT().SetImageBroken(gomock.Any(), []uuid.UUID{okUID, missingUID}, []uuid.UUID{missingUID}).Return(nil)
+
+	broken, err := job.CheckAll(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, broken)
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/api-mc/config"
+	"github.com/AleksK1NG/api-mc/internal/models"
+	"github.com/AleksK1NG/api-mc/internal/news"
+	"github.com/AleksK1NG/api-mc/pkg/mailer"
+)
+
+const publishedSubject = "Your news is published: %s"
+
+var publishedTemplate = template.Must(template.New("published").Parse(`Hello {{.FirstName}},
+
+your news "{{.Title}}" is live now:
+{{.Link}}
+`))
+
+// Queue notification emails are put into, *mailer.Queue implements it
+type MailQueue interface {
+	Enqueue(msg *mailer.Message) error
+}
+
+// Emails author once their news is published, register OnPublished as news repository hook
+type PublishNotifier struct {
+	authorRepo news.AuthorRepository
+	queue      MailQueue
+	baseURL    string
+}
+
+// Publish notifier constructor
+func NewPublishNotifier(cfg *config.Config, authorRepo news.AuthorRepository, queue MailQueue) *PublishNotifier {
+	return &PublishNotifier{authorRepo: authorRepo, queue: queue, baseURL: strings.TrimRight(cfg.Mailer.BaseURL, "/")}
+}
+
+// Render notification for author of published news and enqueue it, sending happens in background
+func (p *PublishNotifier) OnPublished(ctx context.Context, n *models.News) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PublishNotifier.OnPublished")
+	defer span.Finish()
+
+	authors, err := p.authorRepo.GetByIDs(ctx, []uuid.UUID{n.AuthorID})
+	if err != nil {
+		return err
+	}
+	if len(authors) == 0 || authors[0].Email == "" {
+		return errors.Errorf("PublishNotifier.OnPublished: author %s has no email", n.AuthorID)
+	}
+	author := authors[0]
+
+	var body bytes.Buffer
+	if err = publishedTemplate.Execute(&body, struct {
+		FirstName string
+		Title     string
+		Link      string
+	}{
+		FirstName: author.FirstName,
+		Title:     n.Title,
+		Link:      fmt.Sprintf("%s/api/v1/news/%s", p.baseURL, n.NewsID),
+	}); err != nil {
+		return errors.Wrap(err, "PublishNotifier.OnPublished.Execute")
+	}
+
+	msg := &mailer.Message{To: []string{author.Email}, Subject: fmt.Sprintf(publishedSubject, n.Title), Body: body.String()}
+	if err = p.queue.Enqueue(msg); err != nil {
+		return errors.Wrap(err, "PublishNotifier.OnPublished.Enqueue")
+	}
+	return nil
+}
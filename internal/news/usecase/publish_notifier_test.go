@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/config"
+	"github.com/AleksK1NG/api-mc/internal/models"
+	"github.com/AleksK1NG/api-mc/internal/news"
+	"github.com/AleksK1NG/api-mc/internal/news/mock"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+	"github.com/AleksK1NG/api-mc/pkg/mailer"
+)
+
+type fakeMailQueue struct {
+	messages []*mailer.Message
+}
+
+func (f *fakeMailQueue) Enqueue(msg *mailer.Message) error {
+	f.messages = append(f.messages, msg)
+	return nil
+}
+
+func TestPublishNotifier_OnPublished(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		Logger: config.Logger{Development: true},
+		Mailer: config.MailerConfig{BaseURL: "https://news.test/"},
+	}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+
+	mockAuthorRepo := mock.NewMockAuthorRepository(ctrl)
+	queue := &fakeMailQueue{}
+	hooks := news.NewHooks(apiLogger)
+	hooks.OnPublished(NewPublishNotifier(cfg, mockAuthorRepo, queue).OnPublished)
+
+	t.Run("Enqueue on publish", func(t *testing.T) {
+		queue.messages = nil
+		authorUID := uuid.New()
+		n := &models.News{NewsID: uuid.New(), AuthorID: authorUID, Title: "Big news", Status: models.NewsStatusPublished}
+
+		mockAuthorRepo.EXPECT().GetByIDs(gomock.Any(), []uuid.UUID{authorUID}).
+			Return([]*models.User{{UserID: authorUID, FirstName: "Alex", Email: "alex@mail.com"}}, nil)
+
+		hooks.Published(context.Background(), n)
+
+		require.Len(t, queue.messages, 1)
+		require.Equal(t, []string{"alex@mail.com"}, queue.messages[0].To)
+		require.Equal(t, "Your news is published: Big news", queue.messages[0].Subject)
+		require.Contains(t, queue.messages[0].Body, "Hello Alex")
+		require.Contains(t, queue.messages[0].Body, `"Big news"`)
+		require.Contains(t, queue.messages[0].Body, "https://news.test/api/v1/news/"+n.NewsID.String())
+	})
+
+	t.Run("Nothing enqueued without author email", func(t *testing.T) {
+		queue.messages = nil
+		authorUID := uuid.New()
+
+		mockAuthorRepo.EXPECT().GetByIDs(gomock.Any(), []uuid.UUID{authorUID}).Return([]*models.User{}, nil)
+
+		hooks.Published(context.Background(), &models.News{NewsID: uuid.New(), AuthorID: authorUID, Title: "Big news"})
+
+		require.Empty(t, queue.messages)
+	})
+
+	t.Run("Not enqueued on other updates", func(t *testing.T) {
+		queue.messages = nil
+
+		hooks.Updated(context.Background(), &models.News{NewsID: uuid.New(), AuthorID: uuid.New(), Status: models.NewsStatusArchived})
+
+		require.Empty(t, queue.messages)
+	})
+}
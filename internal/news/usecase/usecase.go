@@ -2,9 +2,21 @@ package usecase
 
 import (
 	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
 
+	"github.com/go-redis/redis/v8"
 	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
@@ -12,27 +24,175 @@ import (
 	"github.com/AleksK1NG/api-mc/config"
 	"github.com/AleksK1NG/api-mc/internal/models"
 	"github.com/AleksK1NG/api-mc/internal/news"
+	"github.com/AleksK1NG/api-mc/pkg/feed"
 	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
 	"github.com/AleksK1NG/api-mc/pkg/logger"
+	"github.com/AleksK1NG/api-mc/pkg/preview"
 	"github.com/AleksK1NG/api-mc/pkg/utils"
 )
 
 const (
-	basePrefix    = "api-news:"
-	cacheDuration = 3600
+	basePrefix            = "api-news:"
+	tenantKey             = "tenant"
+	cacheDuration         = 3600
+	staleRefreshTimeout   = 5 * time.Second
+	timelineKey           = "timeline"
+	timelineCacheDuration = 300
+	feedKey               = "feed"
+	feedCacheDuration     = 120
+	feedSize              = 20
+	featuredKey           = "featured"
+	featuredCacheDuration = 300
+	featuredSize          = 10
+
+	leaderboardKey           = "leaderboard"
+	leaderboardCacheDuration = 300
+	leaderboardDefaultWindow = 30 * 24 * time.Hour
+	leaderboardMaxWindow     = 365 * 24 * time.Hour
+	leaderboardDefaultLimit  = 10
+	leaderboardMaxLimit      = 100
+
+	statusCountsKey           = "status-counts"
+	statusCountsCacheDuration = 30
+	authorStatusCountsKey     = "author-status-counts"
+
+	maxCategoriesFilter = 20
+
+	latestPerCategoryKey           = "latest-per-category"
+	latestPerCategoryCacheDuration = 60
+
+	maxAuthorsFilter             = 20
+	latestPerAuthorKey           = "latest-per-author"
+	latestPerAuthorCacheDuration = 30
+
+	relationsKey           = "relations"
+	relationsCacheDuration = 600
+
+	activityKey           = "activity"
+	activityCacheDuration = 15
+
+	trendingKey            = "trending"
+	trendingCacheDuration  = 60
+	trendingDefaultLimit   = 10
+	trendingMaxLimit       = 50
+	defaultTrendingGravity = 1.8
+
+	defaultExcerptLength = 200
+
+	prefetchNextParam = "prefetch_next"
+	exactCountParam   = "exact_count"
+	nextPreviewSize   = 3
+
+	newsListKey = "news-list"
+
+	editLockKey        = "edit-lock"
+	defaultEditLockTTL = 5 * time.Minute
+	maxEditLockTTL     = time.Hour
+
+	slugKey           = "slug"
+	getBySlugsMaxSize = 100
+
+	defaultMaxTags = 20
+	maxTagLength   = 50
+
+	translationKey = "translation"
+	defaultLocale  = "en"
+
+	readingPositionKey = "reading-position"
+
+	viewsKey            = "views"
+	viewsFlushBatchSize = 500
+
+	defaultMaxMetadataSize = 16 << 10
+	// Average adult silent reading speed
+	defaultReadingWordsPerMinute = 200
+
+	purgeBatchSize        = 500
+	commentCountBatchSize = 500
 )
 
+// Roles which may curate news of other authors
+var editorRoles = []string{"admin", "moderator"}
+
+// Fields update is not allowed to change when unset
+var defaultImmutableFields = []string{"author_id", "created_at", "tenant_id"}
+
+// Immutable field checks by news json field name, true when update gives value other than stored one
+var immutableNewsFields = map[string]func(news *models.News, existing *models.NewsBase) bool{
+	"author_id": func(news *models.News, existing *models.NewsBase) bool {
+		return news.AuthorID != uuid.Nil && news.AuthorID != existing.AuthorID
+	},
+	"created_at": func(news *models.News, _ *models.NewsBase) bool {
+		return !news.CreatedAt.IsZero()
+	},
+	"tenant_id": func(news *models.News, existing *models.NewsBase) bool {
+		return news.TenantID != nil && (existing.TenantID == nil || *news.TenantID != *existing.TenantID)
+	},
+}
+
+// Filters and sorts accepted by published news list
+var newsListSpec = &utils.QuerySpec{
+	Filters: map[string]utils.FilterField{
+		"category":        {Column: "category", Type: utils.FieldString, Validate: validateCategoryFilter},
+		"categories":      {Column: "category", Type: utils.FieldString, Validate: validateCategoryFilter, MaxValues: maxCategoriesFilter},
+		"author_id":       {Column: "author_id", Type: utils.FieldUUID},
+		"created_after":   {Column: "created_at", Type: utils.FieldTime, Operator: ">="},
+		"created_before":  {Column: "created_at", Type: utils.FieldTime, Operator: "<"},
+		"metadata_filter": {Column: "metadata", Type: utils.FieldString, Operator: "@>", Validate: validateMetadataFilter},
+		"min_words":       {Column: "word_count", Type: utils.FieldInt, Operator: ">=", Validate: validateWordsFilter},
+		"max_words":       {Column: "word_count", Type: utils.FieldInt, Operator: "<=", Validate: validateWordsFilter},
+	},
+	Sort: map[string]string{
+		"created_at": "created_at",
+		"updated_at": "updated_at",
+		"title":      "title",
+		// Pinned and not expired first when descending
+		"featured": "(pinned_at IS NOT NULL AND (pinned_until IS NULL OR pinned_until > now()))",
+	},
+	DefaultSort: "created_at",
+	TieBreaker:  "news_id",
+	Ignore:      []string{"tz", "strict", prefetchNextParam, exactCountParam},
+}
+
+func validateCategoryFilter(value interface{}) error {
+	if category, _ := value.(string); category == "" || len(category) > 250 {
+		return errors.New("category must be 1 to 250 characters")
+	}
+	return nil
+}
+
+func validateWordsFilter(value interface{}) error {
+	if words, _ := value.(int); words < 0 {
+		return errors.New("words must not be negative")
+	}
+	return nil
+}
+
+func validateMetadataFilter(value interface{}) error {
+	filter, _ := value.(string)
+	if len(filter) > defaultMaxMetadataSize || !models.Metadata(filter).IsObject() {
+		return errors.New("metadata_filter must be JSON object")
+	}
+	return nil
+}
+
 // News UseCase
 type newsUC struct {
 	cfg       *config.Config
 	newsRepo  news.Repository
 	redisRepo news.RedisRepository
-	logger    logger.Logger
+	// Users lookup used to enrich news lists with authors in one batch
+	authorRepo news.AuthorRepository
+	logger     logger.Logger
+	// Environment key prefix followed by news base prefix
+	keyPrefix string
+	// News ids with stale cache refresh running
+	refreshing sync.Map
 }
 
 // News UseCase constructor
-func NewNewsUseCase(cfg *config.Config, newsRepo news.Repository, redisRepo news.RedisRepository, logger logger.Logger) news.UseCase {
-	return &newsUC{cfg: cfg, newsRepo: newsRepo, redisRepo: redisRepo, logger: logger}
+func NewNewsUseCase(cfg *config.Config, newsRepo news.Repository, redisRepo news.RedisRepository, authorRepo news.AuthorRepository, logger logger.Logger) news.UseCase {
+	return &newsUC{cfg: cfg, newsRepo: newsRepo, redisRepo: redisRepo, authorRepo: authorRepo, logger: logger, keyPrefix: cfg.RedisKeyPrefix() + basePrefix}
 }
 
 // Create news
@@ -46,19 +206,98 @@ func (u *newsUC) Create(ctx context.Context, news *models.News) (*models.News, e
 	}
 
 	news.AuthorID = user.UserID
+	news.TenantID = tenantOf(ctx)
+	if err = u.checkEncoding(news, "newsUC.Create"); err != nil {
+		return nil, err
+	}
+	news.Title = utils.NormalizeSpaces(news.Title)
+	if news.Slug != nil {
+		slug := utils.Slugify(*news.Slug)
+		news.Slug = &slug
+	}
 
 	if err = utils.ValidateStruct(ctx, news); err != nil {
-		return nil, httpErrors.NewBadRequestError(errors.WithMessage(err, "newsUC.Create.ValidateStruct"))
+		return nil, httpErrors.NewValidationError(errors.WithMessage(err, "newsUC.Create.ValidateStruct"))
+	}
+
+	existing, err := u.findSameContent(ctx, news)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, duplicateNewsError(existing, user.UserID)
+	}
+
+	var similar []*models.NewsRef
+	if u.cfg.News.SimilarTitleThreshold > 0 {
+		similar, err = u.newsRepo.FindSimilarTitles(ctx, news.Title, u.cfg.News.SimilarTitleThreshold, news.AuthorID, news.TenantID)
+		if err != nil {
+			u.logger.Errorf("newsUC.Create.FindSimilarTitles: %v", err)
+		}
 	}
 
 	n, err := u.newsRepo.Create(ctx, news)
 	if err != nil {
 		return nil, err
 	}
+	n.SimilarNews = similar
+
+	u.deleteAuthorStatusCountsFromCache(ctx, n.AuthorID, "newsUC.Create.DeleteNewsCtx")
 
 	return n, err
 }
 
+// Create news unless news with same slug exists, slug is made from title when not given
+func (u *newsUC) CreateIfNotExists(ctx context.Context, news *models.News) (*models.News, bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.CreateIfNotExists")
+	defer span.Finish()
+
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, false, httpErrors.NewUnauthorizedError(errors.WithMessage(err, "newsUC.CreateIfNotExists.GetUserFromCtx"))
+	}
+
+	news.AuthorID = user.UserID
+	news.TenantID = tenantOf(ctx)
+	if err = u.checkEncoding(news, "newsUC.CreateIfNotExists"); err != nil {
+		return nil, false, err
+	}
+	news.Title = utils.NormalizeSpaces(news.Title)
+	slug := news.Title
+	if news.Slug != nil && *news.Slug != "" {
+		slug = *news.Slug
+	}
+	slug = utils.Slugify(slug)
+	if slug == "" {
+		return nil, false, httpErrors.NewBadRequestError(errors.New("slug is required"))
+	}
+	news.Slug = &slug
+
+	if err = utils.ValidateStruct(ctx, news); err != nil {
+		return nil, false, httpErrors.NewValidationError(errors.WithMessage(err, "newsUC.CreateIfNotExists.ValidateStruct"))
+	}
+
+	existing, err := u.findSameContent(ctx, news)
+	if err != nil {
+		return nil, false, err
+	}
+	if existing != nil {
+		return u.existingNews(existing, user.UserID)
+	}
+
+	n, created, err := u.newsRepo.CreateIfNotExists(ctx, news)
+	if err != nil {
+		return nil, false, err
+	}
+	if !created {
+		return u.existingNews(n, user.UserID)
+	}
+
+	u.deleteAuthorStatusCountsFromCache(ctx, n.AuthorID, "newsUC.CreateIfNotExists.DeleteNewsCtx")
+
+	return n, true, nil
+}
+
 // Update news item
 func (u *newsUC) Update(ctx context.Context, news *models.News) (*models.News, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.Update")
@@ -68,12 +307,32 @@ func (u *newsUC) Update(ctx context.Context, news *models.News) (*models.News, e
 	if err != nil {
 		return nil, err
 	}
+	if err = checkTenant(ctx, newsByID.TenantID, "newsUC.Update"); err != nil {
+		return nil, err
+	}
 
 	if err = utils.ValidateIsOwner(ctx, newsByID.AuthorID.String(), u.logger); err != nil {
 		return nil, httpErrors.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "newsUC.Update.ValidateIsOwner"))
 	}
 
-	updatedUser, err := u.newsRepo.Update(ctx, news)
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, httpErrors.NewUnauthorizedError(errors.WithMessage(err, "newsUC.Update.GetUserFromCtx"))
+	}
+
+	if err = u.checkImmutableFields(news, newsByID); err != nil {
+		return nil, err
+	}
+	if err = u.checkEncoding(news, "newsUC.Update"); err != nil {
+		return nil, err
+	}
+	news.Title = utils.NormalizeSpaces(news.Title)
+	if news.Content != "" {
+		hash := utils.ContentHash(news.Content)
+		news.ContentHash = &hash
+	}
+
+	updatedUser, err := u.newsRepo.Update(ctx, news, user.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -81,76 +340,2191 @@ func (u *newsUC) Update(ctx context.Context, news *models.News) (*models.News, e
 	if err = u.redisRepo.DeleteNewsCtx(ctx, u.getKeyWithPrefix(news.NewsID.String())); err != nil {
 		u.logger.Errorf("newsUC.Update.DeleteNewsCtx: %v", err)
 	}
+	u.deleteAuthorStatusCountsFromCache(ctx, newsByID.AuthorID, "newsUC.Update.DeleteNewsCtx")
+
+	lock, err := u.getEditLock(ctx, news.NewsID)
+	if err != nil {
+		u.logger.Errorf("newsUC.Update.getEditLock: %v", err)
+	} else if lock.Locked && *lock.UserID != user.UserID {
+		u.logger.Warnf("newsUC.Update news %s updated by %s while edit lock is held by %s", news.NewsID, user.UserID, lock.UserID)
+		updatedUser.EditLock = lock
+	}
 
 	return updatedUser, nil
 }
 
+// Get raw cached news entry with remaining ttl, database is never queried
+func (u *newsUC) GetCacheEntry(ctx context.Context, newsID uuid.UUID) (*models.NewsCacheEntry, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetCacheEntry")
+	defer span.Finish()
+
+	key := u.getKeyWithPrefix(newsID.String())
+	value, ttl, err := u.redisRepo.GetRawCtx(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &models.NewsCacheEntry{Key: key, Hit: value != nil}
+	if !entry.Hit {
+		return entry, nil
+	}
+
+	ttlSeconds := int64(-1)
+	if ttl >= 0 {
+		ttlSeconds = int64(ttl / time.Second)
+	}
+	entry.TTLSeconds = &ttlSeconds
+	if json.Valid(value) {
+		entry.Value = value
+	} else {
+		entry.Raw = string(value)
+	}
+
+	return entry, nil
+}
+
 // Get news by id
 func (u *newsUC) GetNewsByID(ctx context.Context, newsID uuid.UUID) (*models.NewsBase, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetNewsByID")
 	defer span.Finish()
 
-	newsBase, err := u.redisRepo.GetNewsByIDCtx(ctx, u.getKeyWithPrefix(newsID.String()))
+	n, err := u.getNewsByIDCached(ctx, newsID)
 	if err != nil {
+		return nil, err
+	}
+	if err = checkTenant(ctx, n.TenantID, "newsUC.GetNewsByID"); err != nil {
+		return nil, err
+	}
+	if err = checkVisible(ctx, n, "newsUC.GetNewsByID"); err != nil {
+		return nil, err
+	}
+
+	if n.Status == models.NewsStatusPublished {
+		if err = u.redisRepo.IncrViewsCtx(ctx, u.getKeyWithPrefix(viewsKey), newsID.String(), 1); err != nil {
+			u.logger.Errorf("newsUC.GetNewsByID.IncrViewsCtx: %v", err)
+		}
+	}
+
+	return u.withMissingAuthor(u.withReadingTime(n)), nil
+}
+
+// Get news by id through cache.
+// Cache is written only by cacheNewsByID after successful database read, so errors and empty results never get cached
+func (u *newsUC) getNewsByIDCached(ctx context.Context, newsID uuid.UUID) (*models.NewsBase, error) {
+	if u.cfg.Redis.StaleWhileRevalidate > 0 {
+		return u.getNewsByIDRevalidating(ctx, newsID)
+	}
+
+	newsBase, err := u.redisRepo.GetNewsByIDCtx(ctx, u.getKeyWithPrefix(newsID.String()))
+	if err != nil && !errors.Is(err, redis.Nil) {
 		u.logger.Errorf("newsUC.GetNewsByID.GetNewsByIDCtx: %v", err)
 	}
 	if newsBase != nil {
+		utils.SetCacheStatus(ctx, utils.CacheHit)
 		return newsBase, nil
 	}
+	utils.SetCacheStatus(ctx, utils.CacheMiss)
 
 	n, err := u.newsRepo.GetNewsByID(ctx, newsID)
 	if err != nil {
 		return nil, err
 	}
+	u.cacheNewsByID(ctx, newsID, n)
+
+	return n, nil
+}
+
+// Write news read from database by id to cache, result which is not the requested news is logged and not cached
+func (u *newsUC) cacheNewsByID(ctx context.Context, newsID uuid.UUID, n *models.NewsBase) {
+	if n == nil || n.NewsID != newsID {
+		u.logger.Warnf("newsUC.cacheNewsByID: database read of news %s gave no news, not cached", newsID)
+		return
+	}
 
-	if err = u.redisRepo.SetNewsCtx(ctx, u.getKeyWithPrefix(newsID.String()), cacheDuration, n); err != nil {
+	if u.cfg.Redis.StaleWhileRevalidate > 0 {
+		u.setNewsWithFreshness(ctx, n)
+		return
+	}
+	if err := u.redisRepo.SetNewsCtx(ctx, u.getKeyWithPrefix(newsID.String()), cacheDuration, n); err != nil {
 		u.logger.Errorf("newsUC.GetNewsByID.SetNewsCtx: %s", err)
 	}
+}
+
+// Get news by id serving cached news past its freshness until hard ttl, stale news is refreshed in background
+func (u *newsUC) getNewsByIDRevalidating(ctx context.Context, newsID uuid.UUID) (*models.NewsBase, error) {
+	key := u.getKeyWithPrefix(newsID.String())
+	newsBase, freshUntil, err := u.redisRepo.GetNewsWithFreshnessCtx(ctx, key)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		u.logger.Errorf("newsUC.GetNewsByID.GetNewsWithFreshnessCtx: %v", err)
+	}
+	if newsBase != nil {
+		if time.Now().Before(freshUntil) {
+			utils.SetCacheStatus(ctx, utils.CacheHit)
+			return newsBase, nil
+		}
+		utils.SetCacheStatus(ctx, utils.CacheStale)
+		u.refreshNewsInBackground(newsID)
+		return newsBase, nil
+	}
+	utils.SetCacheStatus(ctx, utils.CacheMiss)
+
+	n, err := u.newsRepo.GetNewsByID(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+	u.cacheNewsByID(ctx, newsID, n)
 
 	return n, nil
 }
 
-// Delete news
-func (u *newsUC) Delete(ctx context.Context, newsID uuid.UUID) error {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.Delete")
+// Reload news from database into cache, at most one refresh per news runs at a time
+func (u *newsUC) refreshNewsInBackground(newsID uuid.UUID) {
+	if _, running := u.refreshing.LoadOrStore(newsID, struct{}{}); running {
+		return
+	}
+
+	go func() {
+		defer u.refreshing.Delete(newsID)
+
+		ctx, cancel := context.WithTimeout(context.Background(), staleRefreshTimeout)
+		defer cancel()
+
+		n, err := u.newsRepo.GetNewsByID(ctx, newsID)
+		if err != nil {
+			u.logger.Errorf("newsUC.refreshNewsInBackground.GetNewsByID: %v", err)
+			return
+		}
+		u.cacheNewsByID(ctx, newsID, n)
+	}()
+}
+
+// Cache news fresh for cache duration and kept stale for configured time after
+func (u *newsUC) setNewsWithFreshness(ctx context.Context, n *models.NewsBase) {
+	freshUntil := time.Now().Add(cacheDuration * time.Second)
+	hardSeconds := cacheDuration + int(u.cfg.Redis.StaleWhileRevalidate/time.Second)
+	if err := u.redisRepo.SetNewsWithFreshnessCtx(ctx, u.getKeyWithPrefix(n.NewsID.String()), hardSeconds, freshUntil, n); err != nil {
+		u.logger.Errorf("newsUC.setNewsWithFreshness.SetNewsWithFreshnessCtx: %v", err)
+	}
+}
+
+// Get published news by slugs in order of slugs, unknown slugs are skipped. Slugs are cached as news ids so
+// news bodies come from by id cache which is invalidated on every news change
+func (u *newsUC) GetBySlugs(ctx context.Context, slugs []string) ([]*models.NewsBase, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetBySlugs")
 	defer span.Finish()
 
-	newsByID, err := u.newsRepo.GetNewsByID(ctx, newsID)
+	if len(slugs) > getBySlugsMaxSize {
+		return nil, httpErrors.NewBadRequestError(errors.Errorf("at most %d slugs are allowed", getBySlugsMaxSize))
+	}
+	if len(slugs) == 0 {
+		return []*models.NewsBase{}, nil
+	}
+
+	found := make(map[string]*models.NewsBase, len(slugs))
+	for slug, n := range u.getBySlugsFromCache(ctx, slugs) {
+		found[slug] = n
+	}
+
+	missing := make([]string, 0, len(slugs))
+	for _, slug := range slugs {
+		if _, ok := found[slug]; !ok {
+			missing = append(missing, slug)
+		}
+	}
+	if len(missing) > 0 {
+		news, err := u.newsRepo.GetNewsBySlugs(ctx, missing)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range news {
+			if checkTenant(ctx, n.TenantID, "newsUC.GetBySlugs") != nil {
+				continue
+			}
+			found[*n.Slug] = n
+			if err = u.redisRepo.SetNewsCtx(ctx, u.getKeyWithPrefix(n.NewsID.String()), cacheDuration, n); err != nil {
+				u.logger.Errorf("newsUC.GetBySlugs.SetNewsCtx: %v", err)
+			}
+			if err = u.redisRepo.SetNewsIDCtx(ctx, u.getSlugKey(ctx, *n.Slug), cacheDuration, n.NewsID.String()); err != nil {
+				u.logger.Errorf("newsUC.GetBySlugs.SetNewsIDCtx: %v", err)
+			}
+		}
+	}
+
+	result := make([]*models.NewsBase, 0, len(slugs))
+	for _, slug := range slugs {
+		if n, ok := found[slug]; ok {
+			result = append(result, u.withMissingAuthor(u.withReadingTime(n)))
+		}
+	}
+
+	return result, nil
+}
+
+// Get latest published news of every given category, categories without news are left out of result
+func (u *newsUC) GetLatestPerCategory(ctx context.Context, categories []string) (map[string]*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetLatestPerCategory")
+	defer span.Finish()
+
+	categories = uniqueSorted(categories)
+	if len(categories) == 0 {
+		return nil, httpErrors.NewBadRequestError(errors.New("at least one category is required"))
+	}
+	if len(categories) > maxCategoriesFilter {
+		return nil, httpErrors.NewBadRequestError(errors.Errorf("at most %d categories are allowed", maxCategoriesFilter))
+	}
+
+	key := u.getTenantKeyWithPrefix(tenantOf(ctx), fmt.Sprintf("%s:%s", latestPerCategoryKey, strings.Join(categories, ",")))
+	cached, err := u.redisRepo.GetLatestPerCategoryCtx(ctx, key)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		u.logger.Errorf("newsUC.GetLatestPerCategory.GetLatestPerCategoryCtx: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	latest, err := u.newsRepo.GetLatestPerCategory(ctx, categories)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if err = utils.ValidateIsOwner(ctx, newsByID.AuthorID.String(), u.logger); err != nil {
-		return httpErrors.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "newsUC.Delete.ValidateIsOwner"))
+	if err = u.redisRepo.SetLatestPerCategoryCtx(ctx, key, latestPerCategoryCacheDuration, latest); err != nil {
+		u.logger.Errorf("newsUC.GetLatestPerCategory.SetLatestPerCategoryCtx: %v", err)
+	}
+
+	return latest, nil
+}
+
+// Get most recently updated published news of every given author, authors without news are left out of result
+func (u *newsUC) GetLatestUpdatePerAuthor(ctx context.Context, authorIDs []uuid.UUID) (map[uuid.UUID]*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetLatestUpdatePerAuthor")
+	defer span.Finish()
+
+	ids := make([]string, 0, len(authorIDs))
+	for _, id := range authorIDs {
+		if id != uuid.Nil {
+			ids = append(ids, id.String())
+		}
 	}
+	ids = uniqueSorted(ids)
+	if len(ids) == 0 {
+		return nil, httpErrors.NewBadRequestError(errors.New("at least one author is required"))
+	}
+	if len(ids) > maxAuthorsFilter {
+		return nil, httpErrors.NewBadRequestError(errors.Errorf("at most %d authors are allowed", maxAuthorsFilter))
+	}
+
+	key := u.getTenantKeyWithPrefix(tenantOf(ctx), fmt.Sprintf("%s:%s", latestPerAuthorKey, strings.Join(ids, ",")))
+	cached, err := u.redisRepo.GetLatestPerAuthorCtx(ctx, key)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		u.logger.Errorf("newsUC.GetLatestUpdatePerAuthor.GetLatestPerAuthorCtx: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	uniqueIDs := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		uniqueIDs = append(uniqueIDs, uuid.MustParse(id))
+	}
+	latest, err := u.newsRepo.GetLatestUpdatePerAuthor(ctx, uniqueIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.redisRepo.SetLatestPerAuthorCtx(ctx, key, latestPerAuthorCacheDuration, latest); err != nil {
+		u.logger.Errorf("newsUC.GetLatestUpdatePerAuthor.SetLatestPerAuthorCtx: %v", err)
+	}
+
+	return latest, nil
+}
+
+// Link news to related published news, self links and duplicates are rejected
+func (u *newsUC) AddRelation(ctx context.Context, fromID uuid.UUID, toID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.AddRelation")
+	defer span.Finish()
 
-	if err = u.newsRepo.Delete(ctx, newsID); err != nil {
+	if err := utils.ValidateUUIDs(utils.UUIDField{Name: "related_id", Value: toID}); err != nil {
+		return err
+	}
+	if fromID == toID {
+		return httpErrors.NewBadRequestError(errors.New("news can not be related to itself"))
+	}
+	if err := u.checkCanCurate(ctx, fromID, "newsUC.AddRelation"); err != nil {
+		return err
+	}
+	to, err := u.newsRepo.GetNewsByID(ctx, toID)
+	if err != nil {
+		return err
+	}
+	if err = checkTenant(ctx, to.TenantID, "newsUC.AddRelation"); err != nil {
+		return err
+	}
+	if err = checkVisible(ctx, to, "newsUC.AddRelation"); err != nil {
 		return err
 	}
 
-	if err = u.redisRepo.DeleteNewsCtx(ctx, u.getKeyWithPrefix(newsID.String())); err != nil {
-		u.logger.Errorf("newsUC.Delete.DeleteNewsCtx: %v", err)
+	added, err := u.newsRepo.AddRelation(ctx, fromID, toID)
+	if err != nil {
+		return err
+	}
+	if !added {
+		return errors.Wrap(httpErrors.DuplicateRelation, "newsUC.AddRelation")
 	}
 
+	u.deleteRelatedFromCache(ctx, fromID)
 	return nil
 }
 
-// Get news
-func (u *newsUC) GetNews(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetNews")
+// Remove link between news
+func (u *newsUC) RemoveRelation(ctx context.Context, fromID uuid.UUID, toID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.RemoveRelation")
 	defer span.Finish()
 
-	return u.newsRepo.GetNews(ctx, pq)
+	if err := u.checkCanCurate(ctx, fromID, "newsUC.RemoveRelation"); err != nil {
+		return err
+	}
+	if err := u.newsRepo.RemoveRelation(ctx, fromID, toID); err != nil {
+		return err
+	}
+
+	u.deleteRelatedFromCache(ctx, fromID)
+	return nil
 }
 
-// Find nes by title
-func (u *newsUC) SearchByTitle(ctx context.Context, title string, query *utils.PaginationQuery) (*models.NewsList, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.SearchByTitle")
+// Links of news are curated by editors or its author, within tenant of request and only from news caller may see
+func (u *newsUC) checkCanCurate(ctx context.Context, newsID uuid.UUID, op string) error {
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return httpErrors.NewUnauthorizedError(errors.WithMessage(err, op+".GetUserFromCtx"))
+	}
+	n, err := u.newsRepo.GetNewsByID(ctx, newsID)
+	if err != nil {
+		return err
+	}
+	if err = checkTenant(ctx, n.TenantID, op); err != nil {
+		return err
+	}
+	if err = checkVisible(ctx, n, op); err != nil {
+		return err
+	}
+	if n.AuthorID == user.UserID || isEditor(user) {
+		return nil
+	}
+	return httpErrors.NewRestError(http.StatusForbidden, "Forbidden", errors.Errorf("%s: news %s is curated by editors or its author", op, newsID))
+}
+
+func isEditor(user *models.User) bool {
+	if user.Role == nil {
+		return false
+	}
+	for _, role := range editorRoles {
+		if *user.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Get published news curated as related to given news
+func (u *newsUC) GetCuratedRelated(ctx context.Context, newsID uuid.UUID) ([]*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetCuratedRelated")
 	defer span.Finish()
 
-	return u.newsRepo.SearchByTitle(ctx, title, query)
+	if err := u.checkNewsVisible(ctx, newsID, "newsUC.GetCuratedRelated"); err != nil {
+		return nil, err
+	}
+
+	key := u.getRelatedKey(newsID)
+	cached, err := u.redisRepo.GetRelatedCtx(ctx, key)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		u.logger.Errorf("newsUC.GetCuratedRelated.GetRelatedCtx: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	related, err := u.newsRepo.GetCuratedRelated(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.redisRepo.SetRelatedCtx(ctx, key, relationsCacheDuration, related); err != nil {
+		u.logger.Errorf("newsUC.GetCuratedRelated.SetRelatedCtx: %v", err)
+	}
+
+	return related, nil
 }
 
-func (u *newsUC) getKeyWithPrefix(newsID string) string {
-	return fmt.Sprintf("%s: %s", basePrefix, newsID)
+func (u *newsUC) deleteRelatedFromCache(ctx context.Context, newsID uuid.UUID) {
+	if err := u.redisRepo.DeleteNewsCtx(ctx, u.getRelatedKey(newsID)); err != nil {
+		u.logger.Errorf("newsUC.deleteRelatedFromCache.DeleteNewsCtx: %v", err)
+	}
+}
+
+func (u *newsUC) getRelatedKey(newsID uuid.UUID) string {
+	return u.getKeyWithPrefix(fmt.Sprintf("%s:%s", relationsKey, newsID.String()))
+}
+
+// Get published news with highest trending score, blend of views and age
+func (u *newsUC) GetTrending(ctx context.Context, limit int) ([]*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetTrending")
+	defer span.Finish()
+
+	if limit < 0 {
+		return nil, httpErrors.NewBadRequestError(errors.New("limit must not be negative"))
+	}
+	if limit == 0 {
+		limit = trendingDefaultLimit
+	}
+	if limit > trendingMaxLimit {
+		limit = trendingMaxLimit
+	}
+
+	cacheKey := u.getTenantKeyWithPrefix(tenantOf(ctx), fmt.Sprintf("%s:%d", trendingKey, limit))
+	cached, err := u.redisRepo.GetTrendingCtx(ctx, cacheKey)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		u.logger.Errorf("newsUC.GetTrending.GetTrendingCtx: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	gravity := defaultTrendingGravity
+	if u.cfg.News.TrendingGravity > 0 {
+		gravity = u.cfg.News.TrendingGravity
+	}
+	trending, err := u.newsRepo.GetTrending(ctx, gravity, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.redisRepo.SetTrendingCtx(ctx, cacheKey, trendingCacheDuration, trending); err != nil {
+		u.logger.Errorf("newsUC.GetTrending.SetTrendingCtx: %v", err)
+	}
+
+	return trending, nil
+}
+
+// Convert news page to feed summaries, content is replaced by excerpt and authors of whole page are resolved in one query
+func (u *newsUC) Summarize(ctx context.Context, list *models.NewsList) (*models.NewsSummaryList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.Summarize")
+	defer span.Finish()
+
+	authors := newAuthorCache(u.authorRepo.GetByIDs)
+	if err := authors.Load(ctx, list.News, list.NextPreview); err != nil {
+		return nil, err
+	}
+
+	excerptLength := defaultExcerptLength
+	if u.cfg.News.ExcerptLength > 0 {
+		excerptLength = u.cfg.News.ExcerptLength
+	}
+
+	summarize := func(newsList []*models.News) []*models.NewsSummaryDTO {
+		summaries := make([]*models.NewsSummaryDTO, 0, len(newsList))
+		for _, n := range newsList {
+			summaries = append(summaries, &models.NewsSummaryDTO{
+				NewsID:    n.NewsID,
+				Title:     n.Title,
+				Excerpt:   utils.Excerpt(n.Content, excerptLength),
+				Category:  n.Category,
+				AuthorID:  n.AuthorID,
+				Author:    authors.Name(n.AuthorID),
+				ImageURL:  n.ImageURL,
+				CreatedAt: n.CreatedAt,
+			})
+		}
+		return summaries
+	}
+
+	summaryList := &models.NewsSummaryList{
+		TotalCount: list.TotalCount,
+		TotalPages: list.TotalPages,
+		Page:       list.Page,
+		Size:       list.Size,
+		HasMore:    list.HasMore,
+		News:       summarize(list.News),
+	}
+	if len(list.NextPreview) > 0 {
+		summaryList.NextPreview = summarize(list.NextPreview)
+	}
+	return summaryList, nil
+}
+
+// Reject update changing any of News.ImmutableFields, given value equal to stored one is no change.
+// Created at is not loaded with news, so any given value is rejected
+func (u *newsUC) checkImmutableFields(news *models.News, existing *models.NewsBase) error {
+	fields := u.cfg.News.ImmutableFields
+	if len(fields) == 0 {
+		fields = defaultImmutableFields
+	}
+
+	for _, field := range fields {
+		changed, ok := immutableNewsFields[field]
+		if !ok {
+			u.logger.Warnf("newsUC.checkImmutableFields unknown immutable field %q", field)
+			continue
+		}
+		if changed(news, existing) {
+			message := fmt.Sprintf("%s: %s", httpErrors.ImmutableField.Error(), field)
+			return httpErrors.NewRestError(http.StatusBadRequest, message, errors.Wrapf(httpErrors.ImmutableField, "newsUC.checkImmutableFields %s", field))
+		}
+	}
+	return nil
+}
+
+// Reject title and content with invalid UTF-8, or replace invalid bytes when News.SanitizeInvalidUTF8 is set
+func (u *newsUC) checkEncoding(news *models.News, op string) error {
+	if utf8.ValidString(news.Title) && utf8.ValidString(news.Content) {
+		return nil
+	}
+	if u.cfg.News.SanitizeInvalidUTF8 {
+		news.Title = strings.ToValidUTF8(news.Title, string(utf8.RuneError))
+		news.Content = strings.ToValidUTF8(news.Content, string(utf8.RuneError))
+		return nil
+	}
+	return errors.Wrapf(httpErrors.ErrInvalidEncoding, "%s.checkEncoding", op)
+}
+
+// Take advisory edit lock of news for current user, holder renews it by acquiring again. Only author of news,
+// the one who may update it, can lock it. Zero ttl uses configured one, ttl is capped at one hour
+func (u *newsUC) AcquireEditLock(ctx context.Context, newsID uuid.UUID, ttl time.Duration) (*models.NewsEditLock, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.AcquireEditLock")
+	defer span.Finish()
+
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, httpErrors.NewUnauthorizedError(errors.WithMessage(err, "newsUC.AcquireEditLock.GetUserFromCtx"))
+	}
+
+	if ttl < 0 {
+		return nil, httpErrors.NewBadRequestError(errors.New("ttl must not be negative"))
+	}
+	if ttl == 0 {
+		ttl = defaultEditLockTTL
+		if u.cfg.News.EditLockTTL > 0 {
+			ttl = u.cfg.News.EditLockTTL
+		}
+	}
+	if ttl > maxEditLockTTL {
+		ttl = maxEditLockTTL
+	}
+
+	newsByID, err := u.newsRepo.GetNewsByID(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkTenant(ctx, newsByID.TenantID, "newsUC.AcquireEditLock"); err != nil {
+		return nil, err
+	}
+	if err = utils.ValidateIsOwner(ctx, newsByID.AuthorID.String(), u.logger); err != nil {
+		return nil, httpErrors.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "newsUC.AcquireEditLock.ValidateIsOwner"))
+	}
+
+	acquired, err := u.redisRepo.AcquireEditLockCtx(ctx, u.getEditLockKey(newsID), user.UserID.String(), ttl)
+	if err != nil {
+		return nil, err
+	}
+	if !acquired {
+		return nil, errors.Wrapf(httpErrors.EditLocked, "newsUC.AcquireEditLock: news %s", newsID)
+	}
+
+	expiresAt := time.Now().Add(ttl).UTC()
+	return &models.NewsEditLock{NewsID: newsID, Locked: true, UserID: &user.UserID, ExpiresAt: &expiresAt}, nil
+}
+
+// Release edit lock of current user, releasing free lock is no-op, lock of other user can not be released
+func (u *newsUC) ReleaseEditLock(ctx context.Context, newsID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.ReleaseEditLock")
+	defer span.Finish()
+
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return httpErrors.NewUnauthorizedError(errors.WithMessage(err, "newsUC.ReleaseEditLock.GetUserFromCtx"))
+	}
+
+	released, err := u.redisRepo.ReleaseEditLockCtx(ctx, u.getEditLockKey(newsID), user.UserID.String())
+	if err != nil {
+		return err
+	}
+	if released {
+		return nil
+	}
+
+	lock, err := u.getEditLock(ctx, newsID)
+	if err != nil {
+		return err
+	}
+	if lock.Locked {
+		return errors.Wrapf(httpErrors.EditLocked, "newsUC.ReleaseEditLock: news %s", newsID)
+	}
+	return nil
+}
+
+// Get who holds edit lock of news and until when, lock of news caller may not see is not found
+func (u *newsUC) GetEditLock(ctx context.Context, newsID uuid.UUID) (*models.NewsEditLock, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetEditLock")
+	defer span.Finish()
+
+	if err := u.checkNewsVisible(ctx, newsID, "newsUC.GetEditLock"); err != nil {
+		return nil, err
+	}
+
+	return u.getEditLock(ctx, newsID)
+}
+
+func (u *newsUC) getEditLock(ctx context.Context, newsID uuid.UUID) (*models.NewsEditLock, error) {
+	holder, ttl, err := u.redisRepo.GetEditLockCtx(ctx, u.getEditLockKey(newsID))
+	if err != nil {
+		return nil, err
+	}
+
+	lock := &models.NewsEditLock{NewsID: newsID}
+	if holder == "" {
+		return lock, nil
+	}
+	userID, err := uuid.Parse(holder)
+	if err != nil {
+		return nil, errors.Wrap(err, "newsUC.getEditLock.uuid.Parse")
+	}
+	lock.Locked = true
+	lock.UserID = &userID
+	if ttl > 0 {
+		expiresAt := time.Now().Add(ttl).UTC()
+		lock.ExpiresAt = &expiresAt
+	}
+	return lock, nil
+}
+
+func (u *newsUC) getEditLockKey(newsID uuid.UUID) string {
+	return u.getKeyWithPrefix(fmt.Sprintf("%s:%s", editLockKey, newsID.String()))
+}
+
+// Drop empty and repeated values and sort the rest, so same set gives same cache key
+func uniqueSorted(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	unique := make([]string, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if _, ok := seen[v]; ok || v == "" {
+			continue
+		}
+		seen[v] = struct{}{}
+		unique = append(unique, v)
+	}
+	sort.Strings(unique)
+	return unique
+}
+
+// Get cached news by slugs, news whose slug changed since caching are left out
+func (u *newsUC) getBySlugsFromCache(ctx context.Context, slugs []string) map[string]*models.NewsBase {
+	slugKeys := make([]string, 0, len(slugs))
+	for _, slug := range slugs {
+		slugKeys = append(slugKeys, u.getSlugKey(ctx, slug))
+	}
+	ids, err := u.redisRepo.GetNewsIDsCtx(ctx, slugKeys)
+	if err != nil {
+		u.logger.Errorf("newsUC.GetBySlugs.GetNewsIDsCtx: %v", err)
+		return nil
+	}
+
+	cachedSlugs := make([]string, 0, len(ids))
+	newsKeys := make([]string, 0, len(ids))
+	for i, id := range ids {
+		if id != "" {
+			cachedSlugs = append(cachedSlugs, slugs[i])
+			newsKeys = append(newsKeys, u.getKeyWithPrefix(id))
+		}
+	}
+	if len(newsKeys) == 0 {
+		return nil
+	}
+
+	news, err := u.redisRepo.GetNewsByKeysCtx(ctx, newsKeys)
+	if err != nil {
+		u.logger.Errorf("newsUC.GetBySlugs.GetNewsByKeysCtx: %v", err)
+		return nil
+	}
+
+	found := make(map[string]*models.NewsBase, len(news))
+	for i, n := range news {
+		if n != nil && n.Slug != nil && *n.Slug == cachedSlugs[i] && n.Status == models.NewsStatusPublished &&
+			checkTenant(ctx, n.TenantID, "newsUC.GetBySlugs") == nil {
+			found[cachedSlugs[i]] = n
+		}
+	}
+	return found
+}
+
+func (u *newsUC) getSlugKey(ctx context.Context, slug string) string {
+	return u.getTenantKeyWithPrefix(tenantOf(ctx), fmt.Sprintf("%s:%s", slugKey, slug))
+}
+
+// Delete news
+func (u *newsUC) Delete(ctx context.Context, newsID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.Delete")
+	defer span.Finish()
+
+	newsByID, err := u.newsRepo.GetNewsByID(ctx, newsID)
+	if err != nil {
+		return err
+	}
+	if err = checkTenant(ctx, newsByID.TenantID, "newsUC.Delete"); err != nil {
+		return err
+	}
+
+	if err = utils.ValidateIsOwner(ctx, newsByID.AuthorID.String(), u.logger); err != nil {
+		return httpErrors.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "newsUC.Delete.ValidateIsOwner"))
+	}
+
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return httpErrors.NewUnauthorizedError(errors.WithMessage(err, "newsUC.Delete.GetUserFromCtx"))
+	}
+
+	if err = u.newsRepo.Delete(ctx, newsID, user.UserID); err != nil {
+		return err
+	}
+
+	if err = u.redisRepo.DeleteNewsCtx(ctx, u.getKeyWithPrefix(newsID.String())); err != nil {
+		u.logger.Errorf("newsUC.Delete.DeleteNewsCtx: %v", err)
+	}
+	u.deleteAuthorStatusCountsFromCache(ctx, newsByID.AuthorID, "newsUC.Delete.DeleteNewsCtx")
+
+	return nil
+}
+
+// Get news
+func (u *newsUC) GetNews(ctx context.Context, pq *utils.PaginationQuery, params url.Values) (*models.NewsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetNews")
+	defer span.Finish()
+
+	pq.Resolve(u.cfg.Pagination)
+
+	if err := pq.CheckResultWindow(u.cfg.Server.MaxResultWindow); err != nil {
+		return nil, errors.WithMessage(err, "newsUC.GetNews.CheckResultWindow")
+	}
+
+	lq, err := u.parseNewsList(ctx, params, pq)
+	if err != nil {
+		return nil, err
+	}
+
+	if prefetch := params.Get(prefetchNextParam); prefetch != "" {
+		prefetchNext, err := strconv.ParseBool(prefetch)
+		if err != nil {
+			return nil, httpErrors.NewBadRequestError(errors.Wrapf(err, "invalid query param %q", prefetchNextParam))
+		}
+		if prefetchNext {
+			lq.Prefetch = nextPreviewSize
+		}
+	}
+	if exact := params.Get(exactCountParam); exact != "" {
+		exactCount, err := strconv.ParseBool(exact)
+		if err != nil {
+			return nil, httpErrors.NewBadRequestError(errors.Wrapf(err, "invalid query param %q", exactCountParam))
+		}
+		// Statistics know nothing of filters, filtered lists are always counted
+		lq.EstimateCount = !exactCount && len(lq.Conditions) == 0
+	}
+
+	if u.cfg.News.ListCacheTTL <= 0 {
+		return u.newsRepo.GetNews(ctx, lq, pq)
+	}
+
+	cacheKey := u.getKeyWithPrefix(buildListCacheKey(pq, params, tenantOf(ctx)))
+	cached, err := u.redisRepo.GetNewsListCtx(ctx, cacheKey)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		u.logger.Errorf("newsUC.GetNews.GetNewsListCtx: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	newsList, err := u.newsRepo.GetNews(ctx, lq, pq)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.redisRepo.SetNewsListCtx(ctx, cacheKey, int(u.cfg.News.ListCacheTTL/time.Second), newsList); err != nil {
+		u.logger.Errorf("newsUC.GetNews.SetNewsListCtx: %v", err)
+	}
+
+	return newsList, nil
+}
+
+// Get ids of news list page, accepts the same filters and order as GetNews
+func (u *newsUC) GetNewsIDs(ctx context.Context, pq *utils.PaginationQuery, params url.Values) (*models.NewsIDList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetNewsIDs")
+	defer span.Finish()
+
+	pq.Resolve(u.cfg.Pagination)
+
+	if err := pq.CheckResultWindow(u.cfg.Server.MaxResultWindow); err != nil {
+		return nil, errors.WithMessage(err, "newsUC.GetNewsIDs.CheckResultWindow")
+	}
+
+	lq, err := u.parseNewsList(ctx, params, pq)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.newsRepo.GetNewsIDs(ctx, lq, pq)
+}
+
+// Params which do not change news list content, pagination ones are taken resolved from pagination query instead
+var listCacheIgnoredParams = map[string]struct{}{
+	"page": {}, "size": {}, "orderBy": {}, "direction": {}, "tz": {}, "strict": {},
+}
+
+// Cache key of news list page covering every param which changes result, tenant scoped pages never share key with other tenants.
+// Params and values of repeated params are sorted, so equivalent queries share one key whatever order they come in
+func buildListCacheKey(pq *utils.PaginationQuery, filters url.Values, tenantID *uuid.UUID) string {
+	canonical := make(url.Values, len(filters)+4)
+	for name, values := range filters {
+		if _, ok := listCacheIgnoredParams[name]; ok {
+			continue
+		}
+		sorted := append([]string(nil), values...)
+		sort.Strings(sorted)
+		canonical[name] = sorted
+	}
+	canonical.Set("page", strconv.Itoa(pq.GetPage()))
+	canonical.Set("size", strconv.Itoa(pq.GetSize()))
+	canonical.Set("orderBy", pq.GetOrderBy())
+	canonical.Set("direction", pq.GetDirection())
+	if tenantID != nil {
+		canonical.Set("tenant", tenantID.String())
+	}
+
+	sum := sha256.Sum256([]byte(canonical.Encode()))
+	return fmt.Sprintf("%s:%s", newsListKey, hex.EncodeToString(sum[:]))
+}
+
+// Parse news list filters, categories filter must name categories in use.
+// Configured composed default sort applies unless request orders explicitly
+func (u *newsUC) parseNewsList(ctx context.Context, params url.Values, pq *utils.PaginationQuery) (*utils.ListQuery, error) {
+	lq, err := newsListSpec.Parse(params, pq)
+	if err != nil {
+		return nil, err
+	}
+	if tenantID := tenantOf(ctx); tenantID != nil {
+		lq.Conditions = append(lq.Conditions, utils.ListCondition{Column: "tenant_id", Operator: "=", Value: *tenantID})
+	}
+
+	if pq.GetOrderBy() == "" && u.cfg.Pagination.DefaultSort != "" {
+		if lq.Sorts, err = newsListSpec.ParseSort(u.cfg.Pagination.DefaultSort); err != nil {
+			return nil, errors.Wrap(err, "newsUC.parseNewsList.ParseSort.Pagination.DefaultSort")
+		}
+	}
+
+	// Both are valid ints once spec parsed them
+	if params.Get("min_words") != "" && params.Get("max_words") != "" {
+		minWords, _ := strconv.Atoi(params.Get("min_words"))
+		maxWords, _ := strconv.Atoi(params.Get("max_words"))
+		if minWords > maxWords {
+			return nil, httpErrors.NewBadRequestError(errors.New("min_words must not be greater than max_words"))
+		}
+	}
+
+	if categories := params["categories"]; len(categories) > 0 {
+		unknown, err := u.newsRepo.GetUnknownCategories(ctx, categories)
+		if err != nil {
+			return nil, err
+		}
+		if len(unknown) > 0 {
+			return nil, httpErrors.NewBadRequestError(errors.Errorf("unknown categories: %s", strings.Join(unknown, ", ")))
+		}
+	}
+
+	return lq, nil
+}
+
+// Find news by title, scope content or all matches content words too. Empty scope searches title only
+func (u *newsUC) SearchByTitle(ctx context.Context, title string, scope string, query *utils.PaginationQuery) (*models.NewsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.SearchByTitle")
+	defer span.Finish()
+
+	searchScope, err := parseSearchScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	query.Resolve(u.cfg.Pagination)
+
+	if err := query.CheckResultWindow(u.cfg.Server.MaxResultWindow); err != nil {
+		return nil, errors.WithMessage(err, "newsUC.SearchByTitle.CheckResultWindow")
+	}
+
+	title = utils.NormalizeSpaces(title)
+	if title == "" {
+		if !u.cfg.News.EmptySearchListsAll {
+			return nil, errors.WithMessage(httpErrors.ErrEmptySearchQuery, "newsUC.SearchByTitle")
+		}
+		lq, err := u.parseNewsList(ctx, nil, query)
+		if err != nil {
+			return nil, err
+		}
+		return u.newsRepo.GetNews(ctx, lq, query)
+	}
+
+	return u.newsRepo.SearchByTitle(ctx, title, searchScope, query)
+}
+
+// Count news search would find, with the same validation as search. Empty query counts all published news when configured
+func (u *newsUC) CountSearchByTitle(ctx context.Context, title string, scope string) (*models.NewsSearchCount, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.CountSearchByTitle")
+	defer span.Finish()
+
+	searchScope, err := parseSearchScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	title = utils.NormalizeSpaces(title)
+	if title == "" {
+		if !u.cfg.News.EmptySearchListsAll {
+			return nil, errors.WithMessage(httpErrors.ErrEmptySearchQuery, "newsUC.CountSearchByTitle")
+		}
+		// Empty title pattern matches every published news, same set empty search lists
+		searchScope = models.SearchScopeTitle
+	}
+
+	totalCount, err := u.newsRepo.CountSearchByTitle(ctx, title, searchScope)
+	if err != nil {
+		return nil, err
+	}
+	return &models.NewsSearchCount{TotalCount: totalCount}, nil
+}
+
+// Tenant request is scoped to, nil outside of multi tenant deployment
+func tenantOf(ctx context.Context) *uuid.UUID {
+	tenantID, ok := utils.GetTenantFromCtx(ctx)
+	if !ok {
+		return nil
+	}
+	return &tenantID
+}
+
+// Reject access to news of other tenant than the one request is scoped to
+func checkTenant(ctx context.Context, newsTenantID *uuid.UUID, op string) error {
+	tenantID := tenantOf(ctx)
+	if tenantID == nil || (newsTenantID != nil && *newsTenantID == *tenantID) {
+		return nil
+	}
+	return httpErrors.NewRestError(http.StatusForbidden, "Forbidden", errors.Errorf("%s: news belongs to other tenant", op))
+}
+
+// Reject batch which touches news of other tenant than the one request is scoped to
+func (u *newsUC) checkBatchTenant(ctx context.Context, ids []uuid.UUID, op string) error {
+	tenantID := tenantOf(ctx)
+	if tenantID == nil {
+		return nil
+	}
+	outside, err := u.newsRepo.GetOutsideTenant(ctx, ids, *tenantID)
+	if err != nil {
+		return err
+	}
+	if len(outside) > 0 {
+		return httpErrors.NewRestError(http.StatusForbidden, "Forbidden", errors.Errorf("%s: %d news belong to other tenant", op, len(outside)))
+	}
+	return nil
+}
+
+// News which is not published is not found for everyone except its author, preview token is the way to share it
+func checkVisible(ctx context.Context, n *models.NewsBase, op string) error {
+	if n.Status == models.NewsStatusPublished {
+		return nil
+	}
+	if user, err := utils.GetUserFromCtx(ctx); err == nil && user.UserID == n.AuthorID {
+		return nil
+	}
+	return httpErrors.NewNotFoundError(errors.Errorf("%s: news is not published", op))
+}
+
+// Check news of sub resource is visible to caller, see checkVisible
+func (u *newsUC) checkNewsVisible(ctx context.Context, newsID uuid.UUID, op string) error {
+	n, err := u.getNewsByIDCached(ctx, newsID)
+	if err != nil {
+		return err
+	}
+	if err = checkTenant(ctx, n.TenantID, op); err != nil {
+		return err
+	}
+	return checkVisible(ctx, n, op)
+}
+
+func parseSearchScope(scope string) (models.SearchScope, error) {
+	switch searchScope := models.SearchScope(scope); searchScope {
+	case "":
+		return models.SearchScopeTitle, nil
+	case models.SearchScopeTitle, models.SearchScopeContent, models.SearchScopeAll:
+		return searchScope, nil
+	default:
+		return "", httpErrors.NewBadRequestError(errors.Errorf("scope must be one of title, content, all, got %q", scope))
+	}
+}
+
+// Get news count per month
+func (u *newsUC) GetTimeline(ctx context.Context) ([]*models.TimelineBucket, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetTimeline")
+	defer span.Finish()
+
+	timeline, err := u.redisRepo.GetTimelineCtx(ctx, u.getTenantKeyWithPrefix(tenantOf(ctx), timelineKey))
+	if err != nil && !errors.Is(err, redis.Nil) {
+		u.logger.Errorf("newsUC.GetTimeline.GetTimelineCtx: %v", err)
+	}
+	if timeline != nil {
+		return timeline, nil
+	}
+
+	timeline, err = u.newsRepo.GetTimeline(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.redisRepo.SetTimelineCtx(ctx, u.getTenantKeyWithPrefix(tenantOf(ctx), timelineKey), timelineCacheDuration, timeline); err != nil {
+		u.logger.Errorf("newsUC.GetTimeline.SetTimelineCtx: %s", err)
+	}
+
+	return timeline, nil
+}
+
+// Get previous and next news
+func (u *newsUC) GetNeighbors(ctx context.Context, newsID uuid.UUID) (*models.News, *models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetNeighbors")
+	defer span.Finish()
+
+	if err := u.checkNewsVisible(ctx, newsID, "newsUC.GetNeighbors"); err != nil {
+		return nil, nil, err
+	}
+
+	return u.newsRepo.GetNeighbors(ctx, newsID)
+}
+
+// Change status of many news
+func (u *newsUC) UpdateStatusBatch(ctx context.Context, ids []uuid.UUID, status string, dryRun bool) (*models.NewsBatchResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.UpdateStatusBatch")
+	defer span.Finish()
+
+	if len(ids) == 0 {
+		return nil, httpErrors.NewBadRequestError(errors.New("newsUC.UpdateStatusBatch: empty ids"))
+	}
+	if err := utils.ValidateUUIDs(utils.UUIDFields("ids", ids)...); err != nil {
+		return nil, err
+	}
+	if !models.IsValidNewsStatus(status) {
+		return nil, httpErrors.NewBadRequestError(errors.Errorf("newsUC.UpdateStatusBatch: invalid status %q", status))
+	}
+	if err := u.checkBatchTenant(ctx, ids, "newsUC.UpdateStatusBatch"); err != nil {
+		return nil, err
+	}
+
+	affected, err := u.newsRepo.UpdateStatusBatch(ctx, ids, status, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	if !dryRun {
+		u.deleteNewsFromCache(ctx, affected, "newsUC.UpdateStatusBatch.DeleteNewsCtx")
+	}
+
+	return &models.NewsBatchResult{Affected: len(affected), IDs: affected, DryRun: dryRun}, nil
+}
+
+// Get draft news of current user
+func (u *newsUC) GetMyDrafts(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetMyDrafts")
+	defer span.Finish()
+
+	pq.Resolve(u.cfg.Pagination)
+
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, httpErrors.NewUnauthorizedError(errors.WithMessage(err, "newsUC.GetMyDrafts.GetUserFromCtx"))
+	}
+
+	return u.newsRepo.GetMyDrafts(ctx, user.UserID, pq)
+}
+
+// Get news current user has edited, most recently edited first
+func (u *newsUC) GetEditedByMe(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetEditedByMe")
+	defer span.Finish()
+
+	pq.Resolve(u.cfg.Pagination)
+
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, httpErrors.NewUnauthorizedError(errors.WithMessage(err, "newsUC.GetEditedByMe.GetUserFromCtx"))
+	}
+
+	return u.newsRepo.GetEditedBy(ctx, user.UserID, pq)
+}
+
+// Stream every news of author row by row, access is checked by route
+func (u *newsUC) StreamAuthorNews(ctx context.Context, authorID uuid.UUID, fn func(n *models.News) error) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.StreamAuthorNews")
+	defer span.Finish()
+
+	return u.newsRepo.StreamAuthorNews(ctx, authorID, fn)
+}
+
+// Get news whose author no longer exists
+func (u *newsUC) GetOrphaned(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetOrphaned")
+	defer span.Finish()
+
+	pq.Resolve(u.cfg.Pagination)
+
+	return u.newsRepo.GetOrphaned(ctx, pq)
+}
+
+// Get news flagged by image check with broken image url
+func (u *newsUC) GetBrokenImages(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetBrokenImages")
+	defer span.Finish()
+
+	pq.Resolve(u.cfg.Pagination)
+
+	return u.newsRepo.GetBrokenImages(ctx, pq)
+}
+
+// Reassign orphaned news to a new author
+func (u *newsUC) ReassignAuthor(ctx context.Context, ids []uuid.UUID, authorID uuid.UUID, dryRun bool) (*models.NewsBatchResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.ReassignAuthor")
+	defer span.Finish()
+
+	if len(ids) == 0 {
+		return nil, httpErrors.NewBadRequestError(errors.New("newsUC.ReassignAuthor: empty ids"))
+	}
+	fields := append(utils.UUIDFields("ids", ids), utils.UUIDField{Name: "author_id", Value: authorID})
+	if err := utils.ValidateUUIDs(fields...); err != nil {
+		return nil, err
+	}
+	if err := u.checkBatchTenant(ctx, ids, "newsUC.ReassignAuthor"); err != nil {
+		return nil, err
+	}
+
+	affected, err := u.newsRepo.ReassignAuthor(ctx, ids, authorID, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	if !dryRun {
+		u.deleteNewsFromCache(ctx, affected, "newsUC.ReassignAuthor.DeleteNewsCtx")
+		if len(affected) > 0 {
+			u.deleteAuthorStatusCountsFromCache(ctx, authorID, "newsUC.ReassignAuthor.DeleteNewsCtx")
+		}
+	}
+
+	return &models.NewsBatchResult{Affected: len(affected), IDs: affected, DryRun: dryRun}, nil
+}
+
+// Get rendered rss or atom feed of recent news, optionally filtered by category
+func (u *newsUC) GetFeed(ctx context.Context, category string, format string, baseURL string) ([]byte, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetFeed")
+	defer span.Finish()
+
+	if format != feed.FormatRSS && format != feed.FormatAtom {
+		return nil, httpErrors.NewBadRequestError(errors.Errorf("newsUC.GetFeed: invalid format %q", format))
+	}
+
+	key := u.getTenantKeyWithPrefix(tenantOf(ctx), fmt.Sprintf("%s:%s:%s:%s", feedKey, format, category, baseURL))
+	cached, err := u.redisRepo.GetFeedCtx(ctx, key)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		u.logger.Errorf("newsUC.GetFeed.GetFeedCtx: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	recent, err := u.newsRepo.GetRecent(ctx, category, feedSize)
+	if err != nil {
+		return nil, err
+	}
+
+	newsFeed := &feed.Feed{
+		Title:       "News",
+		Link:        baseURL + "/api/v1/news",
+		Description: "Recently published news",
+		Items:       make([]*feed.Item, 0, len(recent)),
+	}
+	if category != "" {
+		newsFeed.Title = fmt.Sprintf("News: %s", category)
+		newsFeed.Description = fmt.Sprintf("Recently published news in %s category", category)
+	}
+	for _, n := range recent {
+		item := &feed.Item{
+			ID:          fmt.Sprintf("urn:uuid:%s", n.NewsID),
+			Title:       n.Title,
+			Link:        fmt.Sprintf("%s/api/v1/news/%s", baseURL, n.NewsID),
+			Description: n.Content,
+			Published:   n.CreatedAt,
+			Updated:     n.UpdatedAt,
+		}
+		if n.Category != nil {
+			item.Category = *n.Category
+		}
+		if item.Updated.Before(item.Published) {
+			item.Updated = item.Published
+		}
+		if item.Updated.After(newsFeed.Updated) {
+			newsFeed.Updated = item.Updated
+		}
+		newsFeed.Items = append(newsFeed.Items, item)
+	}
+
+	var rendered []byte
+	if format == feed.FormatAtom {
+		rendered, err = feed.RenderAtom(newsFeed)
+	} else {
+		rendered, err = feed.RenderRSS(newsFeed)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.redisRepo.SetFeedCtx(ctx, key, feedCacheDuration, rendered); err != nil {
+		u.logger.Errorf("newsUC.GetFeed.SetFeedCtx: %s", err)
+	}
+
+	return rendered, nil
+}
+
+// Get published news ordered by last update
+func (u *newsUC) GetRecentlyUpdated(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetRecentlyUpdated")
+	defer span.Finish()
+
+	pq.Resolve(u.cfg.Pagination)
+
+	if err := pq.CheckResultWindow(u.cfg.Server.MaxResultWindow); err != nil {
+		return nil, errors.WithMessage(err, "newsUC.GetRecentlyUpdated.CheckResultWindow")
+	}
+
+	return u.newsRepo.GetRecentlyUpdated(ctx, pq)
+}
+
+// Get published news without comments
+func (u *newsUC) GetWithoutComments(ctx context.Context, pq *utils.PaginationQuery) (*models.NewsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetWithoutComments")
+	defer span.Finish()
+
+	pq.Resolve(u.cfg.Pagination)
+
+	if err := pq.CheckResultWindow(u.cfg.Server.MaxResultWindow); err != nil {
+		return nil, errors.WithMessage(err, "newsUC.GetWithoutComments.CheckResultWindow")
+	}
+
+	return u.newsRepo.GetWithoutComments(ctx, pq)
+}
+
+// Create signed expiring preview token, only news author can share a preview
+func (u *newsUC) CreatePreviewToken(ctx context.Context, newsID uuid.UUID) (*models.NewsPreviewToken, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.CreatePreviewToken")
+	defer span.Finish()
+
+	newsByID, err := u.newsRepo.GetNewsByID(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ValidateIsOwner(ctx, newsByID.AuthorID.String(), u.logger); err != nil {
+		return nil, httpErrors.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "newsUC.CreatePreviewToken.ValidateIsOwner"))
+	}
+
+	expiresAt := time.Now().Add(u.cfg.Server.PreviewTokenTTL)
+	return &models.NewsPreviewToken{
+		Token:     preview.MakeToken(newsID, expiresAt, u.cfg.Server.PreviewSecretKey),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// Get news by id with preview token regardless of its status, bypasses cache
+func (u *newsUC) GetPreview(ctx context.Context, newsID uuid.UUID, token string) (*models.NewsBase, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetPreview")
+	defer span.Finish()
+
+	if err := preview.ValidateToken(token, newsID, u.cfg.Server.PreviewSecretKey, time.Now()); err != nil {
+		return nil, errors.Wrap(err, "newsUC.GetPreview.ValidateToken")
+	}
+
+	n, err := u.newsRepo.GetNewsByID(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.withMissingAuthor(u.withReadingTime(n)), nil
+}
+
+// Stream published news page row by row
+func (u *newsUC) StreamNews(ctx context.Context, pq *utils.PaginationQuery, params url.Values, fn func(n *models.News) error) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.StreamNews")
+	defer span.Finish()
+
+	pq.Resolve(u.cfg.Pagination)
+
+	if err := pq.CheckResultWindow(u.cfg.Server.MaxResultWindow); err != nil {
+		return errors.WithMessage(err, "newsUC.StreamNews.CheckResultWindow")
+	}
+
+	lq, err := u.parseNewsList(ctx, params, pq)
+	if err != nil {
+		return err
+	}
+
+	return u.newsRepo.StreamNews(ctx, lq, pq, fn)
+}
+
+// Stream published news page found within search scope row by row
+func (u *newsUC) StreamSearchByTitle(ctx context.Context, title string, scope string, pq *utils.PaginationQuery, fn func(n *models.News) error) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.StreamSearchByTitle")
+	defer span.Finish()
+
+	searchScope, err := parseSearchScope(scope)
+	if err != nil {
+		return err
+	}
+
+	pq.Resolve(u.cfg.Pagination)
+
+	if err := pq.CheckResultWindow(u.cfg.Server.MaxResultWindow); err != nil {
+		return errors.WithMessage(err, "newsUC.StreamSearchByTitle.CheckResultWindow")
+	}
+
+	title = utils.NormalizeSpaces(title)
+	if title == "" {
+		if !u.cfg.News.EmptySearchListsAll {
+			return errors.WithMessage(httpErrors.ErrEmptySearchQuery, "newsUC.StreamSearchByTitle")
+		}
+		lq, err := u.parseNewsList(ctx, nil, pq)
+		if err != nil {
+			return err
+		}
+		return u.newsRepo.StreamNews(ctx, lq, pq, fn)
+	}
+
+	return u.newsRepo.StreamSearchByTitle(ctx, title, searchScope, pq, fn)
+}
+
+// Pin news to featured list, nil until pins forever
+func (u *newsUC) Pin(ctx context.Context, newsID uuid.UUID, until *time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.Pin")
+	defer span.Finish()
+
+	if until != nil && !until.After(time.Now()) {
+		return httpErrors.NewBadRequestError(errors.New("pinned_until must be in the future"))
+	}
+
+	newsByID, err := u.getNewsByIDCached(ctx, newsID)
+	if err != nil {
+		return err
+	}
+	if err = checkTenant(ctx, newsByID.TenantID, "newsUC.Pin"); err != nil {
+		return err
+	}
+
+	if err = u.newsRepo.Pin(ctx, newsID, until); err != nil {
+		return err
+	}
+
+	u.deleteFeaturedFromCache(ctx, newsByID.TenantID, "newsUC.Pin.DeleteNewsCtx")
+
+	return nil
+}
+
+// Remove news from featured list
+func (u *newsUC) Unpin(ctx context.Context, newsID uuid.UUID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.Unpin")
+	defer span.Finish()
+
+	newsByID, err := u.getNewsByIDCached(ctx, newsID)
+	if err != nil {
+		return err
+	}
+	if err = checkTenant(ctx, newsByID.TenantID, "newsUC.Unpin"); err != nil {
+		return err
+	}
+
+	if err = u.newsRepo.Unpin(ctx, newsID); err != nil {
+		return err
+	}
+
+	u.deleteFeaturedFromCache(ctx, newsByID.TenantID, "newsUC.Unpin.DeleteNewsCtx")
+
+	return nil
+}
+
+// Featured news of tenant are also listed to unscoped requests, so both cached lists are dropped
+func (u *newsUC) deleteFeaturedFromCache(ctx context.Context, tenantID *uuid.UUID, op string) {
+	keys := []string{u.getKeyWithPrefix(featuredKey)}
+	if tenantID != nil {
+		keys = append(keys, u.getTenantKeyWithPrefix(tenantID, featuredKey))
+	}
+	for _, key := range keys {
+		if err := u.redisRepo.DeleteNewsCtx(ctx, key); err != nil {
+			u.logger.Errorf("%s: %v", op, err)
+		}
+	}
+}
+
+// Get featured news, pins expired while cached are dropped on read
+func (u *newsUC) GetFeatured(ctx context.Context) ([]*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetFeatured")
+	defer span.Finish()
+
+	now := time.Now()
+
+	cached, err := u.redisRepo.GetFeaturedCtx(ctx, u.getTenantKeyWithPrefix(tenantOf(ctx), featuredKey))
+	if err != nil && !errors.Is(err, redis.Nil) {
+		u.logger.Errorf("newsUC.GetFeatured.GetFeaturedCtx: %v", err)
+	}
+	if cached != nil {
+		return filterPinned(cached, now), nil
+	}
+
+	featured, err := u.newsRepo.GetFeatured(ctx, featuredSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.redisRepo.SetFeaturedCtx(ctx, u.getTenantKeyWithPrefix(tenantOf(ctx), featuredKey), featuredCacheTTL(featured, now), featured); err != nil {
+		u.logger.Errorf("newsUC.GetFeatured.SetFeaturedCtx: %v", err)
+	}
+
+	return featured, nil
+}
+
+// Keep only news still pinned at given moment
+func filterPinned(list []*models.News, now time.Time) []*models.News {
+	pinned := make([]*models.News, 0, len(list))
+	for _, n := range list {
+		if n.IsPinned(now) {
+			pinned = append(pinned, n)
+		}
+	}
+	return pinned
+}
+
+// Featured cache lives no longer than the nearest pin expiry
+func featuredCacheTTL(list []*models.News, now time.Time) int {
+	ttl := featuredCacheDuration
+	for _, n := range list {
+		if n.PinnedUntil == nil {
+			continue
+		}
+		if left := int(n.PinnedUntil.Sub(now) / time.Second); left < ttl {
+			ttl = left
+		}
+	}
+	if ttl < 1 {
+		ttl = 1
+	}
+	return ttl
+}
+
+// Get revision history of news, only author can see it
+func (u *newsUC) GetRevisions(ctx context.Context, newsID uuid.UUID) ([]*models.NewsRevision, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetRevisions")
+	defer span.Finish()
+
+	newsByID, err := u.newsRepo.GetNewsByID(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ValidateIsOwner(ctx, newsByID.AuthorID.String(), u.logger); err != nil {
+		return nil, httpErrors.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "newsUC.GetRevisions.ValidateIsOwner"))
+	}
+
+	return u.newsRepo.GetRevisions(ctx, newsID)
+}
+
+// Get full past snapshot of news, only author can see it
+func (u *newsUC) GetRevision(ctx context.Context, revisionID uuid.UUID) (*models.NewsRevisionSnapshot, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetRevision")
+	defer span.Finish()
+
+	revision, err := u.newsRepo.GetRevision(ctx, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	newsByID, err := u.newsRepo.GetNewsByID(ctx, revision.NewsID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ValidateIsOwner(ctx, newsByID.AuthorID.String(), u.logger); err != nil {
+		return nil, httpErrors.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "newsUC.GetRevision.ValidateIsOwner"))
+	}
+
+	return revision, nil
+}
+
+// Revert news to past revision, only author can revert
+func (u *newsUC) RevertTo(ctx context.Context, newsID uuid.UUID, revisionID uuid.UUID) (*models.News, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.RevertTo")
+	defer span.Finish()
+
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, httpErrors.NewUnauthorizedError(errors.WithMessage(err, "newsUC.RevertTo.GetUserFromCtx"))
+	}
+
+	newsByID, err := u.newsRepo.GetNewsByID(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ValidateIsOwner(ctx, newsByID.AuthorID.String(), u.logger); err != nil {
+		return nil, httpErrors.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "newsUC.RevertTo.ValidateIsOwner"))
+	}
+
+	reverted, err := u.newsRepo.RevertTo(ctx, newsID, revisionID, user.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	u.deleteNewsFromCache(ctx, []uuid.UUID{newsID}, "newsUC.RevertTo.DeleteNewsCtx")
+	u.deleteAuthorStatusCountsFromCache(ctx, newsByID.AuthorID, "newsUC.RevertTo.DeleteNewsCtx")
+
+	return reverted, nil
+}
+
+// Get authors with most published news within window, zero window and limit fall back to defaults
+func (u *newsUC) GetAuthorLeaderboard(ctx context.Context, window time.Duration, limit int) ([]*models.AuthorStat, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetAuthorLeaderboard")
+	defer span.Finish()
+
+	if window < 0 || window > leaderboardMaxWindow {
+		return nil, httpErrors.NewBadRequestError(errors.Errorf("window must be between 0 and %s", leaderboardMaxWindow))
+	}
+	if window == 0 {
+		window = leaderboardDefaultWindow
+	}
+	if limit < 0 {
+		return nil, httpErrors.NewBadRequestError(errors.New("limit must not be negative"))
+	}
+	if limit == 0 {
+		limit = leaderboardDefaultLimit
+	}
+	if limit > leaderboardMaxLimit {
+		limit = leaderboardMaxLimit
+	}
+
+	cacheKey := u.getTenantKeyWithPrefix(tenantOf(ctx), fmt.Sprintf("%s:%d:%d", leaderboardKey, int64(window/time.Second), limit))
+
+	cached, err := u.redisRepo.GetLeaderboardCtx(ctx, cacheKey)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		u.logger.Errorf("newsUC.GetAuthorLeaderboard.GetLeaderboardCtx: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	stats, err := u.newsRepo.GetAuthorLeaderboard(ctx, time.Now().Add(-window), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.redisRepo.SetLeaderboardCtx(ctx, cacheKey, leaderboardCacheDuration, stats); err != nil {
+		u.logger.Errorf("newsUC.GetAuthorLeaderboard.SetLeaderboardCtx: %v", err)
+	}
+
+	return stats, nil
+}
+
+// Count news per status, cached for a short while
+func (u *newsUC) CountByStatus(ctx context.Context) (map[string]int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.CountByStatus")
+	defer span.Finish()
+
+	cacheKey := u.getTenantKeyWithPrefix(tenantOf(ctx), statusCountsKey)
+
+	cached, err := u.redisRepo.GetStatusCountsCtx(ctx, cacheKey)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		u.logger.Errorf("newsUC.CountByStatus.GetStatusCountsCtx: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	counts, err := u.newsRepo.CountByStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.redisRepo.SetStatusCountsCtx(ctx, cacheKey, statusCountsCacheDuration, counts); err != nil {
+		u.logger.Errorf("newsUC.CountByStatus.SetStatusCountsCtx: %v", err)
+	}
+
+	return counts, nil
+}
+
+// Count author news per status, cached for a short while and dropped on author news changes
+func (u *newsUC) GetAuthorStatusCounts(ctx context.Context, authorID uuid.UUID) (map[string]int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetAuthorStatusCounts")
+	defer span.Finish()
+
+	cacheKey := u.getAuthorStatusCountsKey(ctx, authorID)
+
+	cached, err := u.redisRepo.GetStatusCountsCtx(ctx, cacheKey)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		u.logger.Errorf("newsUC.GetAuthorStatusCounts.GetStatusCountsCtx: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	counts, err := u.newsRepo.GetAuthorStatusCounts(ctx, authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.redisRepo.SetStatusCountsCtx(ctx, cacheKey, statusCountsCacheDuration, counts); err != nil {
+		u.logger.Errorf("newsUC.GetAuthorStatusCounts.SetStatusCountsCtx: %v", err)
+	}
+
+	return counts, nil
+}
+
+// Set content hash of news and find not deleted news of its tenant with same content, nil when there is none
+func (u *newsUC) findSameContent(ctx context.Context, news *models.News) (*models.News, error) {
+	hash := utils.ContentHash(news.Content)
+	news.ContentHash = &hash
+
+	// Same content of other tenant is not a duplicate, so lookup is within tenant of news
+	existing, err := u.newsRepo.GetNewsByContentHash(ctx, hash, news.TenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return existing, nil
+}
+
+// Existing news is returned only when caller may see it, only its id is told otherwise
+func (u *newsUC) existingNews(existing *models.News, userID uuid.UUID) (*models.News, bool, error) {
+	duplicate := duplicateNewsError(existing, userID)
+	if duplicate.Existing == nil {
+		return nil, false, duplicate
+	}
+	return existing, false, nil
+}
+
+// News which is neither published nor of caller is left out of duplicate, see checkVisible
+func duplicateNewsError(existing *models.News, userID uuid.UUID) *models.DuplicateNewsError {
+	duplicate := &models.DuplicateNewsError{ExistingID: existing.NewsID}
+	if existing.Status == models.NewsStatusPublished || existing.AuthorID == userID {
+		duplicate.Existing = existing
+	}
+	return duplicate
+}
+
+func (u *newsUC) deleteAuthorStatusCountsFromCache(ctx context.Context, authorID uuid.UUID, op string) {
+	if err := u.redisRepo.DeleteNewsCtx(ctx, u.getAuthorStatusCountsKey(ctx, authorID)); err != nil {
+		u.logger.Errorf("%s: %v", op, err)
+	}
+}
+
+func (u *newsUC) getAuthorStatusCountsKey(ctx context.Context, authorID uuid.UUID) string {
+	return u.getTenantKeyWithPrefix(tenantOf(ctx), fmt.Sprintf("%s:%s", authorStatusCountsKey, authorID))
+}
+
+func (u *newsUC) deleteNewsFromCache(ctx context.Context, ids []uuid.UUID, op string) {
+	for _, id := range ids {
+		if err := u.redisRepo.DeleteNewsCtx(ctx, u.getKeyWithPrefix(id.String())); err != nil {
+			u.logger.Errorf("%s: %v", op, err)
+		}
+	}
+}
+
+func (u *newsUC) getKeyWithPrefix(newsID string) string {
+	return fmt.Sprintf("%s: %s", u.keyPrefix, newsID)
+}
+
+// Key of data cached per tenant, so lists of one tenant are never served to other tenants or unscoped requests
+func (u *newsUC) getTenantKeyWithPrefix(tenantID *uuid.UUID, key string) string {
+	if tenantID == nil {
+		return u.getKeyWithPrefix(key)
+	}
+	return u.getKeyWithPrefix(fmt.Sprintf("%s:%s:%s", tenantKey, tenantID, key))
+}
+
+// Replace news tags, only author can set them. Tags are normalized and deduplicated before limit is checked
+func (u *newsUC) SetTags(ctx context.Context, newsID uuid.UUID, tags []string) (*models.NewsTags, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.SetTags")
+	defer span.Finish()
+
+	tags = utils.NormalizeTags(tags)
+	if maxTags := u.maxTags(); len(tags) > maxTags {
+		return nil, errors.Wrapf(httpErrors.ErrTooManyTags, "newsUC.SetTags: %d tags given, max %d", len(tags), maxTags)
+	}
+	for _, tag := range tags {
+		if len([]rune(tag)) > maxTagLength {
+			return nil, httpErrors.NewBadRequestError(errors.Errorf("tag %q is longer than %d characters", tag, maxTagLength))
+		}
+	}
+
+	newsByID, err := u.newsRepo.GetNewsByID(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ValidateIsOwner(ctx, newsByID.AuthorID.String(), u.logger); err != nil {
+		return nil, httpErrors.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "newsUC.SetTags.ValidateIsOwner"))
+	}
+
+	if err = u.newsRepo.SetTags(ctx, newsID, tags); err != nil {
+		return nil, err
+	}
+
+	return &models.NewsTags{NewsID: newsID, Tags: tags}, nil
+}
+
+// Add normalized tag to many news at once, news already having the tag are skipped
+func (u *newsUC) AddTagToMany(ctx context.Context, ids []uuid.UUID, tag string) (*models.NewsTagBatchResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.AddTagToMany")
+	defer span.Finish()
+
+	if len(ids) == 0 {
+		return nil, httpErrors.NewBadRequestError(errors.New("newsUC.AddTagToMany: empty ids"))
+	}
+	if err := utils.ValidateUUIDs(utils.UUIDFields("ids", ids)...); err != nil {
+		return nil, err
+	}
+	tag = utils.NormalizeTag(tag)
+	if tag == "" {
+		return nil, httpErrors.NewBadRequestError(errors.New("newsUC.AddTagToMany: empty tag"))
+	}
+	if len([]rune(tag)) > maxTagLength {
+		return nil, httpErrors.NewBadRequestError(errors.Errorf("tag %q is longer than %d characters", tag, maxTagLength))
+	}
+	if err := u.checkBatchTenant(ctx, ids, "newsUC.AddTagToMany"); err != nil {
+		return nil, err
+	}
+
+	affected, err := u.newsRepo.AddTagToMany(ctx, ids, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.NewsTagBatchResult{Tag: tag, Affected: affected}, nil
+}
+
+// Create or replace news translation in locale, only author of news may translate it
+func (u *newsUC) SetTranslation(ctx context.Context, newsID uuid.UUID, locale string, translation *models.NewsTranslation) (*models.NewsTranslation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.SetTranslation")
+	defer span.Finish()
+
+	locale, err := utils.ParseLocale(locale)
+	if err != nil {
+		return nil, httpErrors.NewBadRequestError(errors.WithMessage(err, "newsUC.SetTranslation.ParseLocale"))
+	}
+	if locale == u.defaultLocale() {
+		return nil, httpErrors.NewBadRequestError(errors.Errorf("locale %q is the default one, update news instead", locale))
+	}
+
+	translation.NewsID = newsID
+	translation.Locale = locale
+	translation.Title = utils.NormalizeSpaces(translation.Title)
+	if err = utils.ValidateStruct(ctx, translation); err != nil {
+		return nil, httpErrors.NewValidationError(errors.WithMessage(err, "newsUC.SetTranslation.ValidateStruct"))
+	}
+
+	newsByID, err := u.newsRepo.GetNewsByID(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkTenant(ctx, newsByID.TenantID, "newsUC.SetTranslation"); err != nil {
+		return nil, err
+	}
+	if err = utils.ValidateIsOwner(ctx, newsByID.AuthorID.String(), u.logger); err != nil {
+		return nil, httpErrors.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "newsUC.SetTranslation.ValidateIsOwner"))
+	}
+
+	t, err := u.newsRepo.SetTranslation(ctx, translation)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.redisRepo.DeleteNewsCtx(ctx, u.getTranslationKey(newsID, locale)); err != nil {
+		u.logger.Errorf("newsUC.SetTranslation.DeleteNewsCtx: %v", err)
+	}
+
+	return t, nil
+}
+
+// Get news translation in locale, 404 when news has none
+func (u *newsUC) GetTranslation(ctx context.Context, newsID uuid.UUID, locale string) (*models.NewsTranslation, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetTranslation")
+	defer span.Finish()
+
+	locale, err := utils.ParseLocale(locale)
+	if err != nil {
+		return nil, httpErrors.NewBadRequestError(errors.WithMessage(err, "newsUC.GetTranslation.ParseLocale"))
+	}
+	if err = u.checkNewsVisible(ctx, newsID, "newsUC.GetTranslation"); err != nil {
+		return nil, err
+	}
+
+	return u.getTranslationCached(ctx, newsID, locale)
+}
+
+// Get news by id with title and content of locale, news in default locale is returned when translation is missing
+func (u *newsUC) GetNewsByIDInLocale(ctx context.Context, newsID uuid.UUID, locale string) (*models.NewsBase, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetNewsByIDInLocale")
+	defer span.Finish()
+
+	locale, err := utils.ParseLocale(locale)
+	if err != nil {
+		return nil, httpErrors.NewBadRequestError(errors.WithMessage(err, "newsUC.GetNewsByIDInLocale.ParseLocale"))
+	}
+
+	n, err := u.GetNewsByID(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+	// Cached news may be shared, so translation is applied to a copy
+	localized := *n
+	localized.Locale = u.defaultLocale()
+	if locale == localized.Locale {
+		return &localized, nil
+	}
+
+	translation, err := u.getTranslationCached(ctx, newsID, locale)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &localized, nil
+		}
+		return nil, err
+	}
+
+	localized.Title = translation.Title
+	localized.Content = translation.Content
+	localized.Locale = translation.Locale
+	return u.withMissingAuthor(u.withReadingTime(&localized)), nil
+}
+
+// Get news translation through per locale cache
+func (u *newsUC) getTranslationCached(ctx context.Context, newsID uuid.UUID, locale string) (*models.NewsTranslation, error) {
+	key := u.getTranslationKey(newsID, locale)
+	cached, err := u.redisRepo.GetTranslationCtx(ctx, key)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		u.logger.Errorf("newsUC.getTranslationCached.GetTranslationCtx: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	translation, err := u.newsRepo.GetTranslation(ctx, newsID, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.redisRepo.SetTranslationCtx(ctx, key, cacheDuration, translation); err != nil {
+		u.logger.Errorf("newsUC.getTranslationCached.SetTranslationCtx: %v", err)
+	}
+
+	return translation, nil
+}
+
+// Save where current user left off reading news, latest position is kept in cache
+func (u *newsUC) SaveReadingPosition(ctx context.Context, newsID uuid.UUID, position float64) (*models.ReadingPosition, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.SaveReadingPosition")
+	defer span.Finish()
+
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, httpErrors.NewUnauthorizedError(errors.WithMessage(err, "newsUC.SaveReadingPosition.GetUserFromCtx"))
+	}
+
+	p := &models.ReadingPosition{UserID: user.UserID, NewsID: newsID, Position: position}
+	if err = utils.ValidateStruct(ctx, p); err != nil {
+		return nil, httpErrors.NewValidationError(errors.WithMessage(err, "newsUC.SaveReadingPosition.ValidateStruct"))
+	}
+
+	newsByID, err := u.newsRepo.GetNewsByID(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkTenant(ctx, newsByID.TenantID, "newsUC.SaveReadingPosition"); err != nil {
+		return nil, err
+	}
+
+	saved, err := u.newsRepo.SaveReadingPosition(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.redisRepo.SetReadingPositionCtx(ctx, u.getReadingPositionKey(user.UserID, newsID), cacheDuration, saved); err != nil {
+		u.logger.Errorf("newsUC.SaveReadingPosition.SetReadingPositionCtx: %v", err)
+	}
+
+	return saved, nil
+}
+
+// Get where current user left off reading news, 404 when user has not read it
+func (u *newsUC) GetReadingPosition(ctx context.Context, newsID uuid.UUID) (*models.ReadingPosition, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetReadingPosition")
+	defer span.Finish()
+
+	user, err := utils.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, httpErrors.NewUnauthorizedError(errors.WithMessage(err, "newsUC.GetReadingPosition.GetUserFromCtx"))
+	}
+
+	key := u.getReadingPositionKey(user.UserID, newsID)
+	cached, err := u.redisRepo.GetReadingPositionCtx(ctx, key)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		u.logger.Errorf("newsUC.GetReadingPosition.GetReadingPositionCtx: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	position, err := u.newsRepo.GetReadingPosition(ctx, user.UserID, newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.redisRepo.SetReadingPositionCtx(ctx, key, cacheDuration, position); err != nil {
+		u.logger.Errorf("newsUC.GetReadingPosition.SetReadingPositionCtx: %v", err)
+	}
+
+	return position, nil
+}
+
+func (u *newsUC) getReadingPositionKey(userID uuid.UUID, newsID uuid.UUID) string {
+	return u.getKeyWithPrefix(fmt.Sprintf("%s:%s:%s", readingPositionKey, userID, newsID))
+}
+
+func (u *newsUC) getTranslationKey(newsID uuid.UUID, locale string) string {
+	return u.getKeyWithPrefix(fmt.Sprintf("%s:%s:%s", translationKey, newsID, locale))
+}
+
+// Locale of news as created, configured one when valid
+func (u *newsUC) defaultLocale() string {
+	if locale, err := utils.ParseLocale(u.cfg.News.DefaultLocale); err == nil {
+		return locale
+	}
+	return defaultLocale
+}
+
+// Get news tags
+func (u *newsUC) GetTags(ctx context.Context, newsID uuid.UUID) (*models.NewsTags, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetTags")
+	defer span.Finish()
+
+	if err := u.checkNewsVisible(ctx, newsID, "newsUC.GetTags"); err != nil {
+		return nil, err
+	}
+
+	tags, err := u.newsRepo.GetTags(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.NewsTags{NewsID: newsID, Tags: tags}, nil
+}
+
+// Get news changed after since for delta sync, changes are not cached
+func (u *newsUC) GetChangedSince(ctx context.Context, since time.Time, pq *utils.PaginationQuery) (*models.NewsChangesList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetChangedSince")
+	defer span.Finish()
+
+	pq.Resolve(u.cfg.Pagination)
+
+	return u.newsRepo.GetChangedSince(ctx, since, pq)
+}
+
+// Get page of recent create, update and delete events of published news, newest first, empty action lists every action
+func (u *newsUC) GetActivityFeed(ctx context.Context, action string, pq *utils.PaginationQuery) (*models.NewsEventList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetActivityFeed")
+	defer span.Finish()
+
+	if action != "" && !models.IsNewsAction(action) {
+		return nil, httpErrors.NewBadRequestError(errors.Errorf("action must be one of %s, %s or %s", models.NewsActionCreate, models.NewsActionUpdate, models.NewsActionDelete))
+	}
+
+	pq.Resolve(u.cfg.Pagination)
+
+	key := u.getTenantKeyWithPrefix(tenantOf(ctx), fmt.Sprintf("%s:%s:%s", activityKey, action, pq.GetQueryString()))
+	cached, err := u.redisRepo.GetActivityFeedCtx(ctx, key)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		u.logger.Errorf("newsUC.GetActivityFeed.GetActivityFeedCtx: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	feed, err := u.newsRepo.GetActivityFeed(ctx, action, pq)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.redisRepo.SetActivityFeedCtx(ctx, key, activityCacheDuration, feed); err != nil {
+		u.logger.Errorf("newsUC.GetActivityFeed.SetActivityFeedCtx: %v", err)
+	}
+
+	return feed, nil
+}
+
+// Physically delete news soft deleted more than olderThan ago, returns number of purged news
+func (u *newsUC) PurgeDeleted(ctx context.Context, olderThan time.Duration) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.PurgeDeleted")
+	defer span.Finish()
+
+	if olderThan <= 0 {
+		return 0, httpErrors.NewBadRequestError(errors.New("older_than must be positive"))
+	}
+
+	ids, err := u.newsRepo.PurgeDeleted(ctx, time.Now().Add(-olderThan), purgeBatchSize)
+	// Batches committed before failure are gone, clean their cache anyway
+	u.deleteNewsFromCache(ctx, ids, "newsUC.PurgeDeleted.DeleteNewsCtx")
+	if err != nil {
+		return len(ids), err
+	}
+
+	return len(ids), nil
+}
+
+// Reconcile stored comment counts with comments table, returns number of news with count corrected
+func (u *newsUC) RecomputeCommentCounts(ctx context.Context) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.RecomputeCommentCounts")
+	defer span.Finish()
+
+	return u.newsRepo.RecomputeCommentCounts(ctx, commentCountBatchSize)
+}
+
+// Move views counted in Redis to news table in batches, returns number of news updated.
+// Views of batch which failed to save and all after it are put back to be flushed next time
+func (u *newsUC) FlushViews(ctx context.Context) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.FlushViews")
+	defer span.Finish()
+
+	key := u.getKeyWithPrefix(viewsKey)
+	counted, err := u.redisRepo.PopViewsCtx(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(counted))
+	for id := range counted {
+		newsID, err := uuid.Parse(id)
+		if err != nil {
+			u.logger.Warnf("newsUC.FlushViews: invalid news id %q dropped", id)
+			continue
+		}
+		ids = append(ids, newsID)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+	views := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		views = append(views, counted[id.String()])
+	}
+
+	flushed := 0
+	for start := 0; start < len(ids); start += viewsFlushBatchSize {
+		end := start + viewsFlushBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		if err = u.newsRepo.AddViews(ctx, ids[start:end], views[start:end]); err != nil {
+			for i := start; i < len(ids); i++ {
+				if restoreErr := u.redisRepo.IncrViewsCtx(ctx, key, ids[i].String(), views[i]); restoreErr != nil {
+					u.logger.Errorf("newsUC.FlushViews.IncrViewsCtx: %v", restoreErr)
+				}
+			}
+			return flushed, err
+		}
+		flushed += end - start
+	}
+
+	return flushed, nil
+}
+
+// Replace news metadata, only author can set it. Metadata must be JSON object within size limit, null clears it
+func (u *newsUC) SetMetadata(ctx context.Context, newsID uuid.UUID, metadata models.Metadata) (*models.NewsMetadata, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.SetMetadata")
+	defer span.Finish()
+
+	if maxSize := u.maxMetadataSize(); len(metadata) > maxSize {
+		return nil, httpErrors.NewBadRequestError(errors.Errorf("metadata is %d bytes, max %d", len(metadata), maxSize))
+	}
+	if metadata != nil && !metadata.IsObject() {
+		return nil, httpErrors.NewBadRequestError(errors.New("metadata must be JSON object"))
+	}
+
+	newsByID, err := u.newsRepo.GetNewsByID(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.ValidateIsOwner(ctx, newsByID.AuthorID.String(), u.logger); err != nil {
+		return nil, httpErrors.NewRestError(http.StatusForbidden, "Forbidden", errors.Wrap(err, "newsUC.SetMetadata.ValidateIsOwner"))
+	}
+
+	if err = u.newsRepo.SetMetadata(ctx, newsID, metadata); err != nil {
+		return nil, err
+	}
+
+	if err = u.redisRepo.DeleteNewsCtx(ctx, u.getKeyWithPrefix(newsID.String())); err != nil {
+		u.logger.Errorf("newsUC.SetMetadata.DeleteNewsCtx: %v", err)
+	}
+
+	return &models.NewsMetadata{NewsID: newsID, Metadata: metadata}, nil
+}
+
+// Get news metadata
+func (u *newsUC) GetMetadata(ctx context.Context, newsID uuid.UUID) (*models.NewsMetadata, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "newsUC.GetMetadata")
+	defer span.Finish()
+
+	if err := u.checkNewsVisible(ctx, newsID, "newsUC.GetMetadata"); err != nil {
+		return nil, err
+	}
+
+	metadata, err := u.newsRepo.GetMetadata(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.NewsMetadata{NewsID: newsID, Metadata: metadata}, nil
+}
+
+// Set estimated reading time of news content, html tags are not counted as words
+func (u *newsUC) withReadingTime(n *models.NewsBase) *models.NewsBase {
+	wordsPerMinute := defaultReadingWordsPerMinute
+	if u.cfg.News.ReadingWordsPerMinute > 0 {
+		wordsPerMinute = u.cfg.News.ReadingWordsPerMinute
+	}
+	n.ReadingTimeMinutes = utils.ReadingTimeMinutes(utils.StripHTML(n.Content), wordsPerMinute)
+	return n
+}
+
+// Set News.MissingAuthorName as author of news whose author no longer exists
+func (u *newsUC) withMissingAuthor(n *models.NewsBase) *models.NewsBase {
+	if n.Author == nil && u.cfg.News.MissingAuthorName != "" {
+		name := u.cfg.News.MissingAuthorName
+		n.Author = &name
+	}
+	return n
+}
+
+func (u *newsUC) maxMetadataSize() int {
+	if u.cfg.News.MaxMetadataSize > 0 {
+		return u.cfg.News.MaxMetadataSize
+	}
+	return defaultMaxMetadataSize
+}
+
+func (u *newsUC) maxTags() int {
+	if u.cfg.News.MaxTags > 0 {
+		return u.cfg.News.MaxTags
+	}
+	return defaultMaxTags
 }
@@ -2,17 +2,34 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
+	"unicode/utf8"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis/v8"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
 	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
+	"github.com/AleksK1NG/api-mc/config"
 	"github.com/AleksK1NG/api-mc/internal/models"
+	"github.com/AleksK1NG/api-mc/internal/news"
 	"github.com/AleksK1NG/api-mc/internal/news/mock"
+	"github.com/AleksK1NG/api-mc/internal/news/repository"
+	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
 	"github.com/AleksK1NG/api-mc/pkg/logger"
+	"github.com/AleksK1NG/api-mc/pkg/preview"
 	"github.com/AleksK1NG/api-mc/pkg/utils"
 )
 
@@ -24,7 +41,8 @@ func TestNewsUC_Create(t *testing.T) {
 
 	apiLogger := logger.NewApiLogger(nil)
 	mockNewsRepo := mock.NewMockRepository(ctrl)
-	newsUC := NewNewsUseCase(nil, mockNewsRepo, nil, apiLogger)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
 
 	userUID := uuid.New()
 
@@ -42,7 +60,9 @@ func TestNewsUC_Create(t *testing.T) {
 	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.Create")
 	defer span.Finish()
 
+	mockNewsRepo.EXPECT().GetNewsByContentHash(ctxWithTrace, utils.ContentHash(news.Content), gomock.Nil()).Return(nil, sql.ErrNoRows)
 	mockNewsRepo.EXPECT().Create(ctxWithTrace, gomock.Eq(news)).Return(news, nil)
+	mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, fmt.Sprintf("%s: %s:%s", basePrefix, authorStatusCountsKey, userUID)).Return(nil)
 
 	createdNews, err := newsUC.Create(ctx, news)
 	require.NoError(t, err)
@@ -50,6 +70,280 @@ func TestNewsUC_Create(t *testing.T) {
 	require.NotNil(t, createdNews)
 }
 
+func TestNewsUC_Create_NormalizeTitle(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	user := &models.User{UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, user)
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.Create")
+	defer span.Finish()
+
+	titles := []string{
+		"  Clean architecture in Go  ",
+		"Clean  architecture   in Go",
+		"\tClean architecture\nin Go\r\n",
+		"Clean\u00a0architecture in\u2003Go",
+	}
+	for _, title := range titles {
+		news := &models.News{Title: title, Content: "Content long text string greater then 20 characters"}
+		mockNewsRepo.EXPECT().GetNewsByContentHash(ctxWithTrace, gomock.Any(), gomock.Nil()).Return(nil, sql.ErrNoRows)
+		mockNewsRepo.EXPECT().Create(ctxWithTrace, gomock.Any()).DoAndReturn(func(_ context.Context, n *models.News) (*models.News, error) {
+			require.Equal(t, "Clean architecture in Go", n.Title)
+			return n, nil
+		})
+		mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, gomock.Any()).Return(nil)
+
+		_, err := newsUC.Create(ctx, news)
+		require.NoError(t, err)
+	}
+
+	t.Run("Only whitespace is too short", func(t *testing.T) {
+		news := &models.News{Title: "   short     ", Content: "Content long text string greater then 20 characters"}
+		_, err := newsUC.Create(ctx, news)
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_Create_AllValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &models.User{UserID: uuid.New()})
+	imageURL := "not an url"
+	news := &models.News{Title: "Short", Content: "Too short", ImageURL: &imageURL, Status: "hidden"}
+
+	_, err := newsUC.Create(ctx, news)
+	restErr, ok := httpErrors.ParseErrors(err).(httpErrors.RestError)
+	require.True(t, ok)
+	require.Equal(t, http.StatusBadRequest, restErr.Status())
+
+	rules := make(map[string]string, len(restErr.ErrDetails))
+	for _, detail := range restErr.ErrDetails {
+		rules[detail.Field] = detail.Rule
+	}
+	require.Equal(t, map[string]string{"title": "gte", "content": "gte", "image_url": "url", "status": "oneof"}, rules)
+
+	body, err := json.Marshal(restErr)
+	require.NoError(t, err)
+	require.Contains(t, string(body), `"details":[`)
+	require.Contains(t, string(body), `{"field":"title","rule":"gte","param":"10","message":"title failed on gte=10 rule"}`)
+}
+
+func TestNewsUC_Create_DuplicateContent(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	user := &models.User{UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, user)
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.Create")
+	defer span.Finish()
+
+	content := "Content long text string greater then 20 characters"
+	existing := &models.News{NewsID: uuid.New(), Title: "Existing title", Content: content, Status: models.NewsStatusPublished}
+
+	t.Run("Duplicate", func(t *testing.T) {
+		news := &models.News{Title: "Title long text string", Content: "  Content long text  string greater then 20 characters\n"}
+		mockNewsRepo.EXPECT().GetNewsByContentHash(ctxWithTrace, utils.ContentHash(content), gomock.Nil()).Return(existing, nil)
+
+		created, err := newsUC.Create(ctx, news)
+		require.Nil(t, created)
+		var duplicate *models.DuplicateNewsError
+		require.True(t, errors.As(err, &duplicate))
+		require.Equal(t, existing, duplicate.Existing)
+	})
+
+	t.Run("Duplicate draft of other author", func(t *testing.T) {
+		draft := &models.News{NewsID: uuid.New(), AuthorID: uuid.New(), Title: "Secret draft", Content: content, Status: models.NewsStatusDraft}
+		news := &models.News{Title: "Title long text string", Content: content}
+		mockNewsRepo.EXPECT().GetNewsByContentHash(ctxWithTrace, utils.ContentHash(content), gomock.Nil()).Return(draft, nil)
+
+		_, err := newsUC.Create(ctx, news)
+		var duplicate *models.DuplicateNewsError
+		require.True(t, errors.As(err, &duplicate))
+		require.Equal(t, draft.NewsID, duplicate.ExistingID)
+		require.Nil(t, duplicate.Existing)
+	})
+
+	t.Run("Duplicate draft of caller", func(t *testing.T) {
+		draft := &models.News{NewsID: uuid.New(), AuthorID: user.UserID, Content: content, Status: models.NewsStatusDraft}
+		news := &models.News{Title: "Title long text string", Content: content}
+		mockNewsRepo.EXPECT().GetNewsByContentHash(ctxWithTrace, utils.ContentHash(content), gomock.Nil()).Return(draft, nil)
+
+		_, err := newsUC.Create(ctx, news)
+		var duplicate *models.DuplicateNewsError
+		require.True(t, errors.As(err, &duplicate))
+		require.Equal(t, draft, duplicate.Existing)
+	})
+
+	t.Run("Different content", func(t *testing.T) {
+		news := &models.News{Title: "Title long text string", Content: content + "!"}
+		require.NotEqual(t, utils.ContentHash(content), utils.ContentHash(news.Content))
+		mockNewsRepo.EXPECT().GetNewsByContentHash(ctxWithTrace, utils.ContentHash(news.Content), gomock.Nil()).Return(nil, sql.ErrNoRows)
+		mockNewsRepo.EXPECT().Create(ctxWithTrace, news).Return(news, nil)
+		mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, gomock.Any()).Return(nil)
+
+		created, err := newsUC.Create(ctx, news)
+		require.NoError(t, err)
+		require.Equal(t, utils.ContentHash(news.Content), *created.ContentHash)
+	})
+}
+
+func TestNewsUC_Create_SimilarTitles(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{News: config.NewsConfig{SimilarTitleThreshold: 0.6}}
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	user := &models.User{UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, user)
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.Create")
+	defer span.Finish()
+
+	news := &models.News{
+		Title:   "Golang 1.16 released with embed package",
+		Content: "Content long text string greater then 20 characters",
+	}
+	similar := []*models.NewsRef{
+		{NewsID: uuid.New(), Title: "Golang 1.16 released with the embed package"},
+	}
+
+	mockNewsRepo.EXPECT().GetNewsByContentHash(ctxWithTrace, gomock.Any(), gomock.Nil()).Return(nil, sql.ErrNoRows)
+	mockNewsRepo.EXPECT().FindSimilarTitles(ctxWithTrace, news.Title, 0.6, user.UserID, gomock.Nil()).Return(similar, nil)
+	mockNewsRepo.EXPECT().Create(ctxWithTrace, gomock.Eq(news)).Return(&models.News{Title: news.Title, AuthorID: user.UserID}, nil)
+	mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, fmt.Sprintf("%s: %s:%s", basePrefix, authorStatusCountsKey, user.UserID)).Return(nil)
+
+	createdNews, err := newsUC.Create(ctx, news)
+	require.NoError(t, err)
+	require.Equal(t, similar, createdNews.SimilarNews)
+
+	body, err := json.Marshal(createdNews.SimilarNews)
+	require.NoError(t, err)
+	require.JSONEq(t, fmt.Sprintf(`[{"news_id":%q,"title":%q}]`, similar[0].NewsID, similar[0].Title), string(body))
+}
+
+func TestNewsUC_CreateIfNotExists(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	user := &models.User{UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, user)
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.CreateIfNotExists")
+	defer span.Finish()
+
+	t.Run("Slug from title", func(t *testing.T) {
+		news := &models.News{
+			Title:   "Golang 1.16 released",
+			Content: "Content long text string greater then 20 characters",
+		}
+
+		mockNewsRepo.EXPECT().GetNewsByContentHash(ctxWithTrace, gomock.Any(), gomock.Nil()).Return(nil, sql.ErrNoRows)
+		mockNewsRepo.EXPECT().CreateIfNotExists(ctxWithTrace, gomock.Any()).DoAndReturn(
+			func(_ context.Context, n *models.News) (*models.News, bool, error) {
+				require.Equal(t, "golang-1-16-released", *n.Slug)
+				require.Equal(t, user.UserID, n.AuthorID)
+				return n, true, nil
+			})
+		mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, fmt.Sprintf("%s: %s:%s", basePrefix, authorStatusCountsKey, user.UserID)).Return(nil)
+
+		n, created, err := newsUC.CreateIfNotExists(ctx, news)
+		require.NoError(t, err)
+		require.True(t, created)
+		require.NotNil(t, n)
+	})
+
+	t.Run("Given slug is normalized", func(t *testing.T) {
+		slug := " Sync Job/42 "
+		news := &models.News{
+			Title:   "Title long text string",
+			Content: "Content long text string greater then 20 characters",
+			Slug:    &slug,
+		}
+		existing := &models.News{NewsID: uuid.New(), Status: models.NewsStatusPublished}
+
+		mockNewsRepo.EXPECT().GetNewsByContentHash(ctxWithTrace, gomock.Any(), gomock.Nil()).Return(nil, sql.ErrNoRows)
+		mockNewsRepo.EXPECT().CreateIfNotExists(ctxWithTrace, gomock.Any()).DoAndReturn(
+			func(_ context.Context, n *models.News) (*models.News, bool, error) {
+				require.Equal(t, "sync-job-42", *n.Slug)
+				return existing, false, nil
+			})
+
+		n, created, err := newsUC.CreateIfNotExists(ctx, news)
+		require.NoError(t, err)
+		require.False(t, created)
+		require.Equal(t, existing, n)
+	})
+
+	t.Run("Draft of other author with same slug", func(t *testing.T) {
+		slug := "draft-of-other-author"
+		news := &models.News{
+			Title:   "Title long text string",
+			Content: "Content long text string greater then 20 characters",
+			Slug:    &slug,
+		}
+		existing := &models.News{NewsID: uuid.New(), AuthorID: uuid.New(), Title: "Secret draft", Status: models.NewsStatusDraft}
+
+		mockNewsRepo.EXPECT().GetNewsByContentHash(ctxWithTrace, gomock.Any(), gomock.Nil()).Return(nil, sql.ErrNoRows)
+		mockNewsRepo.EXPECT().CreateIfNotExists(ctxWithTrace, gomock.Any()).Return(existing, false, nil)
+
+		n, created, err := newsUC.CreateIfNotExists(ctx, news)
+		require.Nil(t, n)
+		require.False(t, created)
+		var duplicate *models.DuplicateNewsError
+		require.True(t, errors.As(err, &duplicate))
+		require.Equal(t, existing.NewsID, duplicate.ExistingID)
+		require.Nil(t, duplicate.Existing)
+	})
+
+	t.Run("Empty slug", func(t *testing.T) {
+		news := &models.News{
+			Title:   "!!! ??? !!! ???",
+			Content: "Content long text string greater then 20 characters",
+		}
+
+		n, created, err := newsUC.CreateIfNotExists(ctx, news)
+		require.Error(t, err)
+		require.False(t, created)
+		require.Nil(t, n)
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+}
+
 func TestNewsUC_Update(t *testing.T) {
 	t.Parallel()
 
@@ -59,14 +353,14 @@ func TestNewsUC_Update(t *testing.T) {
 	apiLogger := logger.NewApiLogger(nil)
 	mockNewsRepo := mock.NewMockRepository(ctrl)
 	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
-	newsUC := NewNewsUseCase(nil, mockNewsRepo, mockRedisRepo, apiLogger)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
 
 	userUID := uuid.New()
 	newsUID := uuid.New()
 	news := &models.News{
 		NewsID:   newsUID,
 		AuthorID: userUID,
-		Title:    "Title long text string greater then 20 characters",
+		Title:    "  Title long  text string greater then 20 characters ",
 		Content:  "Content long text string greater then 20 characters",
 	}
 
@@ -88,13 +382,205 @@ func TestNewsUC_Update(t *testing.T) {
 	defer span.Finish()
 
 	mockNewsRepo.EXPECT().GetNewsByID(ctxWithTrace, gomock.Eq(news.NewsID)).Return(newsBase, nil)
-	mockNewsRepo.EXPECT().Update(ctxWithTrace, gomock.Eq(news)).Return(news, nil)
+	mockNewsRepo.EXPECT().Update(ctxWithTrace, gomock.Eq(news), userUID).Return(news, nil)
 	mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, gomock.Eq(cacheKey)).Return(nil)
+	mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, fmt.Sprintf("%s: %s:%s", basePrefix, authorStatusCountsKey, userUID)).Return(nil)
+	mockRedisRepo.EXPECT().GetEditLockCtx(gomock.Any(), fmt.Sprintf("%s: %s:%s", basePrefix, editLockKey, newsUID)).Return("", time.Duration(0), nil)
 
 	updatedNews, err := newsUC.Update(ctx, news)
 	require.NoError(t, err)
 	require.Nil(t, err)
 	require.NotNil(t, updatedNews)
+	require.Equal(t, "Title long text string greater then 20 characters", updatedNews.Title)
+	require.Nil(t, updatedNews.EditLock)
+}
+
+func TestNewsUC_Update_ImmutableFields(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	userUID := uuid.New()
+	newsUID := uuid.New()
+	newsBase := &models.NewsBase{NewsID: newsUID, AuthorID: userUID}
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &models.User{UserID: userUID})
+
+	t.Run("Changing author is rejected", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), newsUID).Return(newsBase, nil)
+		mockNewsRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := newsUC.Update(ctx, &models.News{NewsID: newsUID, AuthorID: uuid.New(), Title: "Title long text string greater then 20 characters"})
+		require.Error(t, err)
+		restErr := httpErrors.ParseErrors(err)
+		require.Equal(t, http.StatusBadRequest, restErr.Status())
+		require.Contains(t, restErr.Error(), "author_id")
+	})
+
+	t.Run("Changing created at is rejected", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), newsUID).Return(newsBase, nil)
+		mockNewsRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := newsUC.Update(ctx, &models.News{NewsID: newsUID, CreatedAt: time.Now().Add(-time.Hour)})
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Configured fields replace default ones", func(t *testing.T) {
+		newsUC := NewNewsUseCase(&config.Config{News: config.NewsConfig{ImmutableFields: []string{"tenant_id"}}}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+		n := &models.News{NewsID: newsUID, AuthorID: uuid.New()}
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), newsUID).Return(newsBase, nil)
+		mockNewsRepo.EXPECT().Update(gomock.Any(), n, userUID).Return(n, nil)
+		mockRedisRepo.EXPECT().DeleteNewsCtx(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		mockRedisRepo.EXPECT().GetEditLockCtx(gomock.Any(), gomock.Any()).Return("", time.Duration(0), nil)
+
+		_, err := newsUC.Update(ctx, n)
+		require.NoError(t, err)
+	})
+}
+
+func TestNewsUC_Update_EditLockWarning(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	userUID := uuid.New()
+	holderUID := uuid.New()
+	newsUID := uuid.New()
+	news := &models.News{
+		NewsID:   newsUID,
+		AuthorID: userUID,
+		Title:    "Title long text string greater then 20 characters",
+		Content:  "Content long text string greater then 20 characters",
+	}
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &models.User{UserID: userUID})
+
+	mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), newsUID).Return(&models.NewsBase{NewsID: newsUID, AuthorID: userUID}, nil)
+	mockNewsRepo.EXPECT().Update(gomock.Any(), news, userUID).Return(news, nil)
+	mockRedisRepo.EXPECT().DeleteNewsCtx(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	mockRedisRepo.EXPECT().GetEditLockCtx(gomock.Any(), fmt.Sprintf("%s: %s:%s", basePrefix, editLockKey, newsUID)).Return(holderUID.String(), time.Minute, nil)
+
+	updatedNews, err := newsUC.Update(ctx, news)
+	require.NoError(t, err)
+	require.NotNil(t, updatedNews.EditLock)
+	require.True(t, updatedNews.EditLock.Locked)
+	require.Equal(t, holderUID, *updatedNews.EditLock.UserID)
+	require.NotNil(t, updatedNews.EditLock.ExpiresAt)
+}
+
+func TestNewsUC_EditLock(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	userUID := uuid.New()
+	otherUID := uuid.New()
+	newsUID := uuid.New()
+	lockKey := fmt.Sprintf("%s: %s:%s", basePrefix, editLockKey, newsUID)
+	newsKey := fmt.Sprintf("%s: %s", basePrefix, newsUID)
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &models.User{UserID: userUID})
+	ownNews := &models.NewsBase{NewsID: newsUID, AuthorID: userUID, Status: models.NewsStatusDraft}
+
+	t.Run("Acquire with default ttl", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), newsUID).Return(ownNews, nil)
+		mockRedisRepo.EXPECT().AcquireEditLockCtx(gomock.Any(), lockKey, userUID.String(), defaultEditLockTTL).Return(true, nil)
+
+		lock, err := newsUC.AcquireEditLock(ctx, newsUID, 0)
+		require.NoError(t, err)
+		require.True(t, lock.Locked)
+		require.Equal(t, userUID, *lock.UserID)
+		require.NotNil(t, lock.ExpiresAt)
+	})
+
+	t.Run("Ttl is capped", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), newsUID).Return(ownNews, nil)
+		mockRedisRepo.EXPECT().AcquireEditLockCtx(gomock.Any(), lockKey, userUID.String(), maxEditLockTTL).Return(true, nil)
+
+		_, err := newsUC.AcquireEditLock(ctx, newsUID, 24*time.Hour)
+		require.NoError(t, err)
+	})
+
+	t.Run("Held by other user", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), newsUID).Return(ownNews, nil)
+		mockRedisRepo.EXPECT().AcquireEditLockCtx(gomock.Any(), lockKey, userUID.String(), defaultEditLockTTL).Return(false, nil)
+
+		lock, err := newsUC.AcquireEditLock(ctx, newsUID, 0)
+		require.Nil(t, lock)
+		require.True(t, errors.Is(err, httpErrors.EditLocked))
+		require.Equal(t, http.StatusConflict, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("News of other author can not be locked", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), newsUID).
+			Return(&models.NewsBase{NewsID: newsUID, AuthorID: otherUID, Status: models.NewsStatusPublished}, nil)
+
+		lock, err := newsUC.AcquireEditLock(ctx, newsUID, 0)
+		require.Nil(t, lock)
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("News of other tenant can not be locked", func(t *testing.T) {
+		tenantCtx := context.WithValue(ctx, utils.TenantCtxKey{}, uuid.New())
+		otherTenantID := uuid.New()
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), newsUID).
+			Return(&models.NewsBase{NewsID: newsUID, AuthorID: userUID, TenantID: &otherTenantID}, nil)
+
+		_, err := newsUC.AcquireEditLock(tenantCtx, newsUID, 0)
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Release lock of other user", func(t *testing.T) {
+		mockRedisRepo.EXPECT().ReleaseEditLockCtx(gomock.Any(), lockKey, userUID.String()).Return(false, nil)
+		mockRedisRepo.EXPECT().GetEditLockCtx(gomock.Any(), lockKey).Return(otherUID.String(), time.Minute, nil)
+
+		err := newsUC.ReleaseEditLock(ctx, newsUID)
+		require.True(t, errors.Is(err, httpErrors.EditLocked))
+	})
+
+	t.Run("Release free lock", func(t *testing.T) {
+		mockRedisRepo.EXPECT().ReleaseEditLockCtx(gomock.Any(), lockKey, userUID.String()).Return(false, nil)
+		mockRedisRepo.EXPECT().GetEditLockCtx(gomock.Any(), lockKey).Return("", time.Duration(0), nil)
+
+		require.NoError(t, newsUC.ReleaseEditLock(ctx, newsUID))
+	})
+
+	t.Run("Free lock", func(t *testing.T) {
+		mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), newsKey).Return(ownNews, nil)
+		mockRedisRepo.EXPECT().GetEditLockCtx(gomock.Any(), lockKey).Return("", time.Duration(0), nil)
+
+		lock, err := newsUC.GetEditLock(ctx, newsUID)
+		require.NoError(t, err)
+		require.False(t, lock.Locked)
+		require.Nil(t, lock.UserID)
+	})
+
+	t.Run("Lock of draft of other author is not found", func(t *testing.T) {
+		mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), newsKey).Return(ownNews, nil)
+
+		lock, err := newsUC.GetEditLock(context.Background(), newsUID)
+		require.Nil(t, lock)
+		require.Equal(t, http.StatusNotFound, httpErrors.ParseErrors(err).Status())
+	})
 }
 
 func TestNewsUC_GetNewsByID(t *testing.T) {
@@ -106,11 +592,12 @@ func TestNewsUC_GetNewsByID(t *testing.T) {
 	apiLogger := logger.NewApiLogger(nil)
 	mockNewsRepo := mock.NewMockRepository(ctrl)
 	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
-	newsUC := NewNewsUseCase(nil, mockNewsRepo, mockRedisRepo, apiLogger)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
 
 	newsUID := uuid.New()
 	newsBase := &models.NewsBase{
 		NewsID: newsUID,
+		Status: models.NewsStatusPublished,
 	}
 	ctx := context.Background()
 	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetNewsByID")
@@ -120,6 +607,7 @@ func TestNewsUC_GetNewsByID(t *testing.T) {
 	mockRedisRepo.EXPECT().GetNewsByIDCtx(ctxWithTrace, gomock.Eq(cacheKey)).Return(nil, nil)
 	mockNewsRepo.EXPECT().GetNewsByID(ctxWithTrace, gomock.Eq(newsUID)).Return(newsBase, nil)
 	mockRedisRepo.EXPECT().SetNewsCtx(ctxWithTrace, cacheKey, cacheDuration, newsBase).Return(nil)
+	mockRedisRepo.EXPECT().IncrViewsCtx(gomock.Any(), fmt.Sprintf("%s: %s", basePrefix, viewsKey), newsUID.String(), int64(1)).Return(nil)
 
 	newsByID, err := newsUC.GetNewsByID(ctx, newsBase.NewsID)
 	require.NoError(t, err)
@@ -127,7 +615,89 @@ func TestNewsUC_GetNewsByID(t *testing.T) {
 	require.NotNil(t, newsByID)
 }
 
-func TestNewsUC_Delete(t *testing.T) {
+func TestNewsUC_GetNewsByID_CachesOnlySuccessfulReads(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+
+	setup := func(t *testing.T, cfg *config.Config) (news.UseCase, sqlmock.Sqlmock, *miniredis.Miniredis) {
+		db, sqlMock, err := sqlmock.New()
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+
+		mr, err := miniredis.Run()
+		require.NoError(t, err)
+		t.Cleanup(mr.Close)
+
+		newsRepo := repository.NewNewsRepository(sqlx.NewDb(db, "sqlmock"), cfg, apiLogger)
+		redisRepo := repository.NewNewsRedisRepo(redis.NewClient(&redis.Options{Addr: mr.Addr()}), cfg)
+		return NewNewsUseCase(cfg, newsRepo, redisRepo, nil, apiLogger), sqlMock, mr
+	}
+
+	t.Run("Database error is not cached", func(t *testing.T) {
+		newsUC, sqlMock, mr := setup(t, cfg)
+		newsID := uuid.New()
+		sqlMock.ExpectPrepare("FROM news n").ExpectQuery().WithArgs(newsID).WillReturnError(errors.New("connection reset by peer"))
+
+		n, err := newsUC.GetNewsByID(context.Background(), newsID)
+		require.Error(t, err)
+		require.Nil(t, n)
+		require.Empty(t, mr.Keys())
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("Missing news is not cached", func(t *testing.T) {
+		newsUC, sqlMock, mr := setup(t, cfg)
+		newsID := uuid.New()
+		sqlMock.ExpectPrepare("FROM news n").ExpectQuery().WithArgs(newsID).WillReturnRows(sqlmock.NewRows([]string{"news_id"}))
+
+		_, err := newsUC.GetNewsByID(context.Background(), newsID)
+		require.Equal(t, http.StatusNotFound, httpErrors.ParseErrors(err).Status())
+		require.Empty(t, mr.Keys())
+	})
+
+	t.Run("Database error is not cached with stale while revalidate", func(t *testing.T) {
+		newsUC, sqlMock, mr := setup(t, &config.Config{Redis: config.RedisConfig{StaleWhileRevalidate: time.Minute}})
+		newsID := uuid.New()
+		sqlMock.ExpectPrepare("FROM news n").ExpectQuery().WithArgs(newsID).WillReturnError(errors.New("connection reset by peer"))
+
+		_, err := newsUC.GetNewsByID(context.Background(), newsID)
+		require.Error(t, err)
+		require.Empty(t, mr.Keys())
+	})
+
+	t.Run("Successful read is cached", func(t *testing.T) {
+		newsUC, sqlMock, mr := setup(t, cfg)
+		newsID := uuid.New()
+		sqlMock.ExpectPrepare("FROM news n").ExpectQuery().WithArgs(newsID).
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "title", "content", "status"}).AddRow(newsID, "Cached news title", "Cached news content", models.NewsStatusPublished))
+
+		_, err := newsUC.GetNewsByID(context.Background(), newsID)
+		require.NoError(t, err)
+		require.True(t, mr.Exists(fmt.Sprintf("%s: %s", basePrefix, newsID)))
+	})
+
+	t.Run("Empty result is not cached", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockNewsRepo := mock.NewMockRepository(ctrl)
+		mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+		newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+		newsID := uuid.New()
+		mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), gomock.Any()).Return(nil, redis.Nil)
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), newsID).Return(&models.NewsBase{Status: models.NewsStatusPublished}, nil)
+		mockRedisRepo.EXPECT().SetNewsCtx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockRedisRepo.EXPECT().IncrViewsCtx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := newsUC.GetNewsByID(context.Background(), newsID)
+		require.NoError(t, err)
+	})
+}
+
+func TestNewsUC_GetNewsByID_MissingAuthor(t *testing.T) {
 	t.Parallel()
 
 	ctrl := gomock.NewController(t)
@@ -136,34 +706,33 @@ func TestNewsUC_Delete(t *testing.T) {
 	apiLogger := logger.NewApiLogger(nil)
 	mockNewsRepo := mock.NewMockRepository(ctrl)
 	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
-	newsUC := NewNewsUseCase(nil, mockNewsRepo, mockRedisRepo, apiLogger)
+	mockRedisRepo.EXPECT().IncrViewsCtx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
-	newsUID := uuid.New()
-	userUID := uuid.New()
-	newsBase := &models.NewsBase{
-		NewsID:   newsUID,
-		AuthorID: userUID,
-	}
-	cacheKey := fmt.Sprintf("%s: %s", basePrefix, newsUID)
+	newsID := uuid.New()
+	mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), gomock.Any()).DoAndReturn(func(context.Context, string) (*models.NewsBase, error) {
+		return &models.NewsBase{NewsID: newsID, AuthorID: uuid.New(), Status: models.NewsStatusPublished}, nil
+	}).Times(2)
 
-	user := &models.User{
-		UserID: userUID,
-	}
+	t.Run("Placeholder", func(t *testing.T) {
+		cfg := &config.Config{News: config.NewsConfig{MissingAuthorName: "Unknown author"}}
+		newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
 
-	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, user)
-	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.Delete")
-	defer span.Finish()
+		n, err := newsUC.GetNewsByID(context.Background(), newsID)
+		require.NoError(t, err)
+		require.NotNil(t, n.Author)
+		require.Equal(t, "Unknown author", *n.Author)
+	})
 
-	mockNewsRepo.EXPECT().GetNewsByID(ctxWithTrace, gomock.Eq(newsBase.NewsID)).Return(newsBase, nil)
-	mockNewsRepo.EXPECT().Delete(ctxWithTrace, gomock.Eq(newsUID)).Return(nil)
-	mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, gomock.Eq(cacheKey)).Return(nil)
+	t.Run("Null", func(t *testing.T) {
+		newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
 
-	err := newsUC.Delete(ctx, newsBase.NewsID)
-	require.NoError(t, err)
-	require.Nil(t, err)
+		n, err := newsUC.GetNewsByID(context.Background(), newsID)
+		require.NoError(t, err)
+		require.Nil(t, n.Author)
+	})
 }
 
-func TestNewsUC_GetNews(t *testing.T) {
+func TestNewsUC_Tenancy(t *testing.T) {
 	t.Parallel()
 
 	ctrl := gomock.NewController(t)
@@ -172,55 +741,2656 @@ func TestNewsUC_GetNews(t *testing.T) {
 	apiLogger := logger.NewApiLogger(nil)
 	mockNewsRepo := mock.NewMockRepository(ctrl)
 	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
-	newsUC := NewNewsUseCase(nil, mockNewsRepo, mockRedisRepo, apiLogger)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+	mockRedisRepo.EXPECT().IncrViewsCtx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
-	ctx := context.Background()
-	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetNews")
-	defer span.Finish()
+	tenantID := uuid.New()
+	otherTenantID := uuid.New()
+	ctx := context.WithValue(context.Background(), utils.TenantCtxKey{}, tenantID)
 
-	query := &utils.PaginationQuery{
-		Size:    10,
-		Page:    1,
-		OrderBy: "",
-	}
+	t.Run("Other tenant news is forbidden", func(t *testing.T) {
+		newsBase := &models.NewsBase{NewsID: uuid.New(), TenantID: &otherTenantID, Status: models.NewsStatusPublished}
+		mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), fmt.Sprintf("%s: %s", basePrefix, newsBase.NewsID)).Return(newsBase, nil)
 
-	newsList := &models.NewsList{}
+		newsByID, err := newsUC.GetNewsByID(ctx, newsBase.NewsID)
+		require.Nil(t, newsByID)
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+	})
 
-	mockNewsRepo.EXPECT().GetNews(ctxWithTrace, query).Return(newsList, nil)
+	t.Run("News without tenant is forbidden", func(t *testing.T) {
+		newsBase := &models.NewsBase{NewsID: uuid.New(), Status: models.NewsStatusPublished}
+		mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), fmt.Sprintf("%s: %s", basePrefix, newsBase.NewsID)).Return(newsBase, nil)
 
-	news, err := newsUC.GetNews(ctx, query)
-	require.NoError(t, err)
-	require.Nil(t, err)
-	require.NotNil(t, news)
+		_, err := newsUC.GetNewsByID(ctx, newsBase.NewsID)
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Own tenant news", func(t *testing.T) {
+		newsBase := &models.NewsBase{NewsID: uuid.New(), TenantID: &tenantID, Status: models.NewsStatusPublished}
+		mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), fmt.Sprintf("%s: %s", basePrefix, newsBase.NewsID)).Return(newsBase, nil)
+
+		newsByID, err := newsUC.GetNewsByID(ctx, newsBase.NewsID)
+		require.NoError(t, err)
+		require.Equal(t, newsBase.NewsID, newsByID.NewsID)
+	})
+
+	t.Run("Other tenant news can not be deleted", func(t *testing.T) {
+		newsID := uuid.New()
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), newsID).Return(&models.NewsBase{NewsID: newsID, TenantID: &otherTenantID, Status: models.NewsStatusPublished}, nil)
+
+		err := newsUC.Delete(ctx, newsID)
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("List is scoped to tenant", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNews(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, lq *utils.ListQuery, _ *utils.PaginationQuery) (*models.NewsList, error) {
+				where, args := lq.Where("")
+				require.Equal(t, "tenant_id = $1", where)
+				require.Equal(t, []interface{}{tenantID}, args)
+				return &models.NewsList{}, nil
+			})
+
+		_, err := newsUC.GetNews(ctx, &utils.PaginationQuery{Page: 1, Size: 10}, url.Values{})
+		require.NoError(t, err)
+	})
+
+	t.Run("Created news belongs to tenant, same content is looked up within tenant", func(t *testing.T) {
+		userCtx := context.WithValue(ctx, utils.UserCtxKey{}, &models.User{UserID: uuid.New()})
+		news := &models.News{Title: "Tenant scoped title", Content: "Tenant scoped news content"}
+		mockNewsRepo.EXPECT().GetNewsByContentHash(gomock.Any(), utils.ContentHash(news.Content), &tenantID).Return(nil, sql.ErrNoRows)
+		mockNewsRepo.EXPECT().Create(gomock.Any(), news).Return(news, nil)
+		mockRedisRepo.EXPECT().DeleteNewsCtx(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+		_, err := newsUC.Create(userCtx, news)
+		require.NoError(t, err)
+		require.Equal(t, &tenantID, news.TenantID)
+	})
+
+	t.Run("Timeline is cached per tenant", func(t *testing.T) {
+		timelineCacheKey := fmt.Sprintf("%s: %s:%s:%s", basePrefix, tenantKey, tenantID, timelineKey)
+		timeline := []*models.TimelineBucket{{Month: time.Now().UTC(), Count: 1}}
+		mockRedisRepo.EXPECT().GetTimelineCtx(gomock.Any(), timelineCacheKey).Return(nil, redis.Nil)
+		mockNewsRepo.EXPECT().GetTimeline(gomock.Any()).Return(timeline, nil)
+		mockRedisRepo.EXPECT().SetTimelineCtx(gomock.Any(), timelineCacheKey, timelineCacheDuration, timeline).Return(nil)
+
+		cached, err := newsUC.GetTimeline(ctx)
+		require.NoError(t, err)
+		require.Equal(t, timeline, cached)
+	})
+
+	t.Run("Other tenant news is not served by slug", func(t *testing.T) {
+		slug := "shared-slug"
+		own := &models.NewsBase{NewsID: uuid.New(), Slug: &slug, TenantID: &tenantID, Status: models.NewsStatusPublished}
+		other := &models.NewsBase{NewsID: uuid.New(), Slug: &slug, TenantID: &otherTenantID, Status: models.NewsStatusPublished}
+		slugCacheKey := fmt.Sprintf("%s: %s:%s:%s:%s", basePrefix, tenantKey, tenantID, slugKey, slug)
+		mockRedisRepo.EXPECT().GetNewsIDsCtx(gomock.Any(), []string{slugCacheKey}).Return([]string{other.NewsID.String()}, nil)
+		mockRedisRepo.EXPECT().GetNewsByKeysCtx(gomock.Any(), []string{fmt.Sprintf("%s: %s", basePrefix, other.NewsID)}).
+			Return([]*models.NewsBase{other}, nil)
+		mockNewsRepo.EXPECT().GetNewsBySlugs(gomock.Any(), []string{slug}).Return([]*models.NewsBase{other, own}, nil)
+		mockRedisRepo.EXPECT().SetNewsCtx(gomock.Any(), fmt.Sprintf("%s: %s", basePrefix, own.NewsID), cacheDuration, own).Return(nil)
+		mockRedisRepo.EXPECT().SetNewsIDCtx(gomock.Any(), slugCacheKey, cacheDuration, own.NewsID.String()).Return(nil)
+
+		news, err := newsUC.GetBySlugs(ctx, []string{slug})
+		require.NoError(t, err)
+		require.Len(t, news, 1)
+		require.Equal(t, own.NewsID, news[0].NewsID)
+	})
+
+	t.Run("Neighbors of other tenant news are forbidden", func(t *testing.T) {
+		newsBase := &models.NewsBase{NewsID: uuid.New(), TenantID: &otherTenantID, Status: models.NewsStatusPublished}
+		mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), fmt.Sprintf("%s: %s", basePrefix, newsBase.NewsID)).Return(newsBase, nil)
+
+		prev, next, err := newsUC.GetNeighbors(ctx, newsBase.NewsID)
+		require.Nil(t, prev)
+		require.Nil(t, next)
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+	})
 }
 
-func TestNewsUC_SearchByTitle(t *testing.T) {
+func TestNewsUC_GetNewsByIDInLocale(t *testing.T) {
 	t.Parallel()
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	apiLogger := logger.NewApiLogger(nil)
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
 	mockNewsRepo := mock.NewMockRepository(ctrl)
 	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
-	newsUC := NewNewsUseCase(nil, mockNewsRepo, mockRedisRepo, apiLogger)
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+	mockRedisRepo.EXPECT().IncrViewsCtx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
-	ctx := context.Background()
-	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.SearchByTitle")
-	defer span.Finish()
-	query := &utils.PaginationQuery{
-		Size:    10,
-		Page:    1,
-		OrderBy: "",
+	newsBase := &models.NewsBase{NewsID: uuid.New(), Title: "Original title", Content: "Original content", Status: models.NewsStatusPublished}
+	newsKey := fmt.Sprintf("%s: %s", basePrefix, newsBase.NewsID)
+	localeKey := func(locale string) string {
+		return fmt.Sprintf("%s: %s:%s:%s", basePrefix, translationKey, newsBase.NewsID, locale)
 	}
 
-	newsList := &models.NewsList{}
-	title := "title"
+	t.Run("Present translation", func(t *testing.T) {
+		translation := &models.NewsTranslation{NewsID: newsBase.NewsID, Locale: "pt-BR", Title: "Título traduzido", Content: "Conteúdo traduzido"}
+		mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), newsKey).Return(newsBase, nil)
+		mockRedisRepo.EXPECT().GetTranslationCtx(gomock.Any(), localeKey("pt-BR")).Return(nil, redis.Nil)
+		mockNewsRepo.EXPECT().GetTranslation(gomock.Any(), newsBase.NewsID, "pt-BR").Return(translation, nil)
+		mockRedisRepo.EXPECT().SetTranslationCtx(gomock.Any(), localeKey("pt-BR"), cacheDuration, translation).Return(nil)
 
-	mockNewsRepo.EXPECT().SearchByTitle(ctxWithTrace, title, query).Return(newsList, nil)
+		n, err := newsUC.GetNewsByIDInLocale(context.Background(), newsBase.NewsID, "pt-br")
+		require.NoError(t, err)
+		require.Equal(t, "pt-BR", n.Locale)
+		require.Equal(t, translation.Title, n.Title)
+		require.Equal(t, translation.Content, n.Content)
+		require.Equal(t, "Original title", newsBase.Title)
+	})
 
-	news, err := newsUC.SearchByTitle(ctx, title, query)
-	require.NoError(t, err)
+	t.Run("Cached translation", func(t *testing.T) {
+		translation := &models.NewsTranslation{NewsID: newsBase.NewsID, Locale: "de", Title: "Übersetzter Titel", Content: "Übersetzter Inhalt"}
+		mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), newsKey).Return(newsBase, nil)
+		mockRedisRepo.EXPECT().GetTranslationCtx(gomock.Any(), localeKey("de")).Return(translation, nil)
+
+		n, err := newsUC.GetNewsByIDInLocale(context.Background(), newsBase.NewsID, "de")
+		require.NoError(t, err)
+		require.Equal(t, translation.Title, n.Title)
+	})
+
+	t.Run("Missing translation falls back to default", func(t *testing.T) {
+		mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), newsKey).Return(newsBase, nil)
+		mockRedisRepo.EXPECT().GetTranslationCtx(gomock.Any(), localeKey("fr")).Return(nil, redis.Nil)
+		mockNewsRepo.EXPECT().GetTranslation(gomock.Any(), newsBase.NewsID, "fr").Return(nil, errors.Wrap(sql.ErrNoRows, "newsRepo.GetTranslation"))
+
+		n, err := newsUC.GetNewsByIDInLocale(context.Background(), newsBase.NewsID, "fr")
+		require.NoError(t, err)
+		require.Equal(t, "en", n.Locale)
+		require.Equal(t, newsBase.Title, n.Title)
+		require.Equal(t, newsBase.Content, n.Content)
+	})
+
+	t.Run("Default locale", func(t *testing.T) {
+		mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), newsKey).Return(newsBase, nil)
+
+		n, err := newsUC.GetNewsByIDInLocale(context.Background(), newsBase.NewsID, "en")
+		require.NoError(t, err)
+		require.Equal(t, "en", n.Locale)
+		require.Equal(t, newsBase.Title, n.Title)
+	})
+
+	t.Run("Invalid locale", func(t *testing.T) {
+		_, err := newsUC.GetNewsByIDInLocale(context.Background(), newsBase.NewsID, "not a locale")
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+
+		_, err = newsUC.GetTranslation(context.Background(), newsBase.NewsID, "english!")
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Translation in default locale is rejected", func(t *testing.T) {
+		_, err := newsUC.SetTranslation(context.Background(), newsBase.NewsID, "EN", &models.NewsTranslation{Title: "Some long title", Content: "Some long enough content"})
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_ReadingPosition(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	newsID := uuid.New()
+	reader := &models.User{UserID: uuid.New()}
+	otherReader := &models.User{UserID: uuid.New()}
+	readerCtx := context.WithValue(context.Background(), utils.UserCtxKey{}, reader)
+	otherReaderCtx := context.WithValue(context.Background(), utils.UserCtxKey{}, otherReader)
+	positionKey := func(userID uuid.UUID) string {
+		return fmt.Sprintf("%s: %s:%s:%s", basePrefix, readingPositionKey, userID, newsID)
+	}
+
+	t.Run("Save", func(t *testing.T) {
+		saved := &models.ReadingPosition{UserID: reader.UserID, NewsID: newsID, Position: 0.4}
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), newsID).Return(&models.NewsBase{NewsID: newsID}, nil)
+		mockNewsRepo.EXPECT().SaveReadingPosition(gomock.Any(), &models.ReadingPosition{UserID: reader.UserID, NewsID: newsID, Position: 0.4}).Return(saved, nil)
+		mockRedisRepo.EXPECT().SetReadingPositionCtx(gomock.Any(), positionKey(reader.UserID), cacheDuration, saved).Return(nil)
+
+		position, err := newsUC.SaveReadingPosition(readerCtx, newsID, 0.4)
+		require.NoError(t, err)
+		require.Equal(t, saved, position)
+	})
+
+	t.Run("Get cached", func(t *testing.T) {
+		cached := &models.ReadingPosition{UserID: reader.UserID, NewsID: newsID, Position: 0.4}
+		mockRedisRepo.EXPECT().GetReadingPositionCtx(gomock.Any(), positionKey(reader.UserID)).Return(cached, nil)
+
+		position, err := newsUC.GetReadingPosition(readerCtx, newsID)
+		require.NoError(t, err)
+		require.Equal(t, 0.4, position.Position)
+	})
+
+	t.Run("Other user does not see position", func(t *testing.T) {
+		mockRedisRepo.EXPECT().GetReadingPositionCtx(gomock.Any(), positionKey(otherReader.UserID)).Return(nil, redis.Nil)
+		mockNewsRepo.EXPECT().GetReadingPosition(gomock.Any(), otherReader.UserID, newsID).Return(nil, errors.Wrap(sql.ErrNoRows, "newsRepo.GetReadingPosition"))
+
+		_, err := newsUC.GetReadingPosition(otherReaderCtx, newsID)
+		require.Equal(t, http.StatusNotFound, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Get from repository", func(t *testing.T) {
+		stored := &models.ReadingPosition{UserID: otherReader.UserID, NewsID: newsID, Position: 1}
+		mockRedisRepo.EXPECT().GetReadingPositionCtx(gomock.Any(), positionKey(otherReader.UserID)).Return(nil, redis.Nil)
+		mockNewsRepo.EXPECT().GetReadingPosition(gomock.Any(), otherReader.UserID, newsID).Return(stored, nil)
+		mockRedisRepo.EXPECT().SetReadingPositionCtx(gomock.Any(), positionKey(otherReader.UserID), cacheDuration, stored).Return(nil)
+
+		position, err := newsUC.GetReadingPosition(otherReaderCtx, newsID)
+		require.NoError(t, err)
+		require.Equal(t, stored, position)
+	})
+
+	t.Run("Position out of range", func(t *testing.T) {
+		_, err := newsUC.SaveReadingPosition(readerCtx, newsID, 1.5)
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		_, err := newsUC.SaveReadingPosition(context.Background(), newsID, 0.5)
+		require.Equal(t, http.StatusUnauthorized, httpErrors.ParseErrors(err).Status())
+
+		_, err = newsUC.GetReadingPosition(context.Background(), newsID)
+		require.Equal(t, http.StatusUnauthorized, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_GetNewsByID_ReadingTime(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{News: config.NewsConfig{ReadingWordsPerMinute: 100}}
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+	mockRedisRepo.EXPECT().IncrViewsCtx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	cases := []struct {
+		name    string
+		content string
+		minutes int
+	}{
+		{name: "Short content", content: "Only a handful of words here", minutes: 1},
+		{name: "Long content", content: strings.Repeat("word ", 450), minutes: 5},
+		{name: "HTML content", content: "<p>" + strings.Repeat("<b>word</b> ", 150) + "</p><script>" + strings.Repeat("skipped ", 500) + "</script>", minutes: 2},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			newsBase := &models.NewsBase{NewsID: uuid.New(), Content: c.content, Status: models.NewsStatusPublished}
+			mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), fmt.Sprintf("%s: %s", basePrefix, newsBase.NewsID)).Return(newsBase, nil)
+
+			newsByID, err := newsUC.GetNewsByID(context.Background(), newsBase.NewsID)
+			require.NoError(t, err)
+			require.Equal(t, c.minutes, newsByID.ReadingTimeMinutes)
+		})
+	}
+}
+
+func TestNewsUC_GetNewsByID_StaleWhileRevalidate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Redis: config.RedisConfig{StaleWhileRevalidate: 10 * time.Minute}}
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+	mockRedisRepo.EXPECT().IncrViewsCtx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	hardSeconds := cacheDuration + 600
+
+	t.Run("Fresh", func(t *testing.T) {
+		newsBase := &models.NewsBase{NewsID: uuid.New(), Title: "Cached", Status: models.NewsStatusPublished}
+		cacheKey := fmt.Sprintf("%s: %s", basePrefix, newsBase.NewsID)
+
+		mockRedisRepo.EXPECT().GetNewsWithFreshnessCtx(gomock.Any(), cacheKey).Return(newsBase, time.Now().Add(time.Minute), nil)
+
+		newsByID, err := newsUC.GetNewsByID(context.Background(), newsBase.NewsID)
+		require.NoError(t, err)
+		require.Equal(t, newsBase, newsByID)
+	})
+
+	t.Run("Stale but served", func(t *testing.T) {
+		stale := &models.NewsBase{NewsID: uuid.New(), Title: "Stale", Status: models.NewsStatusPublished}
+		fresh := &models.NewsBase{NewsID: stale.NewsID, Title: "Fresh", Status: models.NewsStatusPublished}
+		cacheKey := fmt.Sprintf("%s: %s", basePrefix, stale.NewsID)
+		refreshed := make(chan struct{})
+
+		mockRedisRepo.EXPECT().GetNewsWithFreshnessCtx(gomock.Any(), cacheKey).Return(stale, time.Now().Add(-time.Second), nil)
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), stale.NewsID).Return(fresh, nil)
+		mockRedisRepo.EXPECT().SetNewsWithFreshnessCtx(gomock.Any(), cacheKey, hardSeconds, gomock.Any(), fresh).
+			DoAndReturn(func(_ context.Context, _ string, _ int, freshUntil time.Time, _ *models.NewsBase) error {
+				require.True(t, freshUntil.After(time.Now()))
+				close(refreshed)
+				return nil
+			})
+
+		newsByID, err := newsUC.GetNewsByID(context.Background(), stale.NewsID)
+		require.NoError(t, err)
+		require.Equal(t, stale, newsByID)
+
+		select {
+		case <-refreshed:
+		case <-time.After(time.Second):
+			t.Fatal("stale news was not refreshed")
+		}
+	})
+
+	t.Run("Hard expired", func(t *testing.T) {
+		newsBase := &models.NewsBase{NewsID: uuid.New(), Title: "From db", Status: models.NewsStatusPublished}
+		cacheKey := fmt.Sprintf("%s: %s", basePrefix, newsBase.NewsID)
+
+		mockRedisRepo.EXPECT().GetNewsWithFreshnessCtx(gomock.Any(), cacheKey).Return(nil, time.Time{}, redis.Nil)
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), newsBase.NewsID).Return(newsBase, nil)
+		mockRedisRepo.EXPECT().SetNewsWithFreshnessCtx(gomock.Any(), cacheKey, hardSeconds, gomock.Any(), newsBase).Return(nil)
+
+		newsByID, err := newsUC.GetNewsByID(context.Background(), newsBase.NewsID)
+		require.NoError(t, err)
+		require.Equal(t, newsBase, newsByID)
+	})
+}
+
+func TestNewsUC_GetCacheEntry(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.Background()
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetCacheEntry")
+	defer span.Finish()
+
+	newsUID := uuid.New()
+	cacheKey := fmt.Sprintf("%s: %s", basePrefix, newsUID)
+
+	t.Run("Cached entry", func(t *testing.T) {
+		cached := []byte(fmt.Sprintf(`{"news_id":"%s","title":"cached"}`, newsUID))
+		mockRedisRepo.EXPECT().GetRawCtx(ctxWithTrace, cacheKey).Return(cached, 90*time.Second+500*time.Millisecond, nil)
+
+		entry, err := newsUC.GetCacheEntry(ctx, newsUID)
+		require.NoError(t, err)
+		require.True(t, entry.Hit)
+		require.Equal(t, cacheKey, entry.Key)
+		require.Equal(t, int64(90), *entry.TTLSeconds)
+		require.JSONEq(t, string(cached), string(entry.Value))
+	})
+
+	t.Run("Missing or expired entry", func(t *testing.T) {
+		mockRedisRepo.EXPECT().GetRawCtx(ctxWithTrace, cacheKey).Return(nil, time.Duration(0), nil)
+
+		entry, err := newsUC.GetCacheEntry(ctx, newsUID)
+		require.NoError(t, err)
+		require.False(t, entry.Hit)
+		require.Nil(t, entry.TTLSeconds)
+		require.Nil(t, entry.Value)
+	})
+
+	t.Run("Entry without expiry", func(t *testing.T) {
+		mockRedisRepo.EXPECT().GetRawCtx(ctxWithTrace, cacheKey).Return([]byte("not json"), time.Duration(-1), nil)
+
+		entry, err := newsUC.GetCacheEntry(ctx, newsUID)
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), *entry.TTLSeconds)
+		require.Equal(t, "not json", entry.Raw)
+		require.Nil(t, entry.Value)
+	})
+}
+
+func TestNewsUC_Delete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(nil, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	newsUID := uuid.New()
+	userUID := uuid.New()
+	newsBase := &models.NewsBase{
+		NewsID:   newsUID,
+		AuthorID: userUID,
+	}
+	cacheKey := fmt.Sprintf("%s: %s", basePrefix, newsUID)
+
+	user := &models.User{
+		UserID: userUID,
+	}
+
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, user)
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.Delete")
+	defer span.Finish()
+
+	mockNewsRepo.EXPECT().GetNewsByID(ctxWithTrace, gomock.Eq(newsBase.NewsID)).Return(newsBase, nil)
+	mockNewsRepo.EXPECT().Delete(ctxWithTrace, gomock.Eq(newsUID), userUID).Return(nil)
+	mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, gomock.Eq(cacheKey)).Return(nil)
+	mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, fmt.Sprintf("%s: %s:%s", basePrefix, authorStatusCountsKey, userUID)).Return(nil)
+
+	err := newsUC.Delete(ctx, newsBase.NewsID)
+	require.NoError(t, err)
+	require.Nil(t, err)
+}
+
+func TestNewsUC_GetNews(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.Background()
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetNews")
+	defer span.Finish()
+
+	query := &utils.PaginationQuery{
+		Size:    10,
+		Page:    1,
+		OrderBy: "",
+	}
+
+	newsList := &models.NewsList{}
+
+	mockNewsRepo.EXPECT().GetNews(ctxWithTrace, gomock.Any(), query).Return(newsList, nil)
+
+	news, err := newsUC.GetNews(ctx, query, nil)
+	require.NoError(t, err)
+	require.Nil(t, err)
+	require.NotNil(t, news)
+}
+
+func TestNewsUC_GetNews_DefaultSort(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Pagination: config.PaginationConfig{DefaultOrderBy: "title", DefaultSort: "-featured,-created_at"}}
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	t.Run("Configured default order", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNews(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, lq *utils.ListQuery, _ *utils.PaginationQuery) (*models.NewsList, error) {
+				require.Equal(t, newsListSpec.Sort["featured"]+" DESC, created_at DESC, news_id DESC", lq.Order())
+				return &models.NewsList{}, nil
+			})
+
+		_, err := newsUC.GetNews(context.Background(), &utils.PaginationQuery{Page: 1}, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("Explicit order takes precedence", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNews(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, lq *utils.ListQuery, _ *utils.PaginationQuery) (*models.NewsList, error) {
+				require.Empty(t, lq.Sorts)
+				require.Equal(t, "updated_at DESC, news_id DESC", lq.Order())
+				return &models.NewsList{}, nil
+			})
+
+		pq := &utils.PaginationQuery{Page: 1, OrderBy: "updated_at", Direction: "desc"}
+		_, err := newsUC.GetNews(context.Background(), pq, url.Values{"orderBy": {"updated_at"}, "direction": {"desc"}})
+		require.NoError(t, err)
+	})
+
+	t.Run("Invalid configured order", func(t *testing.T) {
+		badUC := NewNewsUseCase(&config.Config{Pagination: config.PaginationConfig{DefaultSort: "-likes"}}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+		_, err := badUC.GetNews(context.Background(), &utils.PaginationQuery{Page: 1}, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestNewsUC_GetNews_PaginationConfig(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Pagination: config.PaginationConfig{DefaultSize: 20, MaxSize: 30, DefaultOrderBy: "created_at", DefaultDirection: "desc"}}
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.Background()
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetNews")
+	defer span.Finish()
+
+	t.Run("Defaults applied", func(t *testing.T) {
+		resolved := &utils.PaginationQuery{Page: 1, Size: 20, OrderBy: "created_at", Direction: "desc"}
+		mockNewsRepo.EXPECT().GetNews(ctxWithTrace, gomock.Any(), resolved).Return(&models.NewsList{}, nil)
+
+		_, err := newsUC.GetNews(ctx, &utils.PaginationQuery{Page: 1}, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("Size capped", func(t *testing.T) {
+		resolved := &utils.PaginationQuery{Page: 1, Size: 30, OrderBy: "created_at", Direction: "desc"}
+		mockNewsRepo.EXPECT().GetNews(ctxWithTrace, gomock.Any(), resolved).Return(&models.NewsList{}, nil)
+
+		_, err := newsUC.GetNews(ctx, &utils.PaginationQuery{Page: 1, Size: 1000}, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("Filters parsed by spec", func(t *testing.T) {
+		authorID := uuid.New()
+		lq := &utils.ListQuery{
+			Conditions: []utils.ListCondition{{Column: "author_id", Operator: "=", Value: authorID}},
+			OrderBy:    "updated_at",
+			Direction:  "DESC",
+			TieBreaker: "news_id",
+		}
+		mockNewsRepo.EXPECT().GetNews(ctxWithTrace, lq, gomock.Any()).Return(&models.NewsList{}, nil)
+
+		params := url.Values{"author_id": {authorID.String()}, "orderBy": {"updated_at"}}
+		_, err := newsUC.GetNews(ctx, &utils.PaginationQuery{Page: 1, OrderBy: "updated_at"}, params)
+		require.NoError(t, err)
+	})
+
+	t.Run("Unknown filter rejected", func(t *testing.T) {
+		_, err := newsUC.GetNews(ctx, &utils.PaginationQuery{Page: 1}, url.Values{"status": {"draft"}})
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_GetNews_Categories(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.Background()
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetNews")
+	defer span.Finish()
+
+	listQuery := func(conditions ...utils.ListCondition) *utils.ListQuery {
+		return &utils.ListQuery{Conditions: conditions, OrderBy: "created_at", Direction: "ASC", TieBreaker: "news_id"}
+	}
+
+	t.Run("One category", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetUnknownCategories(ctxWithTrace, []string{"golang"}).Return([]string{}, nil)
+		lq := listQuery(utils.ListCondition{Column: "category", Operator: "=", Value: `{"golang"}`, ArrayType: "text"})
+		mockNewsRepo.EXPECT().GetNews(ctxWithTrace, lq, gomock.Any()).Return(&models.NewsList{}, nil)
+
+		_, err := newsUC.GetNews(ctx, &utils.PaginationQuery{Page: 1}, url.Values{"categories": {"golang"}})
+		require.NoError(t, err)
+	})
+
+	t.Run("Several categories with other filters", func(t *testing.T) {
+		authorID := uuid.New()
+		mockNewsRepo.EXPECT().GetUnknownCategories(ctxWithTrace, []string{"golang", "rust"}).Return([]string{}, nil)
+		lq := listQuery(
+			utils.ListCondition{Column: "author_id", Operator: "=", Value: authorID},
+			utils.ListCondition{Column: "category", Operator: "=", Value: `{"golang","rust"}`, ArrayType: "text"},
+		)
+		mockNewsRepo.EXPECT().GetNews(ctxWithTrace, lq, gomock.Any()).Return(&models.NewsList{}, nil)
+
+		params := url.Values{"categories": {"golang", "rust"}, "author_id": {authorID.String()}}
+		_, err := newsUC.GetNews(ctx, &utils.PaginationQuery{Page: 1}, params)
+		require.NoError(t, err)
+	})
+
+	t.Run("Unknown category in list", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetUnknownCategories(ctxWithTrace, []string{"golang", "cobol"}).Return([]string{"cobol"}, nil)
+
+		_, err := newsUC.GetNews(ctx, &utils.PaginationQuery{Page: 1}, url.Values{"categories": {"golang", "cobol"}})
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+		require.Contains(t, err.Error(), "cobol")
+	})
+
+	t.Run("Too many categories", func(t *testing.T) {
+		_, err := newsUC.GetNews(ctx, &utils.PaginationQuery{Page: 1}, url.Values{"categories": make([]string, maxCategoriesFilter+1)})
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_GetNews_MaxResultWindow(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Server: config.ServerConfig{MaxResultWindow: 100}}
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.Background()
+
+	t.Run("At boundary", func(t *testing.T) {
+		span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetNews")
+		defer span.Finish()
+
+		query := &utils.PaginationQuery{Size: 10, Page: 10}
+		mockNewsRepo.EXPECT().GetNews(ctxWithTrace, gomock.Any(), query).Return(&models.NewsList{}, nil)
+
+		newsList, err := newsUC.GetNews(ctx, query, nil)
+		require.NoError(t, err)
+		require.NotNil(t, newsList)
+	})
+
+	t.Run("Beyond boundary", func(t *testing.T) {
+		query := &utils.PaginationQuery{Size: 10, Page: 11}
+
+		newsList, err := newsUC.GetNews(ctx, query, nil)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, httpErrors.ErrDeepPagination))
+		require.Nil(t, newsList)
+	})
+
+	t.Run("Beyond boundary search", func(t *testing.T) {
+		query := &utils.PaginationQuery{Size: 50, Page: 3}
+
+		newsList, err := newsUC.SearchByTitle(ctx, "title", "", query)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, httpErrors.ErrDeepPagination))
+		require.Nil(t, newsList)
+	})
+}
+
+func TestNewsUC_SearchByTitle(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.Background()
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.SearchByTitle")
+	defer span.Finish()
+	query := &utils.PaginationQuery{
+		Size:    10,
+		Page:    1,
+		OrderBy: "",
+	}
+
+	newsList := &models.NewsList{}
+	title := "title"
+
+	mockNewsRepo.EXPECT().SearchByTitle(ctxWithTrace, title, models.SearchScopeTitle, query).Return(newsList, nil)
+
+	news, err := newsUC.SearchByTitle(ctx, title, "", query)
+	require.NoError(t, err)
 	require.Nil(t, err)
 	require.NotNil(t, news)
+
+	t.Run("Normalized query", func(t *testing.T) {
+		mockNewsRepo.EXPECT().SearchByTitle(ctxWithTrace, "clean architecture", models.SearchScopeTitle, query).Return(newsList, nil)
+
+		_, err := newsUC.SearchByTitle(ctx, "  clean \t  architecture ", "", query)
+		require.NoError(t, err)
+	})
+
+	t.Run("Empty query", func(t *testing.T) {
+		_, err := newsUC.SearchByTitle(ctx, "", "", query)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, httpErrors.ErrEmptySearchQuery))
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Whitespace only query", func(t *testing.T) {
+		_, err := newsUC.SearchByTitle(ctx, " \t\n ", "", query)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, httpErrors.ErrEmptySearchQuery))
+	})
+
+	t.Run("Empty query lists all when configured", func(t *testing.T) {
+		listAllUC := NewNewsUseCase(&config.Config{News: config.NewsConfig{EmptySearchListsAll: true}}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+		mockNewsRepo.EXPECT().GetNews(gomock.Any(), gomock.Any(), query).Return(newsList, nil)
+
+		news, err := listAllUC.SearchByTitle(ctx, "   ", "", query)
+		require.NoError(t, err)
+		require.Equal(t, newsList, news)
+	})
+
+	t.Run("Scopes", func(t *testing.T) {
+		for scope, expected := range map[string]models.SearchScope{
+			"title":   models.SearchScopeTitle,
+			"content": models.SearchScopeContent,
+			"all":     models.SearchScopeAll,
+		} {
+			mockNewsRepo.EXPECT().SearchByTitle(ctxWithTrace, "golang", expected, query).Return(newsList, nil)
+
+			_, err := newsUC.SearchByTitle(ctx, "golang", scope, query)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("Unknown scope", func(t *testing.T) {
+		_, err := newsUC.SearchByTitle(ctx, "golang", "comments", query)
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_CountSearchByTitle(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.Background()
+	query := &utils.PaginationQuery{Size: 10, Page: 1}
+
+	t.Run("Count matches search total", func(t *testing.T) {
+		mockNewsRepo.EXPECT().SearchByTitle(gomock.Any(), "clean architecture", models.SearchScopeAll, query).
+			Return(&models.NewsList{TotalCount: 42}, nil)
+		mockNewsRepo.EXPECT().CountSearchByTitle(gomock.Any(), "clean architecture", models.SearchScopeAll).Return(42, nil)
+
+		newsList, err := newsUC.SearchByTitle(ctx, "  clean \t architecture ", "all", query)
+		require.NoError(t, err)
+		count, err := newsUC.CountSearchByTitle(ctx, "  clean \t architecture ", "all")
+		require.NoError(t, err)
+		require.Equal(t, newsList.TotalCount, count.TotalCount)
+	})
+
+	t.Run("Empty query", func(t *testing.T) {
+		_, err := newsUC.CountSearchByTitle(ctx, " \t\n ", "")
+		require.True(t, errors.Is(err, httpErrors.ErrEmptySearchQuery))
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Empty query counts all when configured", func(t *testing.T) {
+		listAllUC := NewNewsUseCase(&config.Config{News: config.NewsConfig{EmptySearchListsAll: true}}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+		mockNewsRepo.EXPECT().CountSearchByTitle(gomock.Any(), "", models.SearchScopeTitle).Return(7, nil)
+
+		count, err := listAllUC.CountSearchByTitle(ctx, "", "content")
+		require.NoError(t, err)
+		require.Equal(t, 7, count.TotalCount)
+	})
+
+	t.Run("Unknown scope", func(t *testing.T) {
+		_, err := newsUC.CountSearchByTitle(ctx, "golang", "comments")
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_GetTimeline(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(nil, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.Background()
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetTimeline")
+	defer span.Finish()
+	cacheKey := fmt.Sprintf("%s: %s", basePrefix, timelineKey)
+
+	timeline := []*models.TimelineBucket{
+		{Month: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), Count: 2},
+		{Month: time.Date(2020, time.February, 1, 0, 0, 0, 0, time.UTC), Count: 5},
+	}
+
+	mockRedisRepo.EXPECT().GetTimelineCtx(ctxWithTrace, gomock.Eq(cacheKey)).Return(nil, redis.Nil)
+	mockNewsRepo.EXPECT().GetTimeline(ctxWithTrace).Return(timeline, nil)
+	mockRedisRepo.EXPECT().SetTimelineCtx(ctxWithTrace, cacheKey, timelineCacheDuration, timeline).Return(nil)
+
+	result, err := newsUC.GetTimeline(ctx)
+	require.NoError(t, err)
+	require.Equal(t, timeline, result)
+}
+
+func TestNewsUC_UpdateStatusBatch(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(nil, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.Background()
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.UpdateStatusBatch")
+	defer span.Finish()
+
+	ids := []uuid.UUID{uuid.New(), uuid.New()}
+
+	t.Run("Invalidates cache", func(t *testing.T) {
+		mockNewsRepo.EXPECT().UpdateStatusBatch(ctxWithTrace, ids, models.NewsStatusArchived, false).Return(ids[:1], nil)
+		mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, fmt.Sprintf("%s: %s", basePrefix, ids[0])).Return(nil)
+
+		result, err := newsUC.UpdateStatusBatch(ctx, ids, models.NewsStatusArchived, false)
+		require.NoError(t, err)
+		require.Equal(t, 1, result.Affected)
+		require.Equal(t, ids[:1], result.IDs)
+		require.False(t, result.DryRun)
+	})
+
+	t.Run("Dry run keeps cache", func(t *testing.T) {
+		mockNewsRepo.EXPECT().UpdateStatusBatch(ctxWithTrace, ids, models.NewsStatusArchived, true).Return(ids, nil)
+
+		result, err := newsUC.UpdateStatusBatch(ctx, ids, models.NewsStatusArchived, true)
+		require.NoError(t, err)
+		require.Equal(t, 2, result.Affected)
+		require.True(t, result.DryRun)
+	})
+
+	t.Run("Invalid status", func(t *testing.T) {
+		result, err := newsUC.UpdateStatusBatch(ctx, ids, "deleted", false)
+		require.Error(t, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("News of other tenant can not be changed", func(t *testing.T) {
+		tenantID := uuid.New()
+		tenantCtx := context.WithValue(ctx, utils.TenantCtxKey{}, tenantID)
+		mockNewsRepo.EXPECT().GetOutsideTenant(gomock.Any(), ids, tenantID).Return(ids[1:], nil)
+
+		result, err := newsUC.UpdateStatusBatch(tenantCtx, ids, models.NewsStatusArchived, false)
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+		require.Nil(t, result)
+	})
+
+	t.Run("News of own tenant are changed", func(t *testing.T) {
+		tenantID := uuid.New()
+		tenantCtx := context.WithValue(ctx, utils.TenantCtxKey{}, tenantID)
+		mockNewsRepo.EXPECT().GetOutsideTenant(gomock.Any(), ids, tenantID).Return([]uuid.UUID{}, nil)
+		mockNewsRepo.EXPECT().UpdateStatusBatch(gomock.Any(), ids, models.NewsStatusArchived, true).Return(ids, nil)
+
+		result, err := newsUC.UpdateStatusBatch(tenantCtx, ids, models.NewsStatusArchived, true)
+		require.NoError(t, err)
+		require.Equal(t, 2, result.Affected)
+	})
+}
+
+func TestNewsUC_ReassignAuthor(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, nil, nil, apiLogger)
+
+	ids := []uuid.UUID{uuid.New(), uuid.New()}
+	authorUID := uuid.New()
+
+	t.Run("Dry run", func(t *testing.T) {
+		mockNewsRepo.EXPECT().ReassignAuthor(gomock.Any(), ids, authorUID, true).Return(ids[:1], nil)
+
+		result, err := newsUC.ReassignAuthor(context.Background(), ids, authorUID, true)
+		require.NoError(t, err)
+		require.Equal(t, &models.NewsBatchResult{Affected: 1, IDs: ids[:1], DryRun: true}, result)
+	})
+
+	t.Run("News of other tenant can not be reassigned", func(t *testing.T) {
+		tenantID := uuid.New()
+		tenantCtx := context.WithValue(context.Background(), utils.TenantCtxKey{}, tenantID)
+		mockNewsRepo.EXPECT().GetOutsideTenant(gomock.Any(), ids, tenantID).Return(ids[:1], nil)
+
+		result, err := newsUC.ReassignAuthor(tenantCtx, ids, authorUID, false)
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+		require.Nil(t, result)
+	})
+}
+
+func TestNewsUC_GetMyDrafts(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	query := &utils.PaginationQuery{
+		Size: 10,
+		Page: 1,
+	}
+
+	t.Run("Uses author from context", func(t *testing.T) {
+		user := &models.User{UserID: uuid.New()}
+		ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, user)
+		span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetMyDrafts")
+		defer span.Finish()
+
+		newsList := &models.NewsList{News: make([]*models.News, 0)}
+		mockNewsRepo.EXPECT().GetMyDrafts(ctxWithTrace, user.UserID, query).Return(newsList, nil)
+
+		drafts, err := newsUC.GetMyDrafts(ctx, query)
+		require.NoError(t, err)
+		require.Equal(t, newsList, drafts)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		drafts, err := newsUC.GetMyDrafts(context.Background(), query)
+		require.Error(t, err)
+		require.Nil(t, drafts)
+	})
+}
+
+func TestNewsUC_GetEditedByMe(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	query := &utils.PaginationQuery{
+		Size: 10,
+		Page: 1,
+	}
+
+	t.Run("Uses editor from context", func(t *testing.T) {
+		user := &models.User{UserID: uuid.New()}
+		ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, user)
+		span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetEditedByMe")
+		defer span.Finish()
+
+		newsList := &models.NewsList{News: []*models.News{{NewsID: uuid.New()}}}
+		mockNewsRepo.EXPECT().GetEditedBy(ctxWithTrace, user.UserID, query).Return(newsList, nil)
+
+		edited, err := newsUC.GetEditedByMe(ctx, query)
+		require.NoError(t, err)
+		require.Equal(t, newsList, edited)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		edited, err := newsUC.GetEditedByMe(context.Background(), query)
+		require.Equal(t, http.StatusUnauthorized, httpErrors.ParseErrors(err).Status())
+		require.Nil(t, edited)
+	})
+}
+
+func TestNewsUC_GetFeed(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(nil, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.Background()
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetFeed")
+	defer span.Finish()
+
+	baseURL := "https://example.com"
+	key := fmt.Sprintf("%s: %s", basePrefix, "feed:rss:golang:"+baseURL)
+
+	t.Run("Cached", func(t *testing.T) {
+		cached := []byte("<rss></rss>")
+		mockRedisRepo.EXPECT().GetFeedCtx(ctxWithTrace, key).Return(cached, nil)
+
+		rendered, err := newsUC.GetFeed(ctx, "golang", "rss", baseURL)
+		require.NoError(t, err)
+		require.Equal(t, cached, rendered)
+	})
+
+	t.Run("Rendered and cached", func(t *testing.T) {
+		category := "golang"
+		recent := []*models.News{
+			{NewsID: uuid.New(), Title: "first & second", Content: "content", Category: &category, CreatedAt: time.Now()},
+		}
+
+		mockRedisRepo.EXPECT().GetFeedCtx(ctxWithTrace, key).Return(nil, redis.Nil)
+		mockNewsRepo.EXPECT().GetRecent(ctxWithTrace, "golang", feedSize).Return(recent, nil)
+		mockRedisRepo.EXPECT().SetFeedCtx(ctxWithTrace, key, feedCacheDuration, gomock.Any()).Return(nil)
+
+		rendered, err := newsUC.GetFeed(ctx, "golang", "rss", baseURL)
+		require.NoError(t, err)
+		require.Contains(t, string(rendered), "first &amp; second")
+		require.Contains(t, string(rendered), baseURL+"/api/v1/news/"+recent[0].NewsID.String())
+	})
+
+	t.Run("Invalid format", func(t *testing.T) {
+		rendered, err := newsUC.GetFeed(ctx, "golang", "json", baseURL)
+		require.Error(t, err)
+		require.Nil(t, rendered)
+	})
+}
+
+func TestNewsUC_GetPreview(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Server: config.ServerConfig{PreviewSecretKey: "secret"}}
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.Background()
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetPreview")
+	defer span.Finish()
+
+	newsUID := uuid.New()
+
+	t.Run("Valid token", func(t *testing.T) {
+		draft := &models.NewsBase{NewsID: newsUID, Status: models.NewsStatusDraft}
+		token := preview.MakeToken(newsUID, time.Now().Add(time.Hour), cfg.Server.PreviewSecretKey)
+		mockNewsRepo.EXPECT().GetNewsByID(ctxWithTrace, gomock.Eq(newsUID)).Return(draft, nil)
+
+		newsBase, err := newsUC.GetPreview(ctx, newsUID, token)
+		require.NoError(t, err)
+		require.Equal(t, draft, newsBase)
+	})
+
+	t.Run("Expired token", func(t *testing.T) {
+		token := preview.MakeToken(newsUID, time.Now().Add(-time.Minute), cfg.Server.PreviewSecretKey)
+
+		newsBase, err := newsUC.GetPreview(ctx, newsUID, token)
+		require.True(t, errors.Is(err, httpErrors.ExpiredPreviewToken))
+		require.Nil(t, newsBase)
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Tampered token", func(t *testing.T) {
+		token := preview.MakeToken(uuid.New(), time.Now().Add(time.Hour), cfg.Server.PreviewSecretKey)
+
+		newsBase, err := newsUC.GetPreview(ctx, newsUID, token)
+		require.True(t, errors.Is(err, httpErrors.InvalidPreviewToken))
+		require.Nil(t, newsBase)
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_GetFeatured(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.Background()
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetFeatured")
+	defer span.Finish()
+	cacheKey := fmt.Sprintf("%s: %s", basePrefix, featuredKey)
+
+	pinnedAt := time.Now().Add(-time.Hour)
+	expired := time.Now().Add(-time.Minute)
+	soon := time.Now().Add(time.Minute)
+
+	forever := &models.News{NewsID: uuid.New(), PinnedAt: &pinnedAt}
+	expiring := &models.News{NewsID: uuid.New(), PinnedAt: &pinnedAt, PinnedUntil: &soon}
+	stale := &models.News{NewsID: uuid.New(), PinnedAt: &pinnedAt, PinnedUntil: &expired}
+
+	t.Run("Expired pin excluded from cache", func(t *testing.T) {
+		mockRedisRepo.EXPECT().GetFeaturedCtx(ctxWithTrace, cacheKey).Return([]*models.News{forever, stale, expiring}, nil)
+
+		featured, err := newsUC.GetFeatured(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []*models.News{forever, expiring}, featured)
+	})
+
+	t.Run("Cache ttl bounded by nearest expiry", func(t *testing.T) {
+		list := []*models.News{forever, expiring}
+		mockRedisRepo.EXPECT().GetFeaturedCtx(ctxWithTrace, cacheKey).Return(nil, redis.Nil)
+		mockNewsRepo.EXPECT().GetFeatured(ctxWithTrace, featuredSize).Return(list, nil)
+		mockRedisRepo.EXPECT().SetFeaturedCtx(ctxWithTrace, cacheKey, gomock.Any(), list).
+			DoAndReturn(func(_ context.Context, _ string, seconds int, _ []*models.News) error {
+				require.True(t, seconds > 0 && seconds <= 60, "ttl %d", seconds)
+				return nil
+			})
+
+		featured, err := newsUC.GetFeatured(ctx)
+		require.NoError(t, err)
+		require.Equal(t, list, featured)
+	})
+}
+
+func TestNewsUC_Pin(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.Background()
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.Pin")
+	defer span.Finish()
+	cacheKey := fmt.Sprintf("%s: %s", basePrefix, featuredKey)
+	newsID := uuid.New()
+	newsKey := fmt.Sprintf("%s: %s", basePrefix, newsID)
+
+	t.Run("Invalidates featured cache", func(t *testing.T) {
+		until := time.Now().Add(time.Hour)
+		mockRedisRepo.EXPECT().GetNewsByIDCtx(ctxWithTrace, newsKey).Return(&models.NewsBase{NewsID: newsID}, nil)
+		mockNewsRepo.EXPECT().Pin(ctxWithTrace, newsID, &until).Return(nil)
+		mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, cacheKey).Return(nil)
+
+		require.NoError(t, newsUC.Pin(ctx, newsID, &until))
+	})
+
+	t.Run("Invalidates featured cache of news tenant", func(t *testing.T) {
+		tenantID := uuid.New()
+		until := time.Now().Add(time.Hour)
+		mockRedisRepo.EXPECT().GetNewsByIDCtx(ctxWithTrace, newsKey).Return(&models.NewsBase{NewsID: newsID, TenantID: &tenantID}, nil)
+		mockNewsRepo.EXPECT().Pin(ctxWithTrace, newsID, &until).Return(nil)
+		mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, cacheKey).Return(nil)
+		mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, fmt.Sprintf("%s: %s:%s:%s", basePrefix, tenantKey, tenantID, featuredKey)).Return(nil)
+
+		require.NoError(t, newsUC.Pin(ctx, newsID, &until))
+	})
+
+	t.Run("Other tenant news can not be pinned", func(t *testing.T) {
+		tenantCtx := context.WithValue(ctx, utils.TenantCtxKey{}, uuid.New())
+		otherTenantID := uuid.New()
+		until := time.Now().Add(time.Hour)
+		mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), newsKey).Return(&models.NewsBase{NewsID: newsID, TenantID: &otherTenantID}, nil)
+
+		err := newsUC.Pin(tenantCtx, newsID, &until)
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Past pinned_until rejected", func(t *testing.T) {
+		until := time.Now().Add(-time.Hour)
+
+		err := newsUC.Pin(ctx, newsID, &until)
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_GetRevisions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	authorUID := uuid.New()
+	newsUID := uuid.New()
+	newsBase := &models.NewsBase{NewsID: newsUID, AuthorID: authorUID}
+
+	t.Run("Author", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &models.User{UserID: authorUID})
+		span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetRevisions")
+		defer span.Finish()
+
+		revisions := []*models.NewsRevision{{RevisionID: uuid.New(), NewsID: newsUID}}
+		mockNewsRepo.EXPECT().GetNewsByID(ctxWithTrace, newsUID).Return(newsBase, nil)
+		mockNewsRepo.EXPECT().GetRevisions(ctxWithTrace, newsUID).Return(revisions, nil)
+
+		result, err := newsUC.GetRevisions(ctx, newsUID)
+		require.NoError(t, err)
+		require.Equal(t, revisions, result)
+	})
+
+	t.Run("Not author", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &models.User{UserID: uuid.New()})
+		span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetRevisions")
+		defer span.Finish()
+
+		mockNewsRepo.EXPECT().GetNewsByID(ctxWithTrace, newsUID).Return(newsBase, nil)
+
+		_, err := newsUC.GetRevisions(ctx, newsUID)
+		require.Error(t, err)
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_RevertTo(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	authorUID := uuid.New()
+	newsUID := uuid.New()
+	revisionUID := uuid.New()
+	newsBase := &models.NewsBase{NewsID: newsUID, AuthorID: authorUID}
+
+	t.Run("Reverts and invalidates cache", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &models.User{UserID: authorUID})
+		span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.RevertTo")
+		defer span.Finish()
+
+		reverted := &models.News{NewsID: newsUID, Content: "old content"}
+		mockNewsRepo.EXPECT().GetNewsByID(ctxWithTrace, newsUID).Return(newsBase, nil)
+		mockNewsRepo.EXPECT().RevertTo(ctxWithTrace, newsUID, revisionUID, authorUID).Return(reverted, nil)
+		mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, fmt.Sprintf("%s: %s", basePrefix, newsUID)).Return(nil)
+		mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, fmt.Sprintf("%s: %s:%s", basePrefix, authorStatusCountsKey, authorUID)).Return(nil)
+
+		result, err := newsUC.RevertTo(ctx, newsUID, revisionUID)
+		require.NoError(t, err)
+		require.Equal(t, reverted, result)
+	})
+
+	t.Run("Not author", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &models.User{UserID: uuid.New()})
+		span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.RevertTo")
+		defer span.Finish()
+
+		mockNewsRepo.EXPECT().GetNewsByID(ctxWithTrace, newsUID).Return(newsBase, nil)
+
+		_, err := newsUC.RevertTo(ctx, newsUID, revisionUID)
+		require.Error(t, err)
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_GetAuthorLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.Background()
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetAuthorLeaderboard")
+	defer span.Finish()
+
+	stats := []*models.AuthorStat{{AuthorID: uuid.New(), Author: "Anna Tie", PublishedCount: 5}}
+
+	t.Run("Defaults and cache miss", func(t *testing.T) {
+		cacheKey := fmt.Sprintf("%s: %s:%d:%d", basePrefix, leaderboardKey, int64(leaderboardDefaultWindow/time.Second), leaderboardDefaultLimit)
+		mockRedisRepo.EXPECT().GetLeaderboardCtx(ctxWithTrace, cacheKey).Return(nil, nil)
+		mockNewsRepo.EXPECT().GetAuthorLeaderboard(ctxWithTrace, gomock.Any(), leaderboardDefaultLimit).
+			DoAndReturn(func(_ context.Context, since time.Time, _ int) ([]*models.AuthorStat, error) {
+				require.WithinDuration(t, time.Now().Add(-leaderboardDefaultWindow), since, time.Minute)
+				return stats, nil
+			})
+		mockRedisRepo.EXPECT().SetLeaderboardCtx(ctxWithTrace, cacheKey, leaderboardCacheDuration, stats).Return(nil)
+
+		result, err := newsUC.GetAuthorLeaderboard(ctx, 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, stats, result)
+	})
+
+	t.Run("Cache hit", func(t *testing.T) {
+		cacheKey := fmt.Sprintf("%s: %s:%d:%d", basePrefix, leaderboardKey, int64(7*24*time.Hour/time.Second), leaderboardMaxLimit)
+		mockRedisRepo.EXPECT().GetLeaderboardCtx(ctxWithTrace, cacheKey).Return(stats, nil)
+
+		result, err := newsUC.GetAuthorLeaderboard(ctx, 7*24*time.Hour, 1000)
+		require.NoError(t, err)
+		require.Equal(t, stats, result)
+	})
+
+	t.Run("Window too wide", func(t *testing.T) {
+		_, err := newsUC.GetAuthorLeaderboard(ctx, 2*leaderboardMaxWindow, 0)
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_CountByStatus(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.Background()
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.CountByStatus")
+	defer span.Finish()
+
+	cacheKey := fmt.Sprintf("%s: %s", basePrefix, statusCountsKey)
+	counts := map[string]int{models.NewsStatusDraft: 0, models.NewsStatusPublished: 340, models.NewsStatusArchived: 8}
+
+	t.Run("Cache miss", func(t *testing.T) {
+		mockRedisRepo.EXPECT().GetStatusCountsCtx(ctxWithTrace, cacheKey).Return(nil, nil)
+		mockNewsRepo.EXPECT().CountByStatus(ctxWithTrace).Return(counts, nil)
+		mockRedisRepo.EXPECT().SetStatusCountsCtx(ctxWithTrace, cacheKey, statusCountsCacheDuration, counts).Return(nil)
+
+		result, err := newsUC.CountByStatus(ctx)
+		require.NoError(t, err)
+		require.Equal(t, counts, result)
+	})
+
+	t.Run("Cache hit", func(t *testing.T) {
+		mockRedisRepo.EXPECT().GetStatusCountsCtx(ctxWithTrace, cacheKey).Return(counts, nil)
+
+		result, err := newsUC.CountByStatus(ctx)
+		require.NoError(t, err)
+		require.Equal(t, counts, result)
+	})
+}
+
+func TestNewsUC_GetAuthorStatusCounts(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.Background()
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetAuthorStatusCounts")
+	defer span.Finish()
+
+	authorID := uuid.New()
+	cacheKey := fmt.Sprintf("%s: %s:%s", basePrefix, authorStatusCountsKey, authorID)
+	counts := map[string]int{models.NewsStatusDraft: 2, models.NewsStatusPublished: 5, models.NewsStatusArchived: 0}
+
+	t.Run("Cache miss", func(t *testing.T) {
+		mockRedisRepo.EXPECT().GetStatusCountsCtx(ctxWithTrace, cacheKey).Return(nil, nil)
+		mockNewsRepo.EXPECT().GetAuthorStatusCounts(ctxWithTrace, authorID).Return(counts, nil)
+		mockRedisRepo.EXPECT().SetStatusCountsCtx(ctxWithTrace, cacheKey, statusCountsCacheDuration, counts).Return(nil)
+
+		result, err := newsUC.GetAuthorStatusCounts(ctx, authorID)
+		require.NoError(t, err)
+		require.Equal(t, counts, result)
+	})
+
+	t.Run("Cache hit", func(t *testing.T) {
+		mockRedisRepo.EXPECT().GetStatusCountsCtx(ctxWithTrace, cacheKey).Return(counts, nil)
+
+		result, err := newsUC.GetAuthorStatusCounts(ctx, authorID)
+		require.NoError(t, err)
+		require.Equal(t, counts, result)
+	})
+}
+
+func TestNewsUC_GetBySlugs(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.Background()
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetBySlugs")
+	defer span.Finish()
+
+	slugs := []string{"first", "second"}
+	first, second := "first", "second"
+	firstNews := &models.NewsBase{NewsID: uuid.New(), Slug: &first, Status: models.NewsStatusPublished}
+	secondNews := &models.NewsBase{NewsID: uuid.New(), Slug: &second, Status: models.NewsStatusPublished}
+	slugKeys := []string{
+		fmt.Sprintf("%s: %s:%s", basePrefix, slugKey, first),
+		fmt.Sprintf("%s: %s:%s", basePrefix, slugKey, second),
+	}
+	firstKey := fmt.Sprintf("%s: %s", basePrefix, firstNews.NewsID)
+	secondKey := fmt.Sprintf("%s: %s", basePrefix, secondNews.NewsID)
+
+	t.Run("All cached", func(t *testing.T) {
+		mockRedisRepo.EXPECT().GetNewsIDsCtx(ctxWithTrace, slugKeys).
+			Return([]string{firstNews.NewsID.String(), secondNews.NewsID.String()}, nil)
+		mockRedisRepo.EXPECT().GetNewsByKeysCtx(ctxWithTrace, []string{firstKey, secondKey}).
+			Return([]*models.NewsBase{firstNews, secondNews}, nil)
+
+		news, err := newsUC.GetBySlugs(ctx, slugs)
+		require.NoError(t, err)
+		require.Equal(t, []*models.NewsBase{firstNews, secondNews}, news)
+	})
+
+	t.Run("Nothing cached", func(t *testing.T) {
+		mockRedisRepo.EXPECT().GetNewsIDsCtx(ctxWithTrace, slugKeys).Return([]string{"", ""}, nil)
+		mockNewsRepo.EXPECT().GetNewsBySlugs(ctxWithTrace, slugs).Return([]*models.NewsBase{secondNews, firstNews}, nil)
+		mockRedisRepo.EXPECT().SetNewsCtx(ctxWithTrace, firstKey, cacheDuration, firstNews).Return(nil)
+		mockRedisRepo.EXPECT().SetNewsIDCtx(ctxWithTrace, slugKeys[0], cacheDuration, firstNews.NewsID.String()).Return(nil)
+		mockRedisRepo.EXPECT().SetNewsCtx(ctxWithTrace, secondKey, cacheDuration, secondNews).Return(nil)
+		mockRedisRepo.EXPECT().SetNewsIDCtx(ctxWithTrace, slugKeys[1], cacheDuration, secondNews.NewsID.String()).Return(nil)
+
+		news, err := newsUC.GetBySlugs(ctx, slugs)
+		require.NoError(t, err)
+		require.Equal(t, []*models.NewsBase{firstNews, secondNews}, news)
+	})
+
+	t.Run("Partly cached with stale slug", func(t *testing.T) {
+		renamed := "renamed"
+		staleNews := &models.NewsBase{NewsID: firstNews.NewsID, Slug: &renamed}
+		mockRedisRepo.EXPECT().GetNewsIDsCtx(ctxWithTrace, slugKeys).
+			Return([]string{firstNews.NewsID.String(), secondNews.NewsID.String()}, nil)
+		mockRedisRepo.EXPECT().GetNewsByKeysCtx(ctxWithTrace, []string{firstKey, secondKey}).
+			Return([]*models.NewsBase{staleNews, secondNews}, nil)
+		mockNewsRepo.EXPECT().GetNewsBySlugs(ctxWithTrace, []string{first}).Return([]*models.NewsBase{}, nil)
+
+		news, err := newsUC.GetBySlugs(ctx, slugs)
+		require.NoError(t, err)
+		require.Equal(t, []*models.NewsBase{secondNews}, news)
+	})
+
+	t.Run("Cached draft is not served", func(t *testing.T) {
+		draftNews := &models.NewsBase{NewsID: firstNews.NewsID, Slug: &first, Status: models.NewsStatusDraft}
+		mockRedisRepo.EXPECT().GetNewsIDsCtx(ctxWithTrace, slugKeys).
+			Return([]string{firstNews.NewsID.String(), secondNews.NewsID.String()}, nil)
+		mockRedisRepo.EXPECT().GetNewsByKeysCtx(ctxWithTrace, []string{firstKey, secondKey}).
+			Return([]*models.NewsBase{draftNews, secondNews}, nil)
+		mockNewsRepo.EXPECT().GetNewsBySlugs(ctxWithTrace, []string{first}).Return([]*models.NewsBase{}, nil)
+
+		news, err := newsUC.GetBySlugs(ctx, slugs)
+		require.NoError(t, err)
+		require.Equal(t, []*models.NewsBase{secondNews}, news)
+	})
+
+	t.Run("Too many slugs", func(t *testing.T) {
+		news, err := newsUC.GetBySlugs(ctx, make([]string, getBySlugsMaxSize+1))
+		require.Error(t, err)
+		require.Nil(t, news)
+	})
+}
+
+func TestNewsUC_GetLatestPerCategory(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	cacheKey := fmt.Sprintf("%s: %s:%s", basePrefix, latestPerCategoryKey, "empty,sport,tech")
+
+	t.Run("Latest per requested category", func(t *testing.T) {
+		latest := map[string]*models.News{
+			"sport": {NewsID: uuid.New(), Title: "Latest sport"},
+			"tech":  {NewsID: uuid.New(), Title: "Latest tech"},
+		}
+		mockRedisRepo.EXPECT().GetLatestPerCategoryCtx(gomock.Any(), cacheKey).Return(nil, redis.Nil)
+		mockNewsRepo.EXPECT().GetLatestPerCategory(gomock.Any(), []string{"empty", "sport", "tech"}).Return(latest, nil)
+		mockRedisRepo.EXPECT().SetLatestPerCategoryCtx(gomock.Any(), cacheKey, latestPerCategoryCacheDuration, latest).Return(nil)
+
+		result, err := newsUC.GetLatestPerCategory(context.Background(), []string{"tech", "sport", "empty", "tech", ""})
+		require.NoError(t, err)
+		require.Equal(t, latest, result)
+		require.NotContains(t, result, "empty")
+	})
+
+	t.Run("Cached", func(t *testing.T) {
+		cached := map[string]*models.News{"tech": {NewsID: uuid.New()}}
+		mockRedisRepo.EXPECT().GetLatestPerCategoryCtx(gomock.Any(), cacheKey).Return(cached, nil)
+
+		result, err := newsUC.GetLatestPerCategory(context.Background(), []string{"sport", "tech", "empty"})
+		require.NoError(t, err)
+		require.Equal(t, cached, result)
+	})
+
+	t.Run("No categories", func(t *testing.T) {
+		_, err := newsUC.GetLatestPerCategory(context.Background(), []string{" "})
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Too many categories", func(t *testing.T) {
+		categories := make([]string, 0, maxCategoriesFilter+1)
+		for i := 0; i <= maxCategoriesFilter; i++ {
+			categories = append(categories, fmt.Sprintf("c%d", i))
+		}
+		_, err := newsUC.GetLatestPerCategory(context.Background(), categories)
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_GetLatestUpdatePerAuthor(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	firstAuthorID := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	secondAuthorID := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	cacheKey := fmt.Sprintf("%s: %s:%s,%s", basePrefix, latestPerAuthorKey, firstAuthorID, secondAuthorID)
+
+	t.Run("Latest per requested author", func(t *testing.T) {
+		latest := map[uuid.UUID]*models.News{firstAuthorID: {NewsID: uuid.New(), AuthorID: firstAuthorID}}
+		mockRedisRepo.EXPECT().GetLatestPerAuthorCtx(gomock.Any(), cacheKey).Return(nil, redis.Nil)
+		mockNewsRepo.EXPECT().GetLatestUpdatePerAuthor(gomock.Any(), []uuid.UUID{firstAuthorID, secondAuthorID}).Return(latest, nil)
+		mockRedisRepo.EXPECT().SetLatestPerAuthorCtx(gomock.Any(), cacheKey, latestPerAuthorCacheDuration, latest).Return(nil)
+
+		result, err := newsUC.GetLatestUpdatePerAuthor(context.Background(), []uuid.UUID{secondAuthorID, firstAuthorID, secondAuthorID, uuid.Nil})
+		require.NoError(t, err)
+		require.Equal(t, latest, result)
+		require.NotContains(t, result, secondAuthorID)
+	})
+
+	t.Run("Cached", func(t *testing.T) {
+		cached := map[uuid.UUID]*models.News{secondAuthorID: {NewsID: uuid.New()}}
+		mockRedisRepo.EXPECT().GetLatestPerAuthorCtx(gomock.Any(), cacheKey).Return(cached, nil)
+
+		result, err := newsUC.GetLatestUpdatePerAuthor(context.Background(), []uuid.UUID{firstAuthorID, secondAuthorID})
+		require.NoError(t, err)
+		require.Equal(t, cached, result)
+	})
+
+	t.Run("No authors", func(t *testing.T) {
+		_, err := newsUC.GetLatestUpdatePerAuthor(context.Background(), []uuid.UUID{uuid.Nil})
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Too many authors", func(t *testing.T) {
+		authorIDs := make([]uuid.UUID, 0, maxAuthorsFilter+1)
+		for i := 0; i <= maxAuthorsFilter; i++ {
+			authorIDs = append(authorIDs, uuid.New())
+		}
+		_, err := newsUC.GetLatestUpdatePerAuthor(context.Background(), authorIDs)
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_GetActivityFeed(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	t.Run("Filtered by action", func(t *testing.T) {
+		pq := &utils.PaginationQuery{Size: 10, Page: 1}
+		cacheKey := fmt.Sprintf("%s: %s:%s:%s", basePrefix, activityKey, models.NewsActionUpdate, "page=1&size=10&orderBy=")
+		feed := &models.NewsEventList{TotalCount: 1, Events: []*models.NewsEvent{{EventID: uuid.New(), Action: models.NewsActionUpdate}}}
+		mockRedisRepo.EXPECT().GetActivityFeedCtx(gomock.Any(), cacheKey).Return(nil, redis.Nil)
+		mockNewsRepo.EXPECT().GetActivityFeed(gomock.Any(), models.NewsActionUpdate, pq).Return(feed, nil)
+		mockRedisRepo.EXPECT().SetActivityFeedCtx(gomock.Any(), cacheKey, activityCacheDuration, feed).Return(nil)
+
+		result, err := newsUC.GetActivityFeed(context.Background(), models.NewsActionUpdate, pq)
+		require.NoError(t, err)
+		require.Equal(t, feed, result)
+	})
+
+	t.Run("Cached", func(t *testing.T) {
+		pq := &utils.PaginationQuery{Size: 10, Page: 1}
+		cacheKey := fmt.Sprintf("%s: %s::%s", basePrefix, activityKey, "page=1&size=10&orderBy=")
+		cached := &models.NewsEventList{TotalCount: 3}
+		mockRedisRepo.EXPECT().GetActivityFeedCtx(gomock.Any(), cacheKey).Return(cached, nil)
+
+		result, err := newsUC.GetActivityFeed(context.Background(), "", pq)
+		require.NoError(t, err)
+		require.Equal(t, cached, result)
+	})
+
+	t.Run("Unknown action", func(t *testing.T) {
+		_, err := newsUC.GetActivityFeed(context.Background(), "publish", &utils.PaginationQuery{})
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_Relations(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	fromUID := uuid.New()
+	toUID := uuid.New()
+	relatedKey := fmt.Sprintf("%s: %s:%s", basePrefix, relationsKey, fromUID)
+	moderator := "moderator"
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &models.User{UserID: uuid.New(), Role: &moderator})
+
+	t.Run("Add", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), fromUID).Return(&models.NewsBase{NewsID: fromUID, Status: models.NewsStatusPublished}, nil)
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), toUID).Return(&models.NewsBase{NewsID: toUID, Status: models.NewsStatusPublished}, nil)
+		mockNewsRepo.EXPECT().AddRelation(gomock.Any(), fromUID, toUID).Return(true, nil)
+		mockRedisRepo.EXPECT().DeleteNewsCtx(gomock.Any(), relatedKey).Return(nil)
+
+		require.NoError(t, newsUC.AddRelation(ctx, fromUID, toUID))
+	})
+
+	t.Run("Add duplicate", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), fromUID).Return(&models.NewsBase{NewsID: fromUID, Status: models.NewsStatusPublished}, nil)
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), toUID).Return(&models.NewsBase{NewsID: toUID, Status: models.NewsStatusPublished}, nil)
+		mockNewsRepo.EXPECT().AddRelation(gomock.Any(), fromUID, toUID).Return(false, nil)
+
+		err := newsUC.AddRelation(ctx, fromUID, toUID)
+		require.True(t, errors.Is(err, httpErrors.DuplicateRelation))
+		require.Equal(t, http.StatusConflict, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Self link rejected", func(t *testing.T) {
+		err := newsUC.AddRelation(ctx, fromUID, fromUID)
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Nil related id rejected", func(t *testing.T) {
+		err := newsUC.AddRelation(ctx, fromUID, uuid.Nil)
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+		require.Contains(t, err.Error(), "related_id (nil uuid)")
+	})
+
+	t.Run("Malformed related id rejected", func(t *testing.T) {
+		malformed := uuid.MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff")
+
+		err := newsUC.AddRelation(ctx, fromUID, malformed)
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+		require.Contains(t, err.Error(), "related_id (malformed uuid)")
+	})
+
+	t.Run("Unknown related news", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), fromUID).Return(&models.NewsBase{NewsID: fromUID, Status: models.NewsStatusPublished}, nil)
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), toUID).Return(nil, sql.ErrNoRows)
+
+		err := newsUC.AddRelation(ctx, fromUID, toUID)
+		require.Equal(t, http.StatusNotFound, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Draft of other author can not be related", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), fromUID).Return(&models.NewsBase{NewsID: fromUID, Status: models.NewsStatusPublished}, nil)
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), toUID).Return(&models.NewsBase{NewsID: toUID, AuthorID: uuid.New(), Status: models.NewsStatusDraft}, nil)
+
+		err := newsUC.AddRelation(ctx, fromUID, toUID)
+		require.Equal(t, http.StatusNotFound, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("News of other tenant can not be related", func(t *testing.T) {
+		tenantID := uuid.New()
+		otherTenantID := uuid.New()
+		tenantCtx := context.WithValue(ctx, utils.TenantCtxKey{}, tenantID)
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), fromUID).
+			Return(&models.NewsBase{NewsID: fromUID, TenantID: &tenantID, Status: models.NewsStatusPublished}, nil)
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), toUID).
+			Return(&models.NewsBase{NewsID: toUID, TenantID: &otherTenantID, Status: models.NewsStatusPublished}, nil)
+
+		err := newsUC.AddRelation(tenantCtx, fromUID, toUID)
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("News of other tenant can not get links", func(t *testing.T) {
+		otherTenantID := uuid.New()
+		tenantCtx := context.WithValue(ctx, utils.TenantCtxKey{}, uuid.New())
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), fromUID).
+			Return(&models.NewsBase{NewsID: fromUID, TenantID: &otherTenantID, Status: models.NewsStatusPublished}, nil)
+
+		err := newsUC.AddRelation(tenantCtx, fromUID, toUID)
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Links of news of other tenant can not be changed", func(t *testing.T) {
+		otherTenantID := uuid.New()
+		tenantCtx := context.WithValue(ctx, utils.TenantCtxKey{}, uuid.New())
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), fromUID).
+			Return(&models.NewsBase{NewsID: fromUID, TenantID: &otherTenantID, Status: models.NewsStatusPublished}, nil)
+
+		err := newsUC.RemoveRelation(tenantCtx, fromUID, toUID)
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Links of other author news are curated by editors only", func(t *testing.T) {
+		writer := "user"
+		writerCtx := context.WithValue(context.Background(), utils.UserCtxKey{}, &models.User{UserID: uuid.New(), Role: &writer})
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), fromUID).
+			Return(&models.NewsBase{NewsID: fromUID, AuthorID: uuid.New(), Status: models.NewsStatusPublished}, nil)
+
+		err := newsUC.AddRelation(writerCtx, fromUID, toUID)
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), fromUID).Return(&models.NewsBase{NewsID: fromUID, Status: models.NewsStatusPublished}, nil)
+		mockNewsRepo.EXPECT().RemoveRelation(gomock.Any(), fromUID, toUID).Return(nil)
+		mockRedisRepo.EXPECT().DeleteNewsCtx(gomock.Any(), relatedKey).Return(nil)
+
+		require.NoError(t, newsUC.RemoveRelation(ctx, fromUID, toUID))
+	})
+
+	t.Run("Get related through cache", func(t *testing.T) {
+		related := []*models.News{{NewsID: toUID}}
+		mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), fmt.Sprintf("%s: %s", basePrefix, fromUID)).
+			Return(&models.NewsBase{NewsID: fromUID, Status: models.NewsStatusPublished}, nil)
+		mockRedisRepo.EXPECT().GetRelatedCtx(gomock.Any(), relatedKey).Return(nil, redis.Nil)
+		mockNewsRepo.EXPECT().GetCuratedRelated(gomock.Any(), fromUID).Return(related, nil)
+		mockRedisRepo.EXPECT().SetRelatedCtx(gomock.Any(), relatedKey, relationsCacheDuration, related).Return(nil)
+
+		result, err := newsUC.GetCuratedRelated(context.Background(), fromUID)
+		require.NoError(t, err)
+		require.Equal(t, related, result)
+	})
+}
+
+func TestNewsUC_AddTagToMany(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, nil, nil, apiLogger)
+
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	t.Run("Some news already have tag", func(t *testing.T) {
+		mockNewsRepo.EXPECT().AddTagToMany(gomock.Any(), ids, "golang").Return(1, nil)
+
+		result, err := newsUC.AddTagToMany(context.Background(), ids, "  GoLang ")
+		require.NoError(t, err)
+		require.Equal(t, &models.NewsTagBatchResult{Tag: "golang", Affected: 1}, result)
+	})
+
+	t.Run("Empty tag", func(t *testing.T) {
+		_, err := newsUC.AddTagToMany(context.Background(), ids, "   ")
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Empty ids", func(t *testing.T) {
+		_, err := newsUC.AddTagToMany(context.Background(), nil, "golang")
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("News of other tenant can not be tagged", func(t *testing.T) {
+		tenantID := uuid.New()
+		tenantCtx := context.WithValue(context.Background(), utils.TenantCtxKey{}, tenantID)
+		mockNewsRepo.EXPECT().GetOutsideTenant(gomock.Any(), ids, tenantID).Return(ids[2:], nil)
+
+		result, err := newsUC.AddTagToMany(tenantCtx, ids, "golang")
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+		require.Nil(t, result)
+	})
+}
+
+func TestNewsUC_SetTags(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{News: config.NewsConfig{MaxTags: 3}, Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, nil, nil, apiLogger)
+
+	user := &models.User{UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, user)
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.SetTags")
+	defer span.Finish()
+
+	newsUID := uuid.New()
+
+	t.Run("At limit", func(t *testing.T) {
+		tags := []string{"go", "rest", "api"}
+		mockNewsRepo.EXPECT().GetNewsByID(ctxWithTrace, newsUID).Return(&models.NewsBase{NewsID: newsUID, AuthorID: user.UserID}, nil)
+		mockNewsRepo.EXPECT().SetTags(ctxWithTrace, newsUID, tags).Return(nil)
+
+		newsTags, err := newsUC.SetTags(ctx, newsUID, tags)
+		require.NoError(t, err)
+		require.Equal(t, tags, newsTags.Tags)
+	})
+
+	t.Run("Beyond limit", func(t *testing.T) {
+		newsTags, err := newsUC.SetTags(ctx, newsUID, []string{"go", "rest", "api", "clean"})
+		require.Nil(t, newsTags)
+		require.True(t, errors.Is(err, httpErrors.ErrTooManyTags))
+		require.Equal(t, http.StatusUnprocessableEntity, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Duplicates do not count", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNewsByID(ctxWithTrace, newsUID).Return(&models.NewsBase{NewsID: newsUID, AuthorID: user.UserID}, nil)
+		mockNewsRepo.EXPECT().SetTags(ctxWithTrace, newsUID, []string{"go", "rest", "api"}).Return(nil)
+
+		newsTags, err := newsUC.SetTags(ctx, newsUID, []string{"Go", "go ", "REST", "rest", "api", "API"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"go", "rest", "api"}, newsTags.Tags)
+	})
+
+	t.Run("Default limit", func(t *testing.T) {
+		uc := NewNewsUseCase(&config.Config{}, mockNewsRepo, nil, nil, apiLogger)
+		tags := make([]string, 0, defaultMaxTags+1)
+		for i := 0; i <= defaultMaxTags; i++ {
+			tags = append(tags, fmt.Sprintf("tag %d", i))
+		}
+
+		_, err := uc.SetTags(ctx, newsUID, tags)
+		require.True(t, errors.Is(err, httpErrors.ErrTooManyTags))
+	})
+
+	t.Run("Not owner", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNewsByID(ctxWithTrace, newsUID).Return(&models.NewsBase{NewsID: newsUID, AuthorID: uuid.New()}, nil)
+
+		_, err := newsUC.SetTags(ctx, newsUID, []string{"go"})
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_SetMetadata(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{News: config.NewsConfig{MaxMetadataSize: 64}, Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	user := &models.User{UserID: uuid.New()}
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, user)
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.SetMetadata")
+	defer span.Finish()
+
+	newsUID := uuid.New()
+	cacheKey := fmt.Sprintf("%s: %s", basePrefix, newsUID)
+
+	t.Run("Store", func(t *testing.T) {
+		metadata := models.Metadata(`{"source": "import"}`)
+		mockNewsRepo.EXPECT().GetNewsByID(ctxWithTrace, newsUID).Return(&models.NewsBase{NewsID: newsUID, AuthorID: user.UserID}, nil)
+		mockNewsRepo.EXPECT().SetMetadata(ctxWithTrace, newsUID, metadata).Return(nil)
+		mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, cacheKey).Return(nil)
+
+		newsMetadata, err := newsUC.SetMetadata(ctx, newsUID, metadata)
+		require.NoError(t, err)
+		require.Equal(t, metadata, newsMetadata.Metadata)
+	})
+
+	t.Run("Not an object", func(t *testing.T) {
+		_, err := newsUC.SetMetadata(ctx, newsUID, models.Metadata(`["source"]`))
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Too large", func(t *testing.T) {
+		_, err := newsUC.SetMetadata(ctx, newsUID, models.Metadata(fmt.Sprintf(`{"note": "%s"}`, strings.Repeat("x", 64))))
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Not owner", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNewsByID(ctxWithTrace, newsUID).Return(&models.NewsBase{NewsID: newsUID, AuthorID: uuid.New()}, nil)
+
+		_, err := newsUC.SetMetadata(ctx, newsUID, models.Metadata(`{}`))
+		require.Equal(t, http.StatusForbidden, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_GetNews_MetadataFilter(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, nil, nil, apiLogger)
+
+	ctx := context.Background()
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.GetNews")
+	defer span.Finish()
+
+	t.Run("Containment filter", func(t *testing.T) {
+		filter := `{"source": "import"}`
+		lq := &utils.ListQuery{
+			Conditions: []utils.ListCondition{{Column: "metadata", Operator: "@>", Value: filter}},
+			OrderBy:    "created_at",
+			Direction:  "ASC",
+			TieBreaker: "news_id",
+		}
+		mockNewsRepo.EXPECT().GetNews(ctxWithTrace, lq, gomock.Any()).Return(&models.NewsList{}, nil)
+
+		_, err := newsUC.GetNews(ctx, &utils.PaginationQuery{Page: 1}, url.Values{"metadata_filter": {filter}})
+		require.NoError(t, err)
+	})
+
+	t.Run("Invalid filter", func(t *testing.T) {
+		_, err := newsUC.GetNews(ctx, &utils.PaginationQuery{Page: 1}, url.Values{"metadata_filter": {"source=import"}})
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_GetNews_WordsFilter(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, nil, nil, apiLogger)
+
+	ctx := context.Background()
+
+	t.Run("Inclusive range", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNews(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, lq *utils.ListQuery, _ *utils.PaginationQuery) (*models.NewsList, error) {
+				require.Equal(t, []utils.ListCondition{
+					{Column: "word_count", Operator: "<=", Value: 500},
+					{Column: "word_count", Operator: ">=", Value: 100},
+				}, lq.Conditions)
+				return &models.NewsList{}, nil
+			})
+
+		_, err := newsUC.GetNews(ctx, &utils.PaginationQuery{Page: 1}, url.Values{"min_words": {"100"}, "max_words": {"500"}})
+		require.NoError(t, err)
+	})
+
+	t.Run("Equal bounds", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNews(gomock.Any(), gomock.Any(), gomock.Any()).Return(&models.NewsList{}, nil)
+
+		_, err := newsUC.GetNews(ctx, &utils.PaginationQuery{Page: 1}, url.Values{"min_words": {"0"}, "max_words": {"0"}})
+		require.NoError(t, err)
+	})
+
+	t.Run("Only lower bound", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNews(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, lq *utils.ListQuery, _ *utils.PaginationQuery) (*models.NewsList, error) {
+				require.Equal(t, []utils.ListCondition{{Column: "word_count", Operator: ">=", Value: 2000}}, lq.Conditions)
+				return &models.NewsList{}, nil
+			})
+
+		_, err := newsUC.GetNews(ctx, &utils.PaginationQuery{Page: 1}, url.Values{"min_words": {"2000"}})
+		require.NoError(t, err)
+	})
+
+	t.Run("Invalid bounds", func(t *testing.T) {
+		for _, params := range []url.Values{
+			{"min_words": {"501"}, "max_words": {"500"}},
+			{"min_words": {"-1"}},
+			{"max_words": {"many"}},
+		} {
+			_, err := newsUC.GetNews(ctx, &utils.PaginationQuery{Page: 1}, params)
+			require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+		}
+	})
+}
+
+func TestNewsUC_PurgeDeleted(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	ctx := context.Background()
+	span, ctxWithTrace := opentracing.StartSpanFromContext(ctx, "newsUC.PurgeDeleted")
+	defer span.Finish()
+
+	t.Run("Only old deletions purged", func(t *testing.T) {
+		now := time.Now()
+		oldUID, olderUID, recentUID := uuid.New(), uuid.New(), uuid.New()
+		deleted := map[uuid.UUID]time.Time{
+			oldUID:    now.Add(-31 * 24 * time.Hour),
+			olderUID:  now.Add(-90 * 24 * time.Hour),
+			recentUID: now.Add(-24 * time.Hour),
+		}
+
+		mockNewsRepo.EXPECT().PurgeDeleted(ctxWithTrace, gomock.Any(), purgeBatchSize).DoAndReturn(
+			func(_ context.Context, before time.Time, _ int) ([]uuid.UUID, error) {
+				purged := make([]uuid.UUID, 0)
+				for id, deletedAt := range deleted {
+					if deletedAt.Before(before) {
+						purged = append(purged, id)
+					}
+				}
+				return purged, nil
+			})
+		mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, fmt.Sprintf("%s: %s", basePrefix, oldUID)).Return(nil)
+		mockRedisRepo.EXPECT().DeleteNewsCtx(ctxWithTrace, fmt.Sprintf("%s: %s", basePrefix, olderUID)).Return(nil)
+
+		purged, err := newsUC.PurgeDeleted(ctx, 30*24*time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, 2, purged)
+	})
+
+	t.Run("Non positive age", func(t *testing.T) {
+		purged, err := newsUC.PurgeDeleted(ctx, 0)
+		require.Zero(t, purged)
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_GetTrending(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+
+	t.Run("Default limit and gravity", func(t *testing.T) {
+		newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+		cacheKey := fmt.Sprintf("%s: %s:%d", basePrefix, trendingKey, trendingDefaultLimit)
+		trending := []*models.News{{NewsID: uuid.New(), Views: 10}}
+
+		mockRedisRepo.EXPECT().GetTrendingCtx(gomock.Any(), cacheKey).Return(nil, redis.Nil)
+		mockNewsRepo.EXPECT().GetTrending(gomock.Any(), defaultTrendingGravity, trendingDefaultLimit).Return(trending, nil)
+		mockRedisRepo.EXPECT().SetTrendingCtx(gomock.Any(), cacheKey, trendingCacheDuration, trending).Return(nil)
+
+		result, err := newsUC.GetTrending(context.Background(), 0)
+		require.NoError(t, err)
+		require.Equal(t, trending, result)
+	})
+
+	t.Run("Configured gravity and capped limit", func(t *testing.T) {
+		newsUC := NewNewsUseCase(&config.Config{News: config.NewsConfig{TrendingGravity: 1.2}}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+		cacheKey := fmt.Sprintf("%s: %s:%d", basePrefix, trendingKey, trendingMaxLimit)
+
+		mockRedisRepo.EXPECT().GetTrendingCtx(gomock.Any(), cacheKey).Return(nil, redis.Nil)
+		mockNewsRepo.EXPECT().GetTrending(gomock.Any(), 1.2, trendingMaxLimit).Return([]*models.News{}, nil)
+		mockRedisRepo.EXPECT().SetTrendingCtx(gomock.Any(), cacheKey, trendingCacheDuration, []*models.News{}).Return(nil)
+
+		result, err := newsUC.GetTrending(context.Background(), trendingMaxLimit+100)
+		require.NoError(t, err)
+		require.Empty(t, result)
+	})
+
+	t.Run("Cached", func(t *testing.T) {
+		newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+		cached := []*models.News{{NewsID: uuid.New()}}
+		mockRedisRepo.EXPECT().GetTrendingCtx(gomock.Any(), fmt.Sprintf("%s: %s:%d", basePrefix, trendingKey, 5)).Return(cached, nil)
+
+		result, err := newsUC.GetTrending(context.Background(), 5)
+		require.NoError(t, err)
+		require.Equal(t, cached, result)
+	})
+
+	t.Run("Negative limit", func(t *testing.T) {
+		newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+		_, err := newsUC.GetTrending(context.Background(), -1)
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_Summarize(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	mockAuthorRepo := mock.NewMockAuthorRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, mockAuthorRepo, apiLogger)
+
+	t.Run("Excerpt and author", func(t *testing.T) {
+		authorID := uuid.New()
+		goneAuthorID := uuid.New()
+		category := "tech"
+		createdAt := time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)
+		longContent := "<p>" + strings.Repeat("word ", 100) + "</p>"
+		list := &models.NewsList{TotalCount: 3, TotalPages: 1, Page: 1, Size: 10, News: []*models.News{
+			{NewsID: uuid.New(), AuthorID: authorID, Title: "First", Content: "<p>Short <b>html</b> body</p>", Category: &category, CreatedAt: createdAt},
+			{NewsID: uuid.New(), AuthorID: authorID, Title: "Second", Content: longContent},
+			{NewsID: uuid.New(), AuthorID: goneAuthorID, Title: "Third", Content: "Plain"},
+		}}
+		mockAuthorRepo.EXPECT().GetByIDs(gomock.Any(), []uuid.UUID{authorID, goneAuthorID}).
+			Return([]*models.User{{UserID: authorID, FirstName: "Jane", LastName: "Doe"}}, nil).
+			Times(1)
+
+		summaries, err := newsUC.Summarize(context.Background(), list)
+		require.NoError(t, err)
+		require.Equal(t, 3, summaries.TotalCount)
+		require.Len(t, summaries.News, 3)
+
+		require.Equal(t, list.News[0].NewsID, summaries.News[0].NewsID)
+		require.Equal(t, "Short html body", summaries.News[0].Excerpt)
+		require.Equal(t, "Jane Doe", summaries.News[0].Author)
+		require.Equal(t, &category, summaries.News[0].Category)
+		require.Equal(t, createdAt, summaries.News[0].CreatedAt)
+
+		require.Equal(t, "Jane Doe", summaries.News[1].Author)
+		require.True(t, strings.HasSuffix(summaries.News[1].Excerpt, "…"))
+		require.LessOrEqual(t, utf8.RuneCountInString(summaries.News[1].Excerpt), defaultExcerptLength+1)
+		require.Empty(t, summaries.News[2].Author)
+
+		body, err := json.Marshal(summaries)
+		require.NoError(t, err)
+		require.NotContains(t, string(body), `"content"`)
+	})
+
+	t.Run("List dominated by one author", func(t *testing.T) {
+		authorID := uuid.New()
+		otherAuthorID := uuid.New()
+		list := &models.NewsList{Page: 1, Size: 20}
+		for i := 0; i < 19; i++ {
+			list.News = append(list.News, &models.News{NewsID: uuid.New(), AuthorID: authorID, Content: "Content"})
+		}
+		list.News = append(list.News, &models.News{NewsID: uuid.New(), AuthorID: otherAuthorID, Content: "Content"})
+		list.NextPreview = []*models.News{{NewsID: uuid.New(), AuthorID: authorID, Content: "Content"}}
+		mockAuthorRepo.EXPECT().GetByIDs(gomock.Any(), []uuid.UUID{authorID, otherAuthorID}).
+			Return([]*models.User{{UserID: authorID, FirstName: "Jane", LastName: "Doe"}, {UserID: otherAuthorID, FirstName: "John"}}, nil).
+			Times(1)
+
+		summaries, err := newsUC.Summarize(context.Background(), list)
+		require.NoError(t, err)
+		for _, summary := range summaries.News[:19] {
+			require.Equal(t, "Jane Doe", summary.Author)
+		}
+		require.Equal(t, "John", summaries.News[19].Author)
+		require.Equal(t, "Jane Doe", summaries.NextPreview[0].Author)
+	})
+
+	t.Run("Empty page", func(t *testing.T) {
+		summaries, err := newsUC.Summarize(context.Background(), &models.NewsList{Page: 2, Size: 10})
+		require.NoError(t, err)
+		require.Equal(t, 2, summaries.Page)
+		require.Empty(t, summaries.News)
+	})
+}
+
+func TestNewsUC_KeyPrefix(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	cfg := &config.Config{Redis: config.RedisConfig{KeyPrefix: "staging:"}}
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	newsBase := &models.NewsBase{NewsID: uuid.New(), Status: models.NewsStatusPublished}
+	mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), "staging:api-news:: "+newsBase.NewsID.String()).Return(newsBase, nil)
+	mockRedisRepo.EXPECT().IncrViewsCtx(gomock.Any(), "staging:api-news:: views", newsBase.NewsID.String(), int64(1)).Return(nil)
+
+	newsByID, err := newsUC.GetNewsByID(context.Background(), newsBase.NewsID)
+	require.NoError(t, err)
+	require.Equal(t, newsBase.NewsID, newsByID.NewsID)
+}
+
+func TestNewsUC_InvalidEncoding(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userUID := uuid.New()
+	ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &models.User{UserID: userUID})
+
+	t.Run("Rejected by default", func(t *testing.T) {
+		mockNewsRepo := mock.NewMockRepository(ctrl)
+		newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mock.NewMockRedisRepository(ctrl), nil, logger.NewApiLogger(nil))
+
+		for _, news := range []*models.News{
+			{Title: "Broken \xff title of news long enough", Content: "Content long text string greater then 20 characters"},
+			{Title: "Title long text string greater then 20 characters", Content: "Truncated rune \xe2\x82 in content text"},
+		} {
+			created, err := newsUC.Create(ctx, news)
+			require.Nil(t, created)
+			require.True(t, errors.Is(err, httpErrors.ErrInvalidEncoding))
+			require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+		}
+	})
+
+	t.Run("Rejected on update", func(t *testing.T) {
+		mockNewsRepo := mock.NewMockRepository(ctrl)
+		newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mock.NewMockRedisRepository(ctrl), nil, logger.NewApiLogger(nil))
+
+		newsUID := uuid.New()
+		mockNewsRepo.EXPECT().GetNewsByID(gomock.Any(), newsUID).Return(&models.NewsBase{NewsID: newsUID, AuthorID: userUID}, nil)
+
+		updated, err := newsUC.Update(ctx, &models.News{NewsID: newsUID, Title: "Overlong \xc0\xaf encoding in title"})
+		require.Nil(t, updated)
+		require.True(t, errors.Is(err, httpErrors.ErrInvalidEncoding))
+	})
+
+	t.Run("Sanitized when configured", func(t *testing.T) {
+		mockNewsRepo := mock.NewMockRepository(ctrl)
+		mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+		cfg := &config.Config{News: config.NewsConfig{SanitizeInvalidUTF8: true}}
+		newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, logger.NewApiLogger(nil))
+
+		news := &models.News{
+			Title:   "Broken \xff title of news long enough",
+			Content: "Truncated rune \xe2\x82 in content text",
+		}
+		mockNewsRepo.EXPECT().GetNewsByContentHash(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, sql.ErrNoRows)
+		mockNewsRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, n *models.News) (*models.News, error) {
+			return n, nil
+		})
+		mockRedisRepo.EXPECT().DeleteNewsCtx(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+		created, err := newsUC.Create(ctx, news)
+		require.NoError(t, err)
+		require.True(t, utf8.ValidString(created.Title))
+		require.True(t, utf8.ValidString(created.Content))
+		require.Equal(t, "Broken � title of news long enough", created.Title)
+		require.Equal(t, "Truncated rune � in content text", created.Content)
+	})
+}
+
+func TestNewsUC_GetNews_ExactCount(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mock.NewMockRedisRepository(ctrl), mock.NewMockAuthorRepository(ctrl), logger.NewApiLogger(nil))
+
+	for _, tc := range []struct {
+		name     string
+		params   url.Values
+		estimate bool
+	}{
+		{name: "Exact by default", params: url.Values{}, estimate: false},
+		{name: "Estimated when not exact", params: url.Values{exactCountParam: {"false"}}, estimate: true},
+		{name: "Filtered list is counted", params: url.Values{exactCountParam: {"false"}, "category": {"golang"}}, estimate: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mockNewsRepo.EXPECT().GetNews(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, lq *utils.ListQuery, _ *utils.PaginationQuery) (*models.NewsList, error) {
+					require.Equal(t, tc.estimate, lq.EstimateCount)
+					return &models.NewsList{}, nil
+				})
+
+			_, err := newsUC.GetNews(context.Background(), &utils.PaginationQuery{Page: 1}, tc.params)
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("Invalid exact count value", func(t *testing.T) {
+		_, err := newsUC.GetNews(context.Background(), &utils.PaginationQuery{Page: 1}, url.Values{exactCountParam: {"maybe"}})
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_GetNews_PrefetchNext(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockAuthorRepo := mock.NewMockAuthorRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mock.NewMockRedisRepository(ctrl), mockAuthorRepo, logger.NewApiLogger(nil))
+
+	t.Run("Preview of next page requested", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNews(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, lq *utils.ListQuery, _ *utils.PaginationQuery) (*models.NewsList, error) {
+				require.Equal(t, nextPreviewSize, lq.Prefetch)
+				return &models.NewsList{}, nil
+			})
+
+		_, err := newsUC.GetNews(context.Background(), &utils.PaginationQuery{Page: 1}, url.Values{prefetchNextParam: {"true"}})
+		require.NoError(t, err)
+	})
+
+	t.Run("No prefetch by default", func(t *testing.T) {
+		mockNewsRepo.EXPECT().GetNews(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, lq *utils.ListQuery, _ *utils.PaginationQuery) (*models.NewsList, error) {
+				require.Zero(t, lq.Prefetch)
+				return &models.NewsList{}, nil
+			})
+
+		_, err := newsUC.GetNews(context.Background(), &utils.PaginationQuery{Page: 1}, url.Values{prefetchNextParam: {"false"}})
+		require.NoError(t, err)
+	})
+
+	t.Run("Invalid prefetch value", func(t *testing.T) {
+		_, err := newsUC.GetNews(context.Background(), &utils.PaginationQuery{Page: 1}, url.Values{prefetchNextParam: {"soon"}})
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+
+	t.Run("Preview summarized with page", func(t *testing.T) {
+		authorID := uuid.New()
+		list := &models.NewsList{
+			News:        []*models.News{{NewsID: uuid.New(), AuthorID: authorID, Content: "page"}},
+			NextPreview: []*models.News{{NewsID: uuid.New(), AuthorID: authorID, Content: "next"}},
+		}
+		mockAuthorRepo.EXPECT().GetByIDs(gomock.Any(), []uuid.UUID{authorID}).
+			Return([]*models.User{{UserID: authorID, FirstName: "Ada", LastName: "Lovelace"}}, nil).Times(1)
+
+		summaries, err := newsUC.Summarize(context.Background(), list)
+		require.NoError(t, err)
+		require.Len(t, summaries.NextPreview, 1)
+		require.Equal(t, list.NextPreview[0].NewsID, summaries.NextPreview[0].NewsID)
+		require.Equal(t, "Ada Lovelace", summaries.NextPreview[0].Author)
+		require.Equal(t, "next", summaries.NextPreview[0].Excerpt)
+	})
+}
+
+func TestBuildListCacheKey(t *testing.T) {
+	t.Parallel()
+
+	pq := &utils.PaginationQuery{Page: 2, Size: 10, OrderBy: "created_at", Direction: "desc"}
+	tenantID := uuid.New()
+
+	first, err := url.ParseQuery("category=go&categories=a&categories=b&min_words=100&tz=Europe/Berlin")
+	require.NoError(t, err)
+	second, err := url.ParseQuery("categories=b&min_words=100&category=go&categories=a&strict=true")
+	require.NoError(t, err)
+	require.Equal(t, buildListCacheKey(pq, first, nil), buildListCacheKey(pq, second, nil))
+
+	keys := map[string]string{
+		"base":       buildListCacheKey(pq, first, nil),
+		"other page": buildListCacheKey(&utils.PaginationQuery{Page: 3, Size: 10, OrderBy: "created_at", Direction: "desc"}, first, nil),
+		"other size": buildListCacheKey(&utils.PaginationQuery{Page: 2, Size: 20, OrderBy: "created_at", Direction: "desc"}, first, nil),
+		"other sort": buildListCacheKey(&utils.PaginationQuery{Page: 2, Size: 10, OrderBy: "title", Direction: "desc"}, first, nil),
+		"other dir":  buildListCacheKey(&utils.PaginationQuery{Page: 2, Size: 10, OrderBy: "created_at", Direction: "asc"}, first, nil),
+		"no filters": buildListCacheKey(pq, url.Values{}, nil),
+		"value":      buildListCacheKey(pq, url.Values{"category": {"rust"}, "categories": {"a", "b"}, "min_words": {"100"}}, nil),
+		"split":      buildListCacheKey(pq, url.Values{"category": {"go"}, "categories": {"a,b"}, "min_words": {"100"}}, nil),
+		"prefetch":   buildListCacheKey(pq, url.Values{"category": {"go"}, "categories": {"a", "b"}, "min_words": {"100"}, prefetchNextParam: {"true"}}, nil),
+		"tenant":     buildListCacheKey(pq, first, &tenantID),
+	}
+	seen := make(map[string]string, len(keys))
+	for name, key := range keys {
+		require.True(t, strings.HasPrefix(key, newsListKey+":"))
+		other, ok := seen[key]
+		require.False(t, ok, "%s collides with %s", name, other)
+		seen[key] = name
+	}
+}
+
+func TestNewsUC_GetNews_ListCache(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{News: config.NewsConfig{ListCacheTTL: time.Minute}}
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(cfg, mockNewsRepo, mockRedisRepo, nil, logger.NewApiLogger(nil))
+
+	params := url.Values{"category": {"go"}}
+	pq := &utils.PaginationQuery{Page: 1, Size: 10, Direction: "asc"}
+	newsList := &models.NewsList{TotalCount: 1, Page: 1, Size: 10, News: []*models.News{{NewsID: uuid.New()}}}
+
+	t.Run("Miss loads and caches page", func(t *testing.T) {
+		cacheKey := fmt.Sprintf("%s: %s", basePrefix, buildListCacheKey(pq, params, nil))
+		mockRedisRepo.EXPECT().GetNewsListCtx(gomock.Any(), cacheKey).Return(nil, redis.Nil)
+		mockNewsRepo.EXPECT().GetNews(gomock.Any(), gomock.Any(), pq).Return(newsList, nil)
+		mockRedisRepo.EXPECT().SetNewsListCtx(gomock.Any(), cacheKey, 60, newsList).Return(nil)
+
+		result, err := newsUC.GetNews(context.Background(), pq, params)
+		require.NoError(t, err)
+		require.Equal(t, newsList, result)
+	})
+
+	t.Run("Hit skips repository", func(t *testing.T) {
+		cacheKey := fmt.Sprintf("%s: %s", basePrefix, buildListCacheKey(pq, params, nil))
+		mockRedisRepo.EXPECT().GetNewsListCtx(gomock.Any(), cacheKey).Return(newsList, nil)
+
+		result, err := newsUC.GetNews(context.Background(), pq, params)
+		require.NoError(t, err)
+		require.Equal(t, newsList, result)
+	})
+}
+
+func TestNewsUC_GetNewsIDs(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mock.NewMockRedisRepository(ctrl), nil, logger.NewApiLogger(nil))
+
+	t.Run("Same list query as full list", func(t *testing.T) {
+		params := url.Values{"category": {"golang"}, "min_words": {"100"}}
+
+		var fullQuery, idsQuery *utils.ListQuery
+		mockNewsRepo.EXPECT().GetNews(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, lq *utils.ListQuery, _ *utils.PaginationQuery) (*models.NewsList, error) {
+				fullQuery = lq
+				return &models.NewsList{}, nil
+			})
+		mockNewsRepo.EXPECT().GetNewsIDs(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, lq *utils.ListQuery, _ *utils.PaginationQuery) (*models.NewsIDList, error) {
+				idsQuery = lq
+				return &models.NewsIDList{}, nil
+			})
+
+		_, err := newsUC.GetNews(context.Background(), &utils.PaginationQuery{Page: 1}, params)
+		require.NoError(t, err)
+		_, err = newsUC.GetNewsIDs(context.Background(), &utils.PaginationQuery{Page: 1}, params)
+		require.NoError(t, err)
+		require.Equal(t, fullQuery, idsQuery)
+	})
+
+	t.Run("Unknown filter rejected", func(t *testing.T) {
+		_, err := newsUC.GetNewsIDs(context.Background(), &utils.PaginationQuery{Page: 1}, url.Values{"colour": {"red"}})
+		require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+	})
+}
+
+func TestNewsUC_GetNewsByID_CountsOnlyPublishedViews(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+	viewsCacheKey := fmt.Sprintf("%s: %s", basePrefix, viewsKey)
+
+	t.Run("Published", func(t *testing.T) {
+		newsBase := &models.NewsBase{NewsID: uuid.New(), Status: models.NewsStatusPublished}
+		mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), fmt.Sprintf("%s: %s", basePrefix, newsBase.NewsID)).Return(newsBase, nil)
+		mockRedisRepo.EXPECT().IncrViewsCtx(gomock.Any(), viewsCacheKey, newsBase.NewsID.String(), int64(1)).Return(nil)
+
+		_, err := newsUC.GetNewsByID(context.Background(), newsBase.NewsID)
+		require.NoError(t, err)
+	})
+
+	t.Run("Draft viewed by author", func(t *testing.T) {
+		author := &models.User{UserID: uuid.New()}
+		newsBase := &models.NewsBase{NewsID: uuid.New(), AuthorID: author.UserID, Status: models.NewsStatusDraft}
+		mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), fmt.Sprintf("%s: %s", basePrefix, newsBase.NewsID)).Return(newsBase, nil)
+		mockRedisRepo.EXPECT().IncrViewsCtx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, author)
+		_, err := newsUC.GetNewsByID(ctx, newsBase.NewsID)
+		require.NoError(t, err)
+	})
+}
+
+func TestNewsUC_FlushViews(t *testing.T) {
+	t.Parallel()
+
+	apiLogger := logger.NewApiLogger(nil)
+	viewsCacheKey := fmt.Sprintf("%s: %s", basePrefix, viewsKey)
+
+	counted := make(map[string]int64, viewsFlushBatchSize+1)
+	for i := 0; i <= viewsFlushBatchSize; i++ {
+		counted[uuid.New().String()] = int64(i + 1)
+	}
+
+	t.Run("Flushed in batches", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockNewsRepo := mock.NewMockRepository(ctrl)
+		mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+		newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+		mockRedisRepo.EXPECT().PopViewsCtx(gomock.Any(), viewsCacheKey).Return(counted, nil)
+		flushed := make(map[string]int64, len(counted))
+		mockNewsRepo.EXPECT().AddViews(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, ids []uuid.UUID, views []int64) error {
+				require.LessOrEqual(t, len(ids), viewsFlushBatchSize)
+				for i, id := range ids {
+					flushed[id.String()] = views[i]
+				}
+				return nil
+			}).Times(2)
+
+		n, err := newsUC.FlushViews(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, len(counted), n)
+		require.Equal(t, counted, flushed)
+	})
+
+	t.Run("Failed batch is restored", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockNewsRepo := mock.NewMockRepository(ctrl)
+		mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+		newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+		mockRedisRepo.EXPECT().PopViewsCtx(gomock.Any(), viewsCacheKey).Return(counted, nil)
+		gomock.InOrder(
+			mockNewsRepo.EXPECT().AddViews(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
+			mockNewsRepo.EXPECT().AddViews(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("db is down")),
+		)
+		restored := make(map[string]int64)
+		mockRedisRepo.EXPECT().IncrViewsCtx(gomock.Any(), viewsCacheKey, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, newsID string, views int64) error {
+				restored[newsID] = views
+				return nil
+			}).Times(1)
+
+		n, err := newsUC.FlushViews(context.Background())
+		require.Error(t, err)
+		require.Equal(t, viewsFlushBatchSize, n)
+		require.Len(t, restored, 1)
+		for newsID, views := range restored {
+			require.Equal(t, counted[newsID], views)
+		}
+	})
+}
+
+func TestNewsUC_NotPublishedIsNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiLogger := logger.NewApiLogger(nil)
+	mockNewsRepo := mock.NewMockRepository(ctrl)
+	mockRedisRepo := mock.NewMockRedisRepository(ctrl)
+	newsUC := NewNewsUseCase(&config.Config{}, mockNewsRepo, mockRedisRepo, nil, apiLogger)
+
+	author := &models.User{UserID: uuid.New()}
+	draft := &models.NewsBase{NewsID: uuid.New(), AuthorID: author.UserID, Status: models.NewsStatusDraft}
+	mockRedisRepo.EXPECT().GetNewsByIDCtx(gomock.Any(), fmt.Sprintf("%s: %s", basePrefix, draft.NewsID)).Return(draft, nil).AnyTimes()
+
+	notFound := func(t *testing.T, err error) {
+		require.Equal(t, http.StatusNotFound, httpErrors.ParseErrors(err).Status())
+	}
+
+	t.Run("Anonymous", func(t *testing.T) {
+		_, err := newsUC.GetNewsByID(context.Background(), draft.NewsID)
+		notFound(t, err)
+		_, err = newsUC.GetTags(context.Background(), draft.NewsID)
+		notFound(t, err)
+		_, err = newsUC.GetMetadata(context.Background(), draft.NewsID)
+		notFound(t, err)
+		_, err = newsUC.GetTranslation(context.Background(), draft.NewsID, "de")
+		notFound(t, err)
+		_, err = newsUC.GetCuratedRelated(context.Background(), draft.NewsID)
+		notFound(t, err)
+	})
+
+	t.Run("Other user", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, &models.User{UserID: uuid.New()})
+
+		_, err := newsUC.GetNewsByID(ctx, draft.NewsID)
+		notFound(t, err)
+	})
+
+	t.Run("Author", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), utils.UserCtxKey{}, author)
+		mockNewsRepo.EXPECT().GetTags(gomock.Any(), draft.NewsID).Return([]string{"golang"}, nil)
+
+		n, err := newsUC.GetNewsByID(ctx, draft.NewsID)
+		require.NoError(t, err)
+		require.Equal(t, draft.NewsID, n.NewsID)
+		tags, err := newsUC.GetTags(ctx, draft.NewsID)
+		require.NoError(t, err)
+		require.Equal(t, []string{"golang"}, tags.Tags)
+	})
 }
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/AleksK1NG/api-mc/internal/news"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+)
+
+const (
+	defaultViewsFlushInterval = 10 * time.Second
+	viewsFinalFlushTimeout    = 5 * time.Second
+)
+
+// Background job moving views counted in Redis to news table
+type ViewsFlushJob struct {
+	newsUC   news.UseCase
+	interval time.Duration
+	logger   logger.Logger
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// Views flush job constructor, zero interval uses default one
+func NewViewsFlushJob(newsUC news.UseCase, interval time.Duration, logger logger.Logger) *ViewsFlushJob {
+	if interval <= 0 {
+		interval = defaultViewsFlushInterval
+	}
+	return &ViewsFlushJob{newsUC: newsUC, interval: interval, logger: logger}
+}
+
+// Flush views every interval until Close
+func (j *ViewsFlushJob) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	j.cancel = cancel
+	j.done = make(chan struct{})
+
+	go func() {
+		defer close(j.done)
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+			j.flush(ctx)
+		}
+	}()
+}
+
+// Stop job and flush views counted since last run
+func (j *ViewsFlushJob) Close() error {
+	if j.cancel == nil {
+		return nil
+	}
+	j.cancel()
+	<-j.done
+
+	ctx, cancel := context.WithTimeout(context.Background(), viewsFinalFlushTimeout)
+	defer cancel()
+	j.flush(ctx)
+	return nil
+}
+
+func (j *ViewsFlushJob) flush(ctx context.Context) {
+	if _, err := j.newsUC.FlushViews(ctx); err != nil {
+		j.logger.Errorf("ViewsFlushJob.FlushViews: %v", err)
+	}
+}
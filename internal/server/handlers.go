@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -24,6 +25,9 @@ import (
 	newsUseCase "github.com/AleksK1NG/api-mc/internal/news/usecase"
 	sessionRepository "github.com/AleksK1NG/api-mc/internal/session/repository"
 	"github.com/AleksK1NG/api-mc/internal/session/usecase"
+	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
+	"github.com/AleksK1NG/api-mc/pkg/localcache"
+	"github.com/AleksK1NG/api-mc/pkg/mailer"
 	"github.com/AleksK1NG/api-mc/pkg/metric"
 	"github.com/AleksK1NG/api-mc/pkg/utils"
 )
@@ -41,18 +45,48 @@ func (s *Server) MapHandlers(e *echo.Echo) error {
 	)
 
 	// Init repositories
-	aRepo := authRepository.NewAuthRepository(s.db)
-	nRepo := newsRepository.NewNewsRepository(s.db)
+	aRepo := authRepository.NewAuthRepository(s.db, s.cfg)
+	nRepo := newsRepository.NewNewsRepository(s.db, s.cfg, s.logger)
 	cRepo := commentsRepository.NewCommentsRepository(s.db)
 	sRepo := sessionRepository.NewSessionRepository(s.redisClient, s.cfg)
 	aAWSRepo := authRepository.NewAuthAWSRepository(s.awsClient)
-	authRedisRepo := authRepository.NewAuthRedisRepo(s.redisClient)
-	newsRedisRepo := newsRepository.NewNewsRedisRepo(s.redisClient)
+	cacheSwitch := utils.NewCacheSwitch(s.cfg.Redis.CacheEnabled)
+	authRedisRepo := authRepository.NewAuthSwitchCacheRepo(authRepository.NewAuthRedisRepo(s.redisClient), cacheSwitch)
+	newsRedisRepo := newsRepository.NewNewsRedisRepo(s.redisClient, s.cfg)
+	if s.cfg.Redis.LocalCacheTTL > 0 {
+		localCache := localcache.NewCache(s.cfg.Redis.LocalCacheTTL, s.cfg.Redis.LocalCacheSize)
+		invalidator := localcache.NewInvalidator(localCache, s.redisClient, s.cfg.Redis.InvalidationChannel, s.logger)
+		pubSub := s.redisClient.Subscribe(context.Background(), invalidator.Channel())
+		go invalidator.Listen(pubSub.Channel())
+		s.closers = append(s.closers, pubSub)
+		newsRedisRepo = newsRepository.NewNewsLocalCacheRepo(newsRedisRepo, localCache, invalidator)
+	}
+	newsRedisRepo = newsRepository.NewNewsSwitchCacheRepo(newsRedisRepo, cacheSwitch)
+	s.closers = append(s.closers, nRepo)
 
 	// Init useCases
 	authUC := authUseCase.NewAuthUseCase(s.cfg, aRepo, authRedisRepo, aAWSRepo, s.logger)
-	newsUC := newsUseCase.NewNewsUseCase(s.cfg, nRepo, newsRedisRepo, s.logger)
+	newsUC := newsUseCase.NewNewsUseCase(s.cfg, nRepo, newsRedisRepo, aRepo, s.logger)
 	commUC := commentsUseCase.NewCommentsUseCase(s.cfg, cRepo, s.logger)
+	if s.cfg.ImageCheck.Enabled {
+		imageCheckJob := newsUseCase.NewImageCheckJob(s.cfg, nRepo, &http.Client{}, s.logger)
+		imageCheckJob.Start()
+		s.closers = append(s.closers, imageCheckJob)
+	}
+	if s.cfg.Mailer.Enabled {
+		sender := mailer.NewSMTPSender(s.cfg.Mailer.Host, s.cfg.Mailer.Port, s.cfg.Mailer.Username, s.cfg.Mailer.Password, s.cfg.Mailer.From)
+		mailQueue := mailer.NewQueue(sender, s.cfg.Mailer.QueueSize, s.cfg.Mailer.Workers, s.cfg.Mailer.MaxRetries, s.cfg.Mailer.RetryBackoff, s.logger)
+		s.closers = append(s.closers, mailQueue)
+		nRepo.Hooks().OnPublished(newsUseCase.NewPublishNotifier(s.cfg, aRepo, mailQueue).OnPublished)
+	}
+	if s.cfg.News.CommentCountInterval > 0 {
+		commentCountJob := newsUseCase.NewCommentCountJob(newsUC, s.cfg.News.CommentCountInterval, s.logger)
+		commentCountJob.Start()
+		s.closers = append(s.closers, commentCountJob)
+	}
+	viewsFlushJob := newsUseCase.NewViewsFlushJob(newsUC, s.cfg.News.ViewsFlushInterval, s.logger)
+	viewsFlushJob.Start()
+	s.closers = append(s.closers, viewsFlushJob)
 	sessUC := usecase.NewSessionUseCase(sRepo, s.cfg)
 
 	// Init handlers
@@ -81,6 +115,7 @@ func (s *Server) MapHandlers(e *echo.Echo) error {
 		DisableStackAll:   true,
 	}))
 	e.Use(middleware.RequestID())
+	e.Use(mw.RequestDeadlineMiddleware)
 	e.Use(mw.MetricsMiddleware(metrics))
 
 	e.Use(middleware.GzipWithConfig(middleware.GzipConfig{
@@ -91,6 +126,7 @@ func (s *Server) MapHandlers(e *echo.Echo) error {
 	}))
 	e.Use(middleware.Secure())
 	e.Use(middleware.BodyLimit("2M"))
+	e.Use(mw.JSONWireMiddleware)
 	if s.cfg.Server.Debug {
 		e.Use(mw.DebugMiddleware)
 	}
@@ -98,6 +134,7 @@ func (s *Server) MapHandlers(e *echo.Echo) error {
 	v1 := e.Group("/api/v1")
 
 	health := v1.Group("/health")
+	admin := v1.Group("/admin")
 	authGroup := v1.Group("/auth")
 	newsGroup := v1.Group("/news")
 	commGroup := v1.Group("/comments")
@@ -111,5 +148,52 @@ func (s *Server) MapHandlers(e *echo.Echo) error {
 		return c.JSON(http.StatusOK, map[string]string{"status": "OK"})
 	})
 
+	admin.GET("/cache", getCacheState(cacheSwitch), mw.AuthSessionMiddleware, mw.RoleBasedAuthMiddleware([]string{"admin"}))
+	admin.PUT("/cache", s.setCacheState(cacheSwitch), mw.AuthSessionMiddleware, mw.CSRF, mw.RoleBasedAuthMiddleware([]string{"admin"}))
+
 	return nil
 }
+
+// Redis cache state
+type cacheState struct {
+	Enabled *bool `json:"enabled" validate:"required"`
+}
+
+// GetCacheState godoc
+// @Summary Get cache state
+// @Description Get is redis caching of news and users enabled, admin only
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} cacheState
+// @Router /admin/cache [get]
+func getCacheState(cacheSwitch *utils.CacheSwitch) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		enabled := cacheSwitch.Enabled()
+		return c.JSON(http.StatusOK, &cacheState{Enabled: &enabled})
+	}
+}
+
+// SetCacheState godoc
+// @Summary Enable or disable cache
+// @Description Turn redis caching of news and users on or off without restart, admin only.
+// @Description While off, requests go to postgres and cache is neither read nor written,
+// @Description so entries cached before turning off may be served until they expire once turned on again
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Success 200 {object} cacheState
+// @Router /admin/cache [put]
+func (s *Server) setCacheState(cacheSwitch *utils.CacheSwitch) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		state := &cacheState{}
+		if err := utils.ReadRequest(c, state); err != nil {
+			utils.LogResponseError(c, s.logger, err)
+			return c.JSON(httpErrors.ErrorResponse(err))
+		}
+
+		if previous := cacheSwitch.Set(*state.Enabled); previous != *state.Enabled {
+			s.logger.Infof("Cache enabled changed to %v RequestID: %s", *state.Enabled, utils.GetRequestID(c))
+		}
+		return c.JSON(http.StatusOK, state)
+	}
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"io"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
@@ -34,6 +35,7 @@ type Server struct {
 	redisClient *redis.Client
 	awsClient   *minio.Client
 	logger      logger.Logger
+	closers     []io.Closer
 }
 
 // NewServer New Server constructor
@@ -76,7 +78,7 @@ func (s *Server) Run() error {
 		defer shutdown()
 
 		s.logger.Info("Server Exited Properly")
-		return s.echo.Server.Shutdown(ctx)
+		return s.shutdown(ctx)
 	}
 
 	server := &http.Server{
@@ -113,5 +115,16 @@ func (s *Server) Run() error {
 	defer shutdown()
 
 	s.logger.Info("Server Exited Properly")
-	return s.echo.Server.Shutdown(ctx)
+	return s.shutdown(ctx)
+}
+
+// Shutdown http server, then release resources held by repositories
+func (s *Server) shutdown(ctx context.Context) error {
+	err := s.echo.Server.Shutdown(ctx)
+	for _, closer := range s.closers {
+		if closeErr := closer.Close(); closeErr != nil {
+			s.logger.Errorf("Server shutdown Close: %v", closeErr)
+		}
+	}
+	return err
 }
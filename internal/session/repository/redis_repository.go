@@ -29,7 +29,7 @@ type sessionRepo struct {
 
 // Session repository constructor
 func NewSessionRepository(redisClient *redis.Client, cfg *config.Config) session.SessRepository {
-	return &sessionRepo{redisClient: redisClient, basePrefix: basePrefix, cfg: cfg}
+	return &sessionRepo{redisClient: redisClient, basePrefix: cfg.RedisKeyPrefix() + basePrefix, cfg: cfg}
 }
 
 // Create session in redis
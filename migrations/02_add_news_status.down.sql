@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS news_status_idx;
+
+ALTER TABLE news DROP COLUMN IF EXISTS status;
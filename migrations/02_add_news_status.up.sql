@@ -0,0 +1,5 @@
+ALTER TABLE news
+    ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'published'
+        CHECK ( status IN ('draft', 'published', 'archived') );
+
+CREATE INDEX IF NOT EXISTS news_status_idx ON news (status);
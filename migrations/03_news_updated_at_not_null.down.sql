@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS news_status_updated_at_idx;
+
+ALTER TABLE news
+    ALTER COLUMN updated_at DROP NOT NULL,
+    ALTER COLUMN updated_at SET DEFAULT CURRENT_TIMESTAMP;
@@ -0,0 +1,7 @@
+UPDATE news SET updated_at = created_at WHERE updated_at IS NULL;
+
+ALTER TABLE news
+    ALTER COLUMN updated_at SET DEFAULT NOW(),
+    ALTER COLUMN updated_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS news_status_updated_at_idx ON news (status, updated_at DESC);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS news_title_trgm_idx;
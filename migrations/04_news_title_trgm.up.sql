@@ -0,0 +1,3 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS news_title_trgm_idx ON news USING gin (title gin_trgm_ops);
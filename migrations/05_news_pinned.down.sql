@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS news_pinned_at_idx;
+
+ALTER TABLE news
+    DROP COLUMN IF EXISTS pinned_until,
+    DROP COLUMN IF EXISTS pinned_at;
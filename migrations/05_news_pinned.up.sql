@@ -0,0 +1,5 @@
+ALTER TABLE news
+    ADD COLUMN IF NOT EXISTS pinned_at    TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS pinned_until TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS news_pinned_at_idx ON news (pinned_at DESC) WHERE pinned_at IS NOT NULL;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS news_revisions
+(
+    revision_id    UUID PRIMARY KEY                  DEFAULT uuid_generate_v4(),
+    news_id        UUID                     NOT NULL REFERENCES news (news_id) ON DELETE CASCADE,
+    actor_id       UUID                     REFERENCES users (user_id) ON DELETE SET NULL,
+    title          VARCHAR(250)             NOT NULL,
+    content        TEXT                     NOT NULL,
+    image_url      VARCHAR(1024),
+    category       VARCHAR(250),
+    status         VARCHAR(20)              NOT NULL,
+    changed_fields VARCHAR(250)             NOT NULL DEFAULT '',
+    created_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS news_revisions_news_id_created_at_idx ON news_revisions (news_id, created_at);
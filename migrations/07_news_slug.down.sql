@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS news_slug_uidx;
+
+ALTER TABLE news
+    DROP COLUMN IF EXISTS slug;
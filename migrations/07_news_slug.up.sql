@@ -0,0 +1,4 @@
+ALTER TABLE news
+    ADD COLUMN IF NOT EXISTS slug VARCHAR(250);
+
+CREATE UNIQUE INDEX IF NOT EXISTS news_slug_uidx ON news (slug);
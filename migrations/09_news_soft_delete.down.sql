@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS news_updated_at_idx;
+
+DELETE FROM news WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE news
+    DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,4 @@
+ALTER TABLE news
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS news_updated_at_idx ON news (updated_at, news_id);
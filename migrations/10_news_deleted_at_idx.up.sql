@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS news_deleted_at_idx ON news (deleted_at) WHERE deleted_at IS NOT NULL;
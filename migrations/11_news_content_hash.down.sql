@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS news_content_hash_uidx;
+
+ALTER TABLE news
+    DROP COLUMN IF EXISTS content_hash;
@@ -0,0 +1,4 @@
+ALTER TABLE news
+    ADD COLUMN IF NOT EXISTS content_hash CHAR(64);
+
+CREATE UNIQUE INDEX IF NOT EXISTS news_content_hash_uidx ON news (content_hash) WHERE deleted_at IS NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS news_metadata_idx;
+
+ALTER TABLE news
+    DROP COLUMN IF EXISTS metadata;
@@ -0,0 +1,4 @@
+ALTER TABLE news
+    ADD COLUMN IF NOT EXISTS metadata JSONB;
+
+CREATE INDEX IF NOT EXISTS news_metadata_idx ON news USING GIN (metadata jsonb_path_ops);
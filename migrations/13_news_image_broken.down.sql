@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS news_image_broken_idx;
+
+ALTER TABLE news
+    DROP COLUMN IF EXISTS image_broken;
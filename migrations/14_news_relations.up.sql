@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS news_relations
+(
+    from_news_id UUID                     NOT NULL REFERENCES news (news_id) ON DELETE CASCADE,
+    to_news_id   UUID                     NOT NULL REFERENCES news (news_id) ON DELETE CASCADE,
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (from_news_id, to_news_id),
+    CHECK (from_news_id <> to_news_id)
+);
+
+CREATE INDEX IF NOT EXISTS news_relations_to_news_id_idx ON news_relations (to_news_id);
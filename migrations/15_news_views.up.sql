@@ -0,0 +1,2 @@
+ALTER TABLE news
+    ADD COLUMN IF NOT EXISTS views BIGINT NOT NULL DEFAULT 0;
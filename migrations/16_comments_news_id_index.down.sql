@@ -0,0 +1 @@
+DROP INDEX IF EXISTS comments_news_id_idx;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS comments_news_id_idx ON comments (news_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS news_word_count_idx;
+
+ALTER TABLE news
+    DROP COLUMN IF EXISTS word_count;
@@ -0,0 +1,8 @@
+ALTER TABLE news
+    ADD COLUMN IF NOT EXISTS word_count INT NOT NULL DEFAULT 0;
+
+UPDATE news
+SET word_count = COALESCE(array_length(regexp_split_to_array(btrim(content), '\s+'), 1), 0)
+WHERE btrim(content) <> '';
+
+CREATE INDEX IF NOT EXISTS news_word_count_idx ON news (word_count);
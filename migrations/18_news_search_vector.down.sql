@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS news_text_vector_idx;
+DROP INDEX IF EXISTS news_content_vector_idx;
@@ -0,0 +1,5 @@
+CREATE INDEX IF NOT EXISTS news_content_vector_idx ON news
+    USING GIN (to_tsvector('simple', content));
+
+CREATE INDEX IF NOT EXISTS news_text_vector_idx ON news
+    USING GIN ((setweight(to_tsvector('simple', title), 'A') || setweight(to_tsvector('simple', content), 'B')));
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS comments_news_comment_count ON comments;
+DROP FUNCTION IF EXISTS news_comment_count();
+
+ALTER TABLE news
+    DROP COLUMN IF EXISTS comment_count;
@@ -0,0 +1,25 @@
+ALTER TABLE news
+    ADD COLUMN IF NOT EXISTS comment_count INT NOT NULL DEFAULT 0;
+
+UPDATE news n
+SET comment_count = c.total
+FROM (SELECT news_id, COUNT(comment_id) AS total FROM comments GROUP BY news_id) c
+WHERE n.news_id = c.news_id;
+
+CREATE OR REPLACE FUNCTION news_comment_count() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE news SET comment_count = comment_count + 1 WHERE news_id = NEW.news_id;
+    ELSIF TG_OP = 'DELETE' THEN
+        UPDATE news SET comment_count = comment_count - 1 WHERE news_id = OLD.news_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER comments_news_comment_count
+    AFTER INSERT OR DELETE
+    ON comments
+    FOR EACH ROW
+EXECUTE FUNCTION news_comment_count();
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS news_tenant_id_created_at_idx;
+
+ALTER TABLE news
+    DROP COLUMN IF EXISTS tenant_id;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS tenant_id;
@@ -0,0 +1,7 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS tenant_id UUID;
+
+ALTER TABLE news
+    ADD COLUMN IF NOT EXISTS tenant_id UUID;
+
+CREATE INDEX IF NOT EXISTS news_tenant_id_created_at_idx ON news (tenant_id, created_at DESC);
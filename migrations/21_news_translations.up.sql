@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS news_translations
+(
+    news_id    UUID                     NOT NULL REFERENCES news (news_id) ON DELETE CASCADE,
+    locale     VARCHAR(35)              NOT NULL CHECK ( locale <> '' ),
+    title      VARCHAR(250)             NOT NULL CHECK ( title <> '' ),
+    content    TEXT                     NOT NULL CHECK ( content <> '' ),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE          DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (news_id, locale)
+);
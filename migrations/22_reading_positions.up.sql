@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS reading_positions
+(
+    user_id    UUID                     NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+    news_id    UUID                     NOT NULL REFERENCES news (news_id) ON DELETE CASCADE,
+    position   DOUBLE PRECISION         NOT NULL CHECK ( position >= 0 AND position <= 1 ),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, news_id)
+);
@@ -0,0 +1,2 @@
+DROP VIEW IF EXISTS news_activity;
+DROP TABLE IF EXISTS news_events;
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS news_events
+(
+    event_id   UUID PRIMARY KEY                  DEFAULT uuid_generate_v4(),
+    news_id    UUID                     NOT NULL REFERENCES news (news_id) ON DELETE CASCADE,
+    actor_id   UUID                     REFERENCES users (user_id) ON DELETE SET NULL,
+    action     VARCHAR(20)              NOT NULL CHECK ( action IN ('create', 'update', 'delete') ),
+    title      VARCHAR(250)             NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS news_events_created_at_idx ON news_events (created_at DESC, event_id DESC);
+CREATE INDEX IF NOT EXISTS news_events_action_created_at_idx ON news_events (action, created_at DESC, event_id DESC);
+
+-- Activity of published news only, so titles of drafts never show up in public feed
+CREATE OR REPLACE VIEW news_activity AS
+SELECT e.event_id,
+       e.action,
+       e.news_id,
+       e.title,
+       e.actor_id,
+       CASE WHEN u.user_id IS NULL THEN NULL ELSE CONCAT(u.first_name, ' ', u.last_name) END AS actor,
+       e.created_at
+FROM news_events e
+         JOIN news n ON n.news_id = e.news_id
+         LEFT JOIN users u ON u.user_id = e.actor_id
+WHERE n.status = 'published';
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS news_events_actor_updates_idx;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS news_events_actor_updates_idx ON news_events (actor_id, news_id, created_at DESC) WHERE action = 'update';
@@ -0,0 +1,19 @@
+DROP VIEW IF EXISTS news_activity;
+CREATE VIEW news_activity AS
+SELECT e.event_id,
+       e.action,
+       e.news_id,
+       e.title,
+       e.actor_id,
+       CASE WHEN u.user_id IS NULL THEN NULL ELSE CONCAT(u.first_name, ' ', u.last_name) END AS actor,
+       e.created_at
+FROM news_events e
+         JOIN news n ON n.news_id = e.news_id
+         LEFT JOIN users u ON u.user_id = e.actor_id
+WHERE n.status = 'published';
+
+DROP INDEX IF EXISTS news_tenant_content_hash_uidx;
+DROP INDEX IF EXISTS news_tenant_slug_uidx;
+
+CREATE UNIQUE INDEX IF NOT EXISTS news_content_hash_uidx ON news (content_hash) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS news_slug_uidx ON news (slug);
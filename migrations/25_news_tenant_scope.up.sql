@@ -0,0 +1,22 @@
+-- Slugs and content are unique per tenant, news without tenant share nil tenant so single tenant deployments keep global uniqueness
+DROP INDEX IF EXISTS news_slug_uidx;
+DROP INDEX IF EXISTS news_content_hash_uidx;
+
+CREATE UNIQUE INDEX IF NOT EXISTS news_tenant_slug_uidx
+    ON news ((COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::uuid)), slug);
+CREATE UNIQUE INDEX IF NOT EXISTS news_tenant_content_hash_uidx
+    ON news ((COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::uuid)), content_hash) WHERE deleted_at IS NULL;
+
+CREATE OR REPLACE VIEW news_activity AS
+SELECT e.event_id,
+       e.action,
+       e.news_id,
+       e.title,
+       e.actor_id,
+       CASE WHEN u.user_id IS NULL THEN NULL ELSE CONCAT(u.first_name, ' ', u.last_name) END AS actor,
+       e.created_at,
+       n.tenant_id
+FROM news_events e
+         JOIN news n ON n.news_id = e.news_id
+         LEFT JOIN users u ON u.user_id = e.actor_id
+WHERE n.status = 'published';
@@ -0,0 +1,88 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"unicode"
+)
+
+// JSON key naming strategies, snake_case is the naming of model tags
+const (
+	SnakeCase = "snake_case"
+	CamelCase = "camelCase"
+)
+
+// Convert snake_case key to camelCase, "news_id" becomes "newsId"
+func SnakeToCamel(key string) string {
+	if !strings.Contains(key, "_") {
+		return key
+	}
+	var b strings.Builder
+	upper := false
+	for i, r := range key {
+		if r == '_' && i > 0 {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Convert camelCase key to snake_case, "newsId" becomes "news_id"
+func CamelToSnake(key string) string {
+	var b strings.Builder
+	for i, r := range key {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Keys whose values are owned by clients, e.g. news metadata, their inner keys are stored and returned as given
+var opaqueKeys = map[string]struct{}{
+	"metadata": {},
+}
+
+// Rename keys of all objects in JSON document, values are kept as is and values of opaque keys are not renamed inside
+func RenameJSONKeys(data []byte, rename func(string) string) ([]byte, error) {
+	var doc interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return json.Marshal(renameKeys(doc, rename))
+}
+
+func renameKeys(v interface{}, rename func(string) string) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		renamed := make(map[string]interface{}, len(value))
+		for k, item := range value {
+			if _, ok := opaqueKeys[k]; ok {
+				renamed[rename(k)] = item
+				continue
+			}
+			renamed[rename(k)] = renameKeys(item, rename)
+		}
+		return renamed
+	case []interface{}:
+		for i, item := range value {
+			value[i] = renameKeys(item, rename)
+		}
+		return value
+	default:
+		return v
+	}
+}
@@ -0,0 +1,50 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnakeToCamel(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "newsId", SnakeToCamel("news_id"))
+	require.Equal(t, "totalCount", SnakeToCamel("total_count"))
+	require.Equal(t, "readingTimeMinutes", SnakeToCamel("reading_time_minutes"))
+	require.Equal(t, "title", SnakeToCamel("title"))
+}
+
+func TestCamelToSnake(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "news_id", CamelToSnake("newsId"))
+	require.Equal(t, "has_more", CamelToSnake("hasMore"))
+	require.Equal(t, "title", CamelToSnake("title"))
+	require.Equal(t, "news_id", CamelToSnake(SnakeToCamel("news_id")))
+}
+
+func TestRenameJSONKeys(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`{"total_count":2,"news":[{"news_id":"1","image_url":null,"views":12345678901234567890}],"tags":["snake_case"]}`)
+
+	renamed, err := RenameJSONKeys(data, SnakeToCamel)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"totalCount":2,"news":[{"newsId":"1","imageUrl":null,"views":12345678901234567890}],"tags":["snake_case"]}`, string(renamed))
+
+	t.Run("Metadata kept as is", func(t *testing.T) {
+		data := []byte(`{"news_id":"1","metadata":{"source_url":"x","nested":{"my_key":1}}}`)
+		renamed, err := RenameJSONKeys(data, SnakeToCamel)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"newsId":"1","metadata":{"source_url":"x","nested":{"my_key":1}}}`, string(renamed))
+
+		data = []byte(`{"newsId":"1","metadata":{"sourceUrl":"x"}}`)
+		renamed, err = RenameJSONKeys(data, CamelToSnake)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"news_id":"1","metadata":{"sourceUrl":"x"}}`, string(renamed))
+	})
+
+	_, err = RenameJSONKeys([]byte(`{"broken"`), SnakeToCamel)
+	require.Error(t, err)
+}
@@ -0,0 +1,131 @@
+package converter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var (
+	markdownSpaces  = regexp.MustCompile(`[ \t\r\n]+`)
+	markdownNewLine = regexp.MustCompile(`\n{3,}`)
+)
+
+// Convert html fragment to markdown, plain text is returned as is
+func HTMLToMarkdown(src string) (string, error) {
+	nodes, err := html.ParseFragment(strings.NewReader(src), &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body})
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	for _, n := range nodes {
+		writeMarkdown(&sb, n, "")
+	}
+
+	md := markdownNewLine.ReplaceAllString(sb.String(), "\n\n")
+	return strings.TrimSpace(md), nil
+}
+
+func writeMarkdown(sb *strings.Builder, n *html.Node, listPrefix string) {
+	switch n.Type {
+	case html.TextNode:
+		sb.WriteString(markdownSpaces.ReplaceAllString(n.Data, " "))
+		return
+	case html.ElementNode:
+	default:
+		writeMarkdownChildren(sb, n, listPrefix)
+		return
+	}
+
+	switch n.DataAtom {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		level := int(n.Data[1] - '0')
+		sb.WriteString("\n\n" + strings.Repeat("#", level) + " ")
+		writeMarkdownChildren(sb, n, listPrefix)
+		sb.WriteString("\n\n")
+	case atom.P, atom.Div:
+		sb.WriteString("\n\n")
+		writeMarkdownChildren(sb, n, listPrefix)
+		sb.WriteString("\n\n")
+	case atom.Br:
+		sb.WriteString("  \n")
+	case atom.Hr:
+		sb.WriteString("\n\n---\n\n")
+	case atom.Strong, atom.B:
+		sb.WriteString("**")
+		writeMarkdownChildren(sb, n, listPrefix)
+		sb.WriteString("**")
+	case atom.Em, atom.I:
+		sb.WriteString("_")
+		writeMarkdownChildren(sb, n, listPrefix)
+		sb.WriteString("_")
+	case atom.Code:
+		sb.WriteString("`" + nodeText(n) + "`")
+	case atom.Pre:
+		sb.WriteString("\n\n```\n" + strings.Trim(nodeText(n), "\n") + "\n```\n\n")
+	case atom.A:
+		sb.WriteString("[")
+		writeMarkdownChildren(sb, n, listPrefix)
+		sb.WriteString(fmt.Sprintf("](%s)", nodeAttr(n, "href")))
+	case atom.Img:
+		sb.WriteString(fmt.Sprintf("![%s](%s)", nodeAttr(n, "alt"), nodeAttr(n, "src")))
+	case atom.Blockquote:
+		var inner strings.Builder
+		writeMarkdownChildren(&inner, n, listPrefix)
+		sb.WriteString("\n\n")
+		for _, line := range strings.Split(strings.TrimSpace(markdownNewLine.ReplaceAllString(inner.String(), "\n\n")), "\n") {
+			sb.WriteString("> " + strings.TrimSpace(line) + "\n")
+		}
+		sb.WriteString("\n")
+	case atom.Ul, atom.Ol:
+		sb.WriteString("\n\n")
+		i := 1
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode || c.DataAtom != atom.Li {
+				continue
+			}
+			marker := "- "
+			if n.DataAtom == atom.Ol {
+				marker = fmt.Sprintf("%d. ", i)
+			}
+			sb.WriteString(listPrefix + marker)
+			writeMarkdownChildren(sb, c, listPrefix+"  ")
+			sb.WriteString("\n")
+			i++
+		}
+		sb.WriteString("\n")
+	case atom.Script, atom.Style:
+	default:
+		writeMarkdownChildren(sb, n, listPrefix)
+	}
+}
+
+func writeMarkdownChildren(sb *strings.Builder, n *html.Node, listPrefix string) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		writeMarkdown(sb, c, listPrefix)
+	}
+}
+
+func nodeText(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var sb strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		sb.WriteString(nodeText(c))
+	}
+	return sb.String()
+}
+
+func nodeAttr(n *html.Node, key string) string {
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+)
+
+// Query cost guard, explains statements before running them and warns when planner estimate is above threshold.
+// Meant for staging to catch filter combinations missing an index, every check costs an extra round trip.
+type CostGuard struct {
+	threshold float64
+	logger    logger.Logger
+}
+
+// Query cost guard constructor, returns nil guard which never explains when disabled
+func NewCostGuard(enabled bool, threshold float64, logger logger.Logger) *CostGuard {
+	if !enabled || threshold <= 0 {
+		return nil
+	}
+	return &CostGuard{threshold: threshold, logger: logger}
+}
+
+type explainPlan struct {
+	Plan struct {
+		NodeType  string  `json:"Node Type"`
+		TotalCost float64 `json:"Total Cost"`
+	} `json:"Plan"`
+}
+
+// Explain query with given args and log warning when its estimated total cost exceeds threshold.
+// Guard never fails the caller, explain errors are only logged
+func (g *CostGuard) Check(ctx context.Context, q sqlx.QueryerContext, name string, query string, args ...interface{}) {
+	if g == nil {
+		return
+	}
+
+	cost, nodeType, err := explainCost(ctx, q, query, args...)
+	if err != nil {
+		g.logger.Errorf("CostGuard.Check, Statement: %s, explain: %v", name, err)
+		return
+	}
+	if cost > g.threshold {
+		g.logger.Warnf("Expensive query, Statement: %s, Params: %d, Cost: %.2f, Threshold: %.2f, Plan: %s", name, len(args), cost, g.threshold, nodeType)
+	}
+}
+
+func explainCost(ctx context.Context, q sqlx.QueryerContext, query string, args ...interface{}) (float64, string, error) {
+	var raw []byte
+	if err := q.QueryRowxContext(ctx, "EXPLAIN (FORMAT JSON) "+query, args...).Scan(&raw); err != nil {
+		return 0, "", errors.Wrap(err, "QueryRowxContext")
+	}
+
+	var plans []explainPlan
+	if err := json.Unmarshal(raw, &plans); err != nil {
+		return 0, "", errors.Wrap(err, "json.Unmarshal")
+	}
+	if len(plans) == 0 {
+		return 0, "", errors.New("empty plan")
+	}
+	return plans[0].Plan.TotalCost, plans[0].Plan.NodeType, nil
+}
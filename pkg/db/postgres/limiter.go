@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const defaultAcquireTimeout = time.Second
+
+// Returned when no DB operation slot frees up within acquire timeout
+var ErrServerBusy = errors.New("server is busy")
+
+// Semaphore bounding concurrent DB operations, so a traffic spike fails fast instead of piling up on the pool
+type Limiter struct {
+	slots   chan struct{}
+	timeout time.Duration
+}
+
+// Limiter constructor, returns nil limiter which never limits when maxConcurrent is not positive.
+// Zero timeout uses default one
+func NewLimiter(maxConcurrent int, timeout time.Duration) *Limiter {
+	if maxConcurrent <= 0 {
+		return nil
+	}
+	if timeout <= 0 {
+		timeout = defaultAcquireTimeout
+	}
+	return &Limiter{slots: make(chan struct{}, maxConcurrent), timeout: timeout}
+}
+
+// Take slot for single operation, release must be called once operation is done.
+// Fails with ErrServerBusy after timeout, or with context error when ctx is done first
+func (l *Limiter) Acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return l.release, nil
+	default:
+	}
+
+	timer := time.NewTimer(l.timeout)
+	defer timer.Stop()
+	select {
+	case l.slots <- struct{}{}:
+		return l.release, nil
+	case <-timer.C:
+		return nil, errors.Wrapf(ErrServerBusy, "postgres.Limiter.Acquire: %d operations in flight for %s", cap(l.slots), l.timeout)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (l *Limiter) release() {
+	<-l.slots
+}
@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter_Acquire(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Beyond limit is busy", func(t *testing.T) {
+		limiter := NewLimiter(2, 20*time.Millisecond)
+
+		first, err := limiter.Acquire(context.Background())
+		require.NoError(t, err)
+		second, err := limiter.Acquire(context.Background())
+		require.NoError(t, err)
+
+		start := time.Now()
+		release, err := limiter.Acquire(context.Background())
+		require.Nil(t, release)
+		require.True(t, errors.Is(err, ErrServerBusy))
+		require.Less(t, int64(time.Since(start)), int64(time.Second))
+
+		first()
+		third, err := limiter.Acquire(context.Background())
+		require.NoError(t, err)
+		second()
+		third()
+	})
+
+	t.Run("Waits for released slot", func(t *testing.T) {
+		limiter := NewLimiter(1, time.Second)
+
+		release, err := limiter.Acquire(context.Background())
+		require.NoError(t, err)
+		time.AfterFunc(10*time.Millisecond, release)
+
+		next, err := limiter.Acquire(context.Background())
+		require.NoError(t, err)
+		next()
+	})
+
+	t.Run("Context done first", func(t *testing.T) {
+		limiter := NewLimiter(1, time.Second)
+
+		release, err := limiter.Acquire(context.Background())
+		require.NoError(t, err)
+		defer release()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = limiter.Acquire(ctx)
+		require.True(t, errors.Is(err, context.DeadlineExceeded))
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		limiter := NewLimiter(0, time.Second)
+		require.Nil(t, limiter)
+
+		for i := 0; i < 10; i++ {
+			_, err := limiter.Acquire(context.Background())
+			require.NoError(t, err)
+		}
+	})
+}
+
+func TestQueryTimer_Limiter(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+
+	query := "SELECT pg_sleep(1)"
+	timer := NewQueryTimer(0, false, nil).WithLimiter(NewLimiter(1, 20*time.Millisecond))
+
+	mock.ExpectExec(query).WillDelayFor(200 * time.Millisecond).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	done := make(chan error)
+	go func() {
+		_, err := timer.ExecContext(context.Background(), sqlxDB, "sleep", query)
+		done <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	var dest []string
+	err = timer.SelectContext(context.Background(), sqlxDB, "sleep", &dest, query)
+	require.True(t, errors.Is(err, ErrServerBusy))
+
+	var n int
+	err = timer.QueryRowxContext(context.Background(), sqlxDB, "sleep", query).Scan(&n)
+	require.True(t, errors.Is(err, ErrServerBusy))
+
+	require.NoError(t, <-done)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
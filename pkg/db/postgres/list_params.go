@@ -0,0 +1,27 @@
+package postgres
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Max items of list argument bound to one ANY($n) param when Postgres.MaxListParams is not set
+const DefaultMaxListParams = 1000
+
+// Returned when list argument of query is longer than allowed
+var ErrBatchTooLarge = errors.New("batch is too large")
+
+// Configured max items of list argument, zero or negative uses DefaultMaxListParams
+func MaxListParams(configured int) int {
+	if configured <= 0 {
+		return DefaultMaxListParams
+	}
+	return configured
+}
+
+// Check list argument fits one query, callers with larger jobs should split them into chunks of at most max items
+func CheckListSize(method string, size int, max int) error {
+	if size > max {
+		return errors.Wrapf(ErrBatchTooLarge, "%s: %d items given, max %d", method, size, max)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+)
+
+const (
+	redactedParam     = "[REDACTED]"
+	maxLoggedParamLen = 100
+)
+
+// Query timer, wraps sqlx calls and warns about statements running longer than threshold.
+// Only statement name and bound parameters count are logged, values are logged only with logParams for debugging
+type QueryTimer struct {
+	threshold time.Duration
+	logParams bool
+	logger    logger.Logger
+	limiter   *Limiter
+}
+
+// Query timer constructor, zero threshold disables slow query logging.
+// With logParams every statement is logged at debug level with its bound parameters, Sensitive ones redacted
+func NewQueryTimer(threshold time.Duration, logParams bool, logger logger.Logger) *QueryTimer {
+	return &QueryTimer{threshold: threshold, logParams: logParams, logger: logger}
+}
+
+// Bound concurrent statements with limiter, every call waits for free slot before running
+func (t *QueryTimer) WithLimiter(limiter *Limiter) *QueryTimer {
+	t.limiter = limiter
+	return t
+}
+
+// Single row result, holds limiter error when statement did not run
+type Row struct {
+	row *sqlx.Row
+	err error
+}
+
+// Scan row columns into dest
+func (r *Row) Scan(dest ...interface{}) error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.row.Scan(dest...)
+}
+
+// Scan row into struct
+func (r *Row) StructScan(dest interface{}) error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.row.StructScan(dest)
+}
+
+// Mark bound parameter as sensitive, it is passed to driver as is but never written to logs
+func Sensitive(value interface{}) driver.Valuer {
+	return sensitiveParam{value: value}
+}
+
+type sensitiveParam struct {
+	value interface{}
+}
+
+func (s sensitiveParam) Value() (driver.Value, error) {
+	if valuer, ok := s.value.(driver.Valuer); ok {
+		return valuer.Value()
+	}
+	return s.value, nil
+}
+
+// Get single row into dest
+func (t *QueryTimer) GetContext(ctx context.Context, q sqlx.QueryerContext, name string, dest interface{}, query string, args ...interface{}) error {
+	release, err := t.limiter.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	defer t.track(name, args)()
+	return withContextErr(ctx, sqlx.GetContext(ctx, q, dest, query, args...))
+}
+
+// Select rows into dest slice
+func (t *QueryTimer) SelectContext(ctx context.Context, q sqlx.QueryerContext, name string, dest interface{}, query string, args ...interface{}) error {
+	release, err := t.limiter.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	defer t.track(name, args)()
+	return withContextErr(ctx, sqlx.SelectContext(ctx, q, dest, query, args...))
+}
+
+// Query rows, limiter slot is released once query returns, reading rows is not counted
+func (t *QueryTimer) QueryxContext(ctx context.Context, q sqlx.QueryerContext, name string, query string, args ...interface{}) (*sqlx.Rows, error) {
+	release, err := t.limiter.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	defer t.track(name, args)()
+	rows, err := q.QueryxContext(ctx, query, args...)
+	return rows, withContextErr(ctx, err)
+}
+
+// Query single row
+func (t *QueryTimer) QueryRowxContext(ctx context.Context, q sqlx.QueryerContext, name string, query string, args ...interface{}) *Row {
+	release, err := t.limiter.Acquire(ctx)
+	if err != nil {
+		return &Row{err: err}
+	}
+	defer release()
+
+	defer t.track(name, args)()
+	return &Row{row: q.QueryRowxContext(ctx, query, args...)}
+}
+
+// Exec statement
+func (t *QueryTimer) ExecContext(ctx context.Context, e sqlx.ExecerContext, name string, query string, args ...interface{}) (sql.Result, error) {
+	release, err := t.limiter.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	defer t.track(name, args)()
+	res, err := e.ExecContext(ctx, query, args...)
+	return res, withContextErr(ctx, err)
+}
+
+// Drivers report query interrupted by cancelled context with their own errors, keep context error in chain so callers can match it
+func withContextErr(ctx context.Context, err error) error {
+	if err == nil || ctx.Err() == nil || errors.Is(err, ctx.Err()) {
+		return err
+	}
+	return errors.Wrap(ctx.Err(), err.Error())
+}
+
+func (t *QueryTimer) track(name string, args []interface{}) func() {
+	if t.logParams {
+		t.logger.Debugf("Query, Statement: %s, Params: [%s]", name, formatParams(args))
+	}
+
+	start := time.Now()
+	return func() {
+		if t.threshold <= 0 {
+			return
+		}
+		if elapsed := time.Since(start); elapsed > t.threshold {
+			t.logger.Warnf("Slow query, Statement: %s, Params: %d, Duration: %s, Threshold: %s", name, len(args), elapsed, t.threshold)
+		}
+	}
+}
+
+// Format bound parameters as $n=value, sensitive ones redacted and long ones cut
+func formatParams(args []interface{}) string {
+	params := make([]string, 0, len(args))
+	for i, arg := range args {
+		var value string
+		switch v := arg.(type) {
+		case sensitiveParam:
+			value = redactedParam
+		case nil:
+			value = "NULL"
+		case []byte:
+			value = string(v)
+		default:
+			value = fmt.Sprintf("%v", v)
+		}
+		if runes := []rune(value); len(runes) > maxLoggedParamLen {
+			value = string(runes[:maxLoggedParamLen]) + "..."
+		}
+		params = append(params, fmt.Sprintf("$%d=%s", i+1, value))
+	}
+	return strings.Join(params, ", ")
+}
@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+)
+
+func TestQueryTimer_ContextErr(t *testing.T) {
+	t.Parallel()
+
+	query := "SELECT pg_sleep(1)"
+	timer := NewQueryTimer(0, false, nil)
+
+	t.Run("Cancelled during query", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close()
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+
+		mock.ExpectQuery(query).WillDelayFor(time.Second).WillReturnRows(sqlmock.NewRows([]string{"pg_sleep"}))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		var dest []string
+		err = timer.SelectContext(ctx, sqlxDB, "sleep", &dest, query)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, context.Canceled))
+	})
+
+	t.Run("Deadline during exec", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close()
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+
+		mock.ExpectExec(query).WillDelayFor(time.Second).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err = timer.ExecContext(ctx, sqlxDB, "sleep", query)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, context.DeadlineExceeded))
+	})
+
+	t.Run("Other errors untouched", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close()
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+
+		queryErr := errors.New("syntax error")
+		mock.ExpectExec(query).WillReturnError(queryErr)
+
+		_, err = timer.ExecContext(context.Background(), sqlxDB, "sleep", query)
+		require.Equal(t, queryErr, err)
+	})
+}
+
+type debugRecorder struct {
+	logger.Logger
+	debugs []string
+}
+
+func (d *debugRecorder) Debugf(template string, args ...interface{}) {
+	d.debugs = append(d.debugs, fmt.Sprintf(template, args...))
+}
+
+func TestQueryTimer_LogParams(t *testing.T) {
+	t.Parallel()
+
+	query := "SELECT title FROM news WHERE news_id = $1 AND metadata @> $2 AND content = $3"
+	longContent := strings.Repeat("a", 150)
+	run := func(t *testing.T, timer *QueryTimer) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close()
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+
+		mock.ExpectQuery(query).WithArgs("news-id", `{"token":"secret"}`, longContent).
+			WillReturnRows(sqlmock.NewRows([]string{"title"}).AddRow("Title"))
+
+		var dest []string
+		require.NoError(t, timer.SelectContext(context.Background(), sqlxDB, "getTitle", &dest, query, "news-id", Sensitive(`{"token":"secret"}`), longContent))
+		require.NoError(t, mock.ExpectationsWereMet())
+	}
+
+	t.Run("Logged in debug mode", func(t *testing.T) {
+		recorder := &debugRecorder{}
+		run(t, NewQueryTimer(0, true, recorder))
+
+		require.Len(t, recorder.debugs, 1)
+		require.Contains(t, recorder.debugs[0], "Statement: getTitle")
+		require.Contains(t, recorder.debugs[0], "$1=news-id")
+		require.Contains(t, recorder.debugs[0], "$2=[REDACTED]")
+		require.Contains(t, recorder.debugs[0], "$3="+strings.Repeat("a", maxLoggedParamLen)+"...")
+		require.NotContains(t, recorder.debugs[0], "secret")
+	})
+
+	t.Run("Absent otherwise", func(t *testing.T) {
+		recorder := &debugRecorder{}
+		run(t, NewQueryTimer(0, false, recorder))
+
+		require.Empty(t, recorder.debugs)
+	})
+}
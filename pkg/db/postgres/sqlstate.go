@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+// Postgres error codes translated into API errors
+const (
+	UniqueViolation     = "23505"
+	ForeignKeyViolation = "23503"
+	QueryCanceled       = "57014"
+)
+
+var sqlStateText = regexp.MustCompile(`SQLSTATE ([0-9A-Z]{5})`)
+
+// SQLSTATE of postgres error in err chain, empty when err is not a postgres error.
+// Errors of jackc/pgx and lib/pq both expose SQLState method, so either driver set in Postgres.PgDriver works.
+// Code is also read from error text, drivers errors flattened into message keep it there
+func SQLState(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var pgErr interface{ SQLState() string }
+	if errors.As(err, &pgErr) {
+		return pgErr.SQLState()
+	}
+	if m := sqlStateText.FindStringSubmatch(err.Error()); m != nil {
+		return m[1]
+	}
+	return ""
+}
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// Same shape as lib/pq error, message has no SQLSTATE and code is exposed through method
+type pqError struct {
+	Code       string
+	Message    string
+	Constraint string
+}
+
+func (e *pqError) Error() string {
+	return "pq: " + e.Message
+}
+
+func (e *pqError) SQLState() string {
+	return e.Code
+}
+
+func TestSQLState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pgx error", func(t *testing.T) {
+		err := pgx.PgError{Severity: "ERROR", Code: UniqueViolation, Message: "duplicate key value"}
+		require.Equal(t, UniqueViolation, SQLState(err))
+		require.Equal(t, UniqueViolation, SQLState(&err))
+		require.Equal(t, UniqueViolation, SQLState(errors.Wrap(err, "newsRepo.Create")))
+	})
+
+	t.Run("lib/pq error", func(t *testing.T) {
+		err := &pqError{Code: ForeignKeyViolation, Message: "insert or update violates foreign key constraint"}
+		require.Equal(t, ForeignKeyViolation, SQLState(err))
+		require.Equal(t, ForeignKeyViolation, SQLState(fmt.Errorf("newsRepo.Create: %w", err)))
+	})
+
+	t.Run("Code in message only", func(t *testing.T) {
+		err := errors.Wrap(errors.New("context canceled"), "ERROR: canceling statement due to user request (SQLSTATE 57014)")
+		require.Equal(t, QueryCanceled, SQLState(err))
+	})
+
+	t.Run("Not postgres error", func(t *testing.T) {
+		require.Empty(t, SQLState(nil))
+		require.Empty(t, SQLState(errors.New("boom")))
+	})
+}
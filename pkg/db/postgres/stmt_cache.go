@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// Prepared statements cache, statements are prepared lazily on first use and reused afterwards
+type StmtCache struct {
+	db    *sqlx.DB
+	mu    sync.Mutex
+	stmts map[string]*sqlx.Stmt
+	// Prepares in progress, concurrent callers of the same query wait for one prepare
+	calls map[string]*stmtCall
+}
+
+type stmtCall struct {
+	done chan struct{}
+	stmt *sqlx.Stmt
+	err  error
+}
+
+// Prepared statements cache constructor
+func NewStmtCache(db *sqlx.DB) *StmtCache {
+	return &StmtCache{db: db, stmts: make(map[string]*sqlx.Stmt), calls: make(map[string]*stmtCall)}
+}
+
+// Get prepared statement for query as sqlx.QueryerContext, so it can be used in place of db.
+// Query passed to the returned queryer methods is ignored, the prepared one is executed
+func (c *StmtCache) Queryer(ctx context.Context, query string) (sqlx.QueryerContext, error) {
+	stmt, err := c.Stmt(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return stmtQueryer{stmt}, nil
+}
+
+// Get prepared statement for query, preparing it if needed.
+// Prepare runs outside of the lock, so lookups of other queries never wait for it
+func (c *StmtCache) Stmt(ctx context.Context, query string) (*sqlx.Stmt, error) {
+	c.mu.Lock()
+	if stmt, ok := c.stmts[query]; ok {
+		c.mu.Unlock()
+		return stmt, nil
+	}
+	if call, ok := c.calls[query]; ok {
+		c.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.stmt, call.err
+		case <-ctx.Done():
+			return nil, errors.Wrap(ctx.Err(), "postgres.StmtCache.Stmt")
+		}
+	}
+	call := &stmtCall{done: make(chan struct{})}
+	c.calls[query] = call
+	c.mu.Unlock()
+
+	call.stmt, call.err = c.db.PreparexContext(ctx, query)
+	if call.err != nil {
+		call.err = errors.Wrap(call.err, "postgres.StmtCache.PreparexContext")
+	}
+
+	c.mu.Lock()
+	delete(c.calls, query)
+	if call.err == nil {
+		c.stmts[query] = call.stmt
+	}
+	c.mu.Unlock()
+	close(call.done)
+
+	return call.stmt, call.err
+}
+
+// Close all prepared statements
+func (c *StmtCache) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var firstErr error
+	for query, stmt := range c.stmts {
+		if err := stmt.Close(); err != nil && firstErr == nil {
+			firstErr = errors.Wrap(err, "postgres.StmtCache.Close")
+		}
+		delete(c.stmts, query)
+	}
+	return firstErr
+}
+
+type stmtQueryer struct {
+	stmt *sqlx.Stmt
+}
+
+func (s stmtQueryer) QueryContext(ctx context.Context, _ string, args ...interface{}) (*sql.Rows, error) {
+	return s.stmt.QueryContext(ctx, args...)
+}
+
+func (s stmtQueryer) QueryxContext(ctx context.Context, _ string, args ...interface{}) (*sqlx.Rows, error) {
+	return s.stmt.QueryxContext(ctx, args...)
+}
+
+func (s stmtQueryer) QueryRowxContext(ctx context.Context, _ string, args ...interface{}) *sqlx.Row {
+	return s.stmt.QueryRowxContext(ctx, args...)
+}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStmtCache_Stmt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Prepared once for concurrent callers", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close()
+		cache := NewStmtCache(sqlx.NewDb(db, "sqlmock"))
+
+		mock.ExpectPrepare("SELECT 1").WillDelayFor(20 * time.Millisecond)
+
+		var wg sync.WaitGroup
+		stmts := make([]*sqlx.Stmt, 10)
+		for i := range stmts {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				stmts[i], _ = cache.Stmt(context.Background(), "SELECT 1")
+			}(i)
+		}
+		wg.Wait()
+
+		for _, stmt := range stmts {
+			require.NotNil(t, stmt)
+			require.Same(t, stmts[0], stmt)
+		}
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Hit does not wait for other prepare", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close()
+		mock.MatchExpectationsInOrder(false)
+		cache := NewStmtCache(sqlx.NewDb(db, "sqlmock"))
+
+		mock.ExpectPrepare("SELECT 1")
+		_, err = cache.Stmt(context.Background(), "SELECT 1")
+		require.NoError(t, err)
+
+		mock.ExpectPrepare("SELECT 2").WillDelayFor(time.Second)
+		go func() { _, _ = cache.Stmt(context.Background(), "SELECT 2") }()
+		time.Sleep(10 * time.Millisecond)
+
+		start := time.Now()
+		_, err = cache.Stmt(context.Background(), "SELECT 1")
+		require.NoError(t, err)
+		require.Less(t, int64(time.Since(start)), int64(100*time.Millisecond))
+	})
+
+	t.Run("Failed prepare is retried", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		defer db.Close()
+		cache := NewStmtCache(sqlx.NewDb(db, "sqlmock"))
+
+		mock.ExpectPrepare("SELECT 1").WillReturnError(context.DeadlineExceeded)
+		mock.ExpectPrepare("SELECT 1")
+
+		_, err = cache.Stmt(context.Background(), "SELECT 1")
+		require.Error(t, err)
+		stmt, err := cache.Stmt(context.Background(), "SELECT 1")
+		require.NoError(t, err)
+		require.NotNil(t, stmt)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
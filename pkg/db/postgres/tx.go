@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+const (
+	txMaxAttempts  = 3
+	txRetryBackoff = 20 * time.Millisecond
+
+	serializationFailure = "40001"
+	deadlockDetected     = "40P01"
+)
+
+// Run fn inside a transaction of pool default isolation level, commit on success, rollback on error or panic.
+// Serialization failures and deadlocks re-run the whole transaction with backoff, up to txMaxAttempts times
+func WithTx(ctx context.Context, db *sqlx.DB, fn func(tx *sqlx.Tx) error) error {
+	return WithTxIsolation(ctx, db, sql.LevelDefault, fn)
+}
+
+// Same as WithTx with given isolation level, sql.LevelDefault keeps pool default
+func WithTxIsolation(ctx context.Context, db *sqlx.DB, level sql.IsolationLevel, fn func(tx *sqlx.Tx) error) error {
+	opts := &sql.TxOptions{Isolation: level}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = runTx(ctx, db, opts, fn); err == nil || !isRetryableTxError(err) || attempt == txMaxAttempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "postgres.WithTx.Retry: %v", err)
+		case <-time.After(txRetryBackoff * time.Duration(1<<(attempt-1))):
+		}
+	}
+}
+
+func runTx(ctx context.Context, db *sqlx.DB, opts *sql.TxOptions, fn func(tx *sqlx.Tx) error) error {
+	tx, err := db.BeginTxx(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "postgres.WithTx.BeginTxx")
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err = fn(tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return errors.Wrapf(err, "postgres.WithTx.Rollback: %v", rbErr)
+		}
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "postgres.WithTx.Commit")
+	}
+	return nil
+}
+
+func isRetryableTxError(err error) bool {
+	code := SQLState(err)
+	return code == serializationFailure || code == deadlockDetected
+}
@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"sync"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithTx_Retry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Serialization failure retried", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		calls := 0
+		err = WithTx(context.Background(), sqlxDB, func(tx *sqlx.Tx) error {
+			calls++
+			if calls == 1 {
+				return errors.Wrap(pgx.PgError{Severity: "ERROR", Code: serializationFailure}, "update")
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, calls)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Non retryable error propagated", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		calls := 0
+		uniqueViolation := pgx.PgError{Severity: "ERROR", Code: "23505"}
+		err = WithTx(context.Background(), sqlxDB, func(tx *sqlx.Tx) error {
+			calls++
+			return uniqueViolation
+		})
+		require.Equal(t, uniqueViolation, err)
+		require.Equal(t, 1, calls)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Attempts bounded", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		sqlxDB := sqlx.NewDb(db, "sqlmock")
+
+		for i := 0; i < txMaxAttempts; i++ {
+			mock.ExpectBegin()
+			mock.ExpectRollback()
+		}
+
+		calls := 0
+		err = WithTx(context.Background(), sqlxDB, func(tx *sqlx.Tx) error {
+			calls++
+			return pgx.PgError{Severity: "ERROR", Code: deadlockDetected}
+		})
+		require.Error(t, err)
+		require.Equal(t, txMaxAttempts, calls)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+// Driver connection recording options of every begun transaction, sqlmock ignores them
+type txRecorder struct {
+	mu     sync.Mutex
+	levels []sql.IsolationLevel
+}
+
+func (r *txRecorder) Connect(context.Context) (driver.Conn, error) { return r, nil }
+func (r *txRecorder) Driver() driver.Driver                        { return r }
+func (r *txRecorder) Open(string) (driver.Conn, error)             { return r, nil }
+func (r *txRecorder) Prepare(string) (driver.Stmt, error)          { return nil, errors.New("not supported") }
+func (r *txRecorder) Close() error                                 { return nil }
+func (r *txRecorder) Begin() (driver.Tx, error)                    { return r, nil }
+func (r *txRecorder) Commit() error                                { return nil }
+func (r *txRecorder) Rollback() error                              { return nil }
+
+func (r *txRecorder) BeginTx(_ context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.levels = append(r.levels, sql.IsolationLevel(opts.Isolation))
+	return r, nil
+}
+
+func TestWithTxIsolation(t *testing.T) {
+	t.Parallel()
+
+	recorder := &txRecorder{}
+	db := sql.OpenDB(recorder)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "postgres")
+
+	noop := func(tx *sqlx.Tx) error { return nil }
+	require.NoError(t, WithTx(context.Background(), sqlxDB, noop))
+	require.NoError(t, WithTxIsolation(context.Background(), sqlxDB, sql.LevelReadCommitted, noop))
+	require.NoError(t, WithTxIsolation(context.Background(), sqlxDB, sql.LevelSerializable, noop))
+
+	require.Equal(t, []sql.IsolationLevel{sql.LevelDefault, sql.LevelReadCommitted, sql.LevelSerializable}, recorder.levels)
+}
@@ -0,0 +1,69 @@
+package feed
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testFeed() *Feed {
+	published := time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)
+	return &Feed{
+		Title:       "News: golang",
+		Link:        "https://example.com/api/v1/news",
+		Description: "Recently published news",
+		Updated:     published,
+		Items: []*Item{
+			{
+				ID:          "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+				Title:       `Tom & Jerry <say> "hi"`,
+				Link:        "https://example.com/api/v1/news/6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+				Description: "<p>content</p>",
+				Category:    "golang",
+				Published:   published,
+				Updated:     published,
+			},
+		},
+	}
+}
+
+func TestRenderRSS(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := RenderRSS(testFeed())
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(rendered), xml.Header))
+	require.Contains(t, string(rendered), "Tom &amp; Jerry &lt;say&gt;")
+	require.NotContains(t, string(rendered), "<say>")
+
+	doc := &rss{}
+	require.NoError(t, xml.Unmarshal(rendered, doc))
+	require.Equal(t, "2.0", doc.Version)
+	require.Equal(t, "News: golang", doc.Channel.Title)
+	require.Len(t, doc.Channel.Items, 1)
+	require.Equal(t, `Tom & Jerry <say> "hi"`, doc.Channel.Items[0].Title)
+	require.Equal(t, "<p>content</p>", doc.Channel.Items[0].Description)
+	require.Equal(t, "Wed, 03 Feb 2021 04:05:06 +0000", doc.Channel.Items[0].PubDate)
+	require.False(t, doc.Channel.Items[0].GUID.IsPermaLink)
+}
+
+func TestRenderAtom(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := RenderAtom(testFeed())
+	require.NoError(t, err)
+	require.Contains(t, string(rendered), `<feed xmlns="http://www.w3.org/2005/Atom">`)
+	require.Contains(t, string(rendered), "Tom &amp; Jerry &lt;say&gt;")
+
+	doc := &atom{}
+	require.NoError(t, xml.Unmarshal(rendered, doc))
+	require.Equal(t, "2021-02-03T04:05:06Z", doc.Updated)
+	require.Len(t, doc.Entries, 1)
+	require.Equal(t, `Tom & Jerry <say> "hi"`, doc.Entries[0].Title)
+	require.Equal(t, "2021-02-03T04:05:06Z", doc.Entries[0].Published)
+	require.Equal(t, "golang", doc.Entries[0].Category.Term)
+	require.Equal(t, "html", doc.Entries[0].Summary.Type)
+}
@@ -8,17 +8,25 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/AleksK1NG/api-mc/pkg/db/postgres"
 )
 
+// Non standard status used when client went away before response was written
+const StatusClientClosedRequest = 499
+
 const (
-	ErrBadRequest         = "Bad request"
-	ErrEmailAlreadyExists = "User with given email already exists"
-	ErrNoSuchUser         = "User not found"
-	ErrWrongCredentials   = "Wrong Credentials"
-	ErrNotFound           = "Not Found"
-	ErrUnauthorized       = "Unauthorized"
-	ErrForbidden          = "Forbidden"
-	ErrBadQueryParams     = "Invalid query params"
+	ErrBadRequest           = "Bad request"
+	ErrEmailAlreadyExists   = "User with given email already exists"
+	ErrNoSuchUser           = "User not found"
+	ErrWrongCredentials     = "Wrong Credentials"
+	ErrNotFound             = "Not Found"
+	ErrUnauthorized         = "Unauthorized"
+	ErrForbidden            = "Forbidden"
+	ErrBadQueryParams       = "Invalid query params"
+	ErrUnsupportedMediaType = "Unsupported Media Type"
 )
 
 var (
@@ -35,11 +43,32 @@ var (
 	BadQueryParams        = errors.New("Invalid query params")
 	InternalServerError   = errors.New("Internal Server Error")
 	RequestTimeoutError   = errors.New("Request Timeout")
+	ClientClosedRequest   = errors.New("Client Closed Request")
+	GatewayTimeoutError   = errors.New("Gateway Timeout")
+	ServerBusy            = errors.New("Server is busy, try again later")
 	ExistsEmailError      = errors.New("User with given email already exists")
 	InvalidJWTToken       = errors.New("Invalid JWT token")
 	InvalidJWTClaims      = errors.New("Invalid JWT claims")
 	NotAllowedImageHeader = errors.New("Not allowed image header")
 	NoCookie              = errors.New("not found cookie header")
+	InvalidUUIDParam      = errors.New("Invalid uuid param")
+	InvalidUUIDField      = errors.New("Invalid uuid field")
+	UnsupportedMediaType  = errors.New("Content-Type must be application/json")
+	ErrDeepPagination     = errors.New("Result window is too large, use cursor pagination for deep pages")
+	ErrTooManyTags        = errors.New("Too many tags")
+	InvalidPreviewToken   = errors.New("Invalid preview token")
+	ExpiredPreviewToken   = errors.New("Expired preview token")
+	InvalidTimezone       = errors.New("Invalid timezone")
+	DuplicateContent      = errors.New("News with same content already exists")
+	UnknownQueryParams    = errors.New("Unknown query params")
+	DuplicateRelation     = errors.New("News are already related")
+	ErrEmptySearchQuery   = errors.New("Search query is empty")
+	ErrInvalidEncoding    = errors.New("Text is not valid UTF-8")
+	EditLocked            = errors.New("News is being edited by another user")
+	FileTooLarge          = errors.New("File is too large")
+	ImmutableField        = errors.New("Field can not be changed")
+	InvalidDeadline       = errors.New("Invalid request deadline")
+	BatchTooLarge         = errors.New("Batch is too large, split it into smaller chunks")
 )
 
 // Rest error interface
@@ -54,6 +83,16 @@ type RestError struct {
 	ErrStatus int         `json:"status,omitempty"`
 	ErrError  string      `json:"error,omitempty"`
 	ErrCauses interface{} `json:"-"`
+	// Every failed field of validation error
+	ErrDetails []FieldError `json:"details,omitempty"`
+}
+
+// Single failed field of validation error
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
 }
 
 // Error  Error() interface method
@@ -107,6 +146,16 @@ func NewBadRequestError(causes interface{}) RestErr {
 	}
 }
 
+// New Validation Error, details list every failed field of validator error found in err chain
+func NewValidationError(err error) RestErr {
+	return RestError{
+		ErrStatus:  http.StatusBadRequest,
+		ErrError:   BadRequest.Error(),
+		ErrCauses:  err,
+		ErrDetails: validationDetails(err),
+	}
+}
+
 // New Not Found Error
 func NewNotFoundError(causes interface{}) RestErr {
 	return RestError{
@@ -147,12 +196,36 @@ func NewInternalServerError(causes interface{}) RestErr {
 // Parser of error string messages returns RestError
 func ParseErrors(err error) RestErr {
 	switch {
+	case errors.Is(err, context.Canceled):
+		return NewRestError(StatusClientClosedRequest, ClientClosedRequest.Error(), err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return NewRestError(http.StatusGatewayTimeout, GatewayTimeoutError.Error(), err)
+	case errors.Is(err, postgres.ErrServerBusy):
+		return NewRestError(http.StatusServiceUnavailable, ServerBusy.Error(), err)
+	case errors.Is(err, postgres.ErrBatchTooLarge):
+		return NewRestError(http.StatusBadRequest, BatchTooLarge.Error(), err)
 	case errors.Is(err, sql.ErrNoRows):
 		return NewRestError(http.StatusNotFound, NotFound.Error(), err)
-	case errors.Is(err, context.DeadlineExceeded):
-		return NewRestError(http.StatusRequestTimeout, RequestTimeoutError.Error(), err)
-	case strings.Contains(err.Error(), "SQLSTATE"):
-		return parseSqlErrors(err)
+	case errors.Is(err, ErrDeepPagination):
+		return NewRestError(http.StatusBadRequest, ErrDeepPagination.Error(), err)
+	case errors.Is(err, ErrEmptySearchQuery):
+		return NewRestError(http.StatusBadRequest, ErrEmptySearchQuery.Error(), err)
+	case errors.Is(err, ErrInvalidEncoding):
+		return NewRestError(http.StatusBadRequest, ErrInvalidEncoding.Error(), err)
+	case errors.Is(err, ErrTooManyTags):
+		return NewRestError(http.StatusUnprocessableEntity, ErrTooManyTags.Error(), err)
+	case errors.Is(err, FileTooLarge):
+		return NewRestError(http.StatusRequestEntityTooLarge, FileTooLarge.Error(), err)
+	case errors.Is(err, NotAllowedImageHeader):
+		return NewRestError(http.StatusUnsupportedMediaType, NotAllowedImageHeader.Error(), err)
+	case errors.Is(err, EditLocked):
+		return NewRestError(http.StatusConflict, EditLocked.Error(), err)
+	case errors.Is(err, DuplicateRelation):
+		return NewRestError(http.StatusConflict, DuplicateRelation.Error(), err)
+	case errors.Is(err, InvalidPreviewToken), errors.Is(err, ExpiredPreviewToken):
+		return NewRestError(http.StatusForbidden, Forbidden.Error(), err)
+	case postgres.SQLState(err) != "":
+		return parseSqlErrors(err, postgres.SQLState(err))
 	case strings.Contains(err.Error(), "Field validation"):
 		return parseValidatorError(err)
 	case strings.Contains(err.Error(), "Unmarshal"):
@@ -173,24 +246,60 @@ func ParseErrors(err error) RestErr {
 	}
 }
 
-func parseSqlErrors(err error) RestErr {
-	if strings.Contains(err.Error(), "23505") {
+func parseSqlErrors(err error, code string) RestErr {
+	switch code {
+	case postgres.QueryCanceled:
+		if strings.Contains(err.Error(), "statement timeout") {
+			return NewRestError(http.StatusGatewayTimeout, GatewayTimeoutError.Error(), err)
+		}
+		return NewRestError(StatusClientClosedRequest, ClientClosedRequest.Error(), err)
+	case postgres.UniqueViolation:
+		if strings.Contains(err.Error(), "news_content_hash_uidx") {
+			return NewRestError(http.StatusConflict, DuplicateContent.Error(), err)
+		}
 		return NewRestError(http.StatusBadRequest, ExistsEmailError.Error(), err)
+	default:
+		return NewRestError(http.StatusBadRequest, BadRequest.Error(), err)
 	}
-
-	return NewRestError(http.StatusBadRequest, BadRequest.Error(), err)
 }
 
 func parseValidatorError(err error) RestErr {
-	if strings.Contains(err.Error(), "Password") {
-		return NewRestError(http.StatusBadRequest, "Invalid password, min length 6", err)
+	if restErr, ok := err.(RestError); ok && len(restErr.ErrDetails) > 0 {
+		return restErr
 	}
 
-	if strings.Contains(err.Error(), "Email") {
-		return NewRestError(http.StatusBadRequest, "Invalid email", err)
+	restErr := RestError{ErrStatus: http.StatusBadRequest, ErrError: BadRequest.Error(), ErrCauses: err, ErrDetails: validationDetails(err)}
+	switch message := strings.ToLower(err.Error()); {
+	case strings.Contains(message, "password"):
+		restErr.ErrError = "Invalid password, min length 6"
+	case strings.Contains(message, "email"):
+		restErr.ErrError = "Invalid email"
 	}
+	return restErr
+}
+
+// Every failed field of validator error in err chain, nil when err is not validation error
+func validationDetails(err error) []FieldError {
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return nil
+	}
+
+	details := make([]FieldError, 0, len(validationErrs))
+	for _, fe := range validationErrs {
+		message := fmt.Sprintf("%s failed on %s rule", fe.Field(), fe.Tag())
+		if fe.Param() != "" {
+			message = fmt.Sprintf("%s failed on %s=%s rule", fe.Field(), fe.Tag(), fe.Param())
+		}
+		details = append(details, FieldError{Field: fe.Field(), Rule: fe.Tag(), Param: fe.Param(), Message: message})
+	}
+	return details
+}
 
-	return NewRestError(http.StatusBadRequest, BadRequest.Error(), err)
+// Check is error caused by cancelled request or expired deadline rather than by failure worth alerting on
+func IsContextError(err error) bool {
+	status := ParseErrors(err).Status()
+	return status == StatusClientClosedRequest || status == http.StatusGatewayTimeout
 }
 
 // Error response
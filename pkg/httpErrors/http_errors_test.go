@@ -0,0 +1,121 @@
+package httpErrors
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/pkg/db/postgres"
+)
+
+func TestParseErrors_Context(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Cancelled", func(t *testing.T) {
+		err := fmt.Errorf("newsRepo.GetNews: %w", context.Canceled)
+		require.Equal(t, StatusClientClosedRequest, ParseErrors(err).Status())
+		require.True(t, IsContextError(err))
+	})
+
+	t.Run("Deadline exceeded", func(t *testing.T) {
+		err := fmt.Errorf("newsRepo.GetNews: %w", context.DeadlineExceeded)
+		require.Equal(t, http.StatusGatewayTimeout, ParseErrors(err).Status())
+		require.True(t, IsContextError(err))
+	})
+
+	t.Run("Query canceled by postgres", func(t *testing.T) {
+		err := fmt.Errorf("ERROR: canceling statement due to user request (SQLSTATE 57014)")
+		require.Equal(t, StatusClientClosedRequest, ParseErrors(err).Status())
+
+		err = fmt.Errorf("ERROR: canceling statement due to statement timeout (SQLSTATE 57014)")
+		require.Equal(t, http.StatusGatewayTimeout, ParseErrors(err).Status())
+	})
+
+	t.Run("Other errors", func(t *testing.T) {
+		require.False(t, IsContextError(sql.ErrNoRows))
+		require.False(t, IsContextError(fmt.Errorf("boom")))
+	})
+}
+
+func TestParseErrors_UniqueViolation(t *testing.T) {
+	t.Parallel()
+
+	err := fmt.Errorf(`ERROR: duplicate key value violates unique constraint "news_content_hash_uidx" (SQLSTATE 23505)`)
+	require.Equal(t, http.StatusConflict, ParseErrors(err).Status())
+
+	err = fmt.Errorf(`ERROR: duplicate key value violates unique constraint "users_email_key" (SQLSTATE 23505)`)
+	require.Equal(t, http.StatusBadRequest, ParseErrors(err).Status())
+}
+
+// Same shape as lib/pq error, message has no SQLSTATE and code is exposed through method
+type pqError struct {
+	Code    string
+	Message string
+}
+
+func (e *pqError) Error() string {
+	return "pq: " + e.Message
+}
+
+func (e *pqError) SQLState() string {
+	return e.Code
+}
+
+func TestParseErrors_DriverErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pgx", func(t *testing.T) {
+		err := fmt.Errorf("newsRepo.Create: %w", pgx.PgError{Severity: "ERROR", Code: "23505", Message: `duplicate key value violates unique constraint "news_content_hash_uidx"`})
+		require.Equal(t, http.StatusConflict, ParseErrors(err).Status())
+
+		err = fmt.Errorf("newsRepo.Create: %w", &pgx.PgError{Severity: "ERROR", Code: "23503", Message: "violates foreign key constraint"})
+		require.Equal(t, http.StatusBadRequest, ParseErrors(err).Status())
+	})
+
+	t.Run("lib/pq", func(t *testing.T) {
+		err := fmt.Errorf("newsRepo.Create: %w", &pqError{Code: "23505", Message: `duplicate key value violates unique constraint "news_content_hash_uidx"`})
+		require.Equal(t, http.StatusConflict, ParseErrors(err).Status())
+
+		err = fmt.Errorf("authRepo.Register: %w", &pqError{Code: "23505", Message: `duplicate key value violates unique constraint "users_email_key"`})
+		require.Equal(t, http.StatusBadRequest, ParseErrors(err).Status())
+		require.Contains(t, ParseErrors(err).Error(), ExistsEmailError.Error())
+
+		err = fmt.Errorf("newsRepo.GetNews: %w", &pqError{Code: "57014", Message: "canceling statement due to statement timeout"})
+		require.Equal(t, http.StatusGatewayTimeout, ParseErrors(err).Status())
+	})
+}
+
+func TestParseErrors_ValidationDetails(t *testing.T) {
+	t.Parallel()
+
+	type login struct {
+		Email    string `json:"email" validate:"required,email"`
+		Password string `json:"password" validate:"required,gte=6"`
+	}
+	err := validator.New().Struct(&login{Email: "nope", Password: "123"})
+
+	restErr, ok := ParseErrors(err).(RestError)
+	require.True(t, ok)
+	require.Equal(t, http.StatusBadRequest, restErr.Status())
+	require.Equal(t, "Invalid password, min length 6", restErr.ErrError)
+	require.Len(t, restErr.ErrDetails, 2)
+	require.Equal(t, "email", restErr.ErrDetails[0].Rule)
+	require.Equal(t, "gte", restErr.ErrDetails[1].Rule)
+	require.Equal(t, "6", restErr.ErrDetails[1].Param)
+
+	require.Empty(t, ParseErrors(fmt.Errorf("boom")).(RestError).ErrDetails)
+}
+
+func TestParseErrors_ServerBusy(t *testing.T) {
+	t.Parallel()
+
+	err := fmt.Errorf("newsRepo.GetNews: %w", postgres.ErrServerBusy)
+	require.Equal(t, http.StatusServiceUnavailable, ParseErrors(err).Status())
+	require.Contains(t, ParseErrors(err).Error(), ServerBusy.Error())
+}
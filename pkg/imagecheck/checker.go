@@ -0,0 +1,111 @@
+package imagecheck
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// HTTP client used for checks, *http.Client satisfies it
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Image url checker, sends HEAD request to every url with bounded concurrency and request rate
+type Checker struct {
+	client      HTTPClient
+	timeout     time.Duration
+	concurrency int
+	// Pause between request starts, zero is unlimited
+	interval time.Duration
+}
+
+// Image url checker constructor, concurrency below one checks urls one by one
+func NewChecker(client HTTPClient, timeout time.Duration, concurrency int, requestsPerSecond float64) *Checker {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	var interval time.Duration
+	if requestsPerSecond > 0 {
+		interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+	return &Checker{client: client, timeout: timeout, concurrency: concurrency, interval: interval}
+}
+
+// Check urls and return broken flag of every checked url.
+// Urls not checked before ctx is done are missing from result, so they are not reported either way
+func (c *Checker) Check(ctx context.Context, urls []string) map[string]bool {
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		result = make(map[string]bool, len(urls))
+		slots  = make(chan struct{}, c.concurrency)
+	)
+
+	var tick <-chan time.Time
+	if c.interval > 0 {
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for i, url := range urls {
+		if tick != nil && i > 0 {
+			select {
+			case <-tick:
+			case <-ctx.Done():
+			}
+		}
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(url string) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			broken, ok := c.isBroken(ctx, url)
+			if !ok {
+				return
+			}
+			mu.Lock()
+			result[url] = broken
+			mu.Unlock()
+		}(url)
+	}
+	wg.Wait()
+
+	return result
+}
+
+// Url is broken when request fails or answers with error status, servers refusing HEAD are given benefit of the doubt.
+// Second value is false when check was cut by ctx and has no result
+func (c *Checker) isBroken(ctx context.Context, url string) (bool, bool) {
+	reqCtx := ctx
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		reqCtx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodHead, url, nil)
+	if err != nil {
+		return true, true
+	}
+	res, err := c.client.Do(req)
+	if err != nil {
+		return true, ctx.Err() == nil
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusMethodNotAllowed {
+		return false, true
+	}
+	return res.StatusCode >= http.StatusBadRequest, true
+}
@@ -0,0 +1,122 @@
+package imagecheck
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClient struct {
+	mu       sync.Mutex
+	statuses map[string]int
+	methods  []string
+	inFlight int
+	maxIn    int
+	delay    time.Duration
+}
+
+func (f *fakeClient) Do(req *http.Request) (*http.Response, error) {
+	f.mu.Lock()
+	f.methods = append(f.methods, req.Method)
+	f.inFlight++
+	if f.inFlight > f.maxIn {
+		f.maxIn = f.inFlight
+	}
+	status, ok := f.statuses[req.URL.String()]
+	f.mu.Unlock()
+
+	defer func() {
+		f.mu.Lock()
+		f.inFlight--
+		f.mu.Unlock()
+	}()
+
+	if f.delay > 0 {
+		select {
+		case <-time.After(f.delay):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return &http.Response{StatusCode: status, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+}
+
+func TestChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Ok and not found", func(t *testing.T) {
+		client := &fakeClient{statuses: map[string]int{
+			"http://images.test/ok.png":      http.StatusOK,
+			"http://images.test/missing.png": http.StatusNotFound,
+			"http://images.test/no-head.png": http.StatusMethodNotAllowed,
+		}}
+		checker := NewChecker(client, time.Second, 2, 0)
+
+		result := checker.Check(context.Background(), []string{
+			"http://images.test/ok.png",
+			"http://images.test/missing.png",
+			"http://images.test/no-head.png",
+			"http://unknown.test/image.png",
+		})
+		require.Equal(t, map[string]bool{
+			"http://images.test/ok.png":      false,
+			"http://images.test/missing.png": true,
+			"http://images.test/no-head.png": false,
+			"http://unknown.test/image.png":  true,
+		}, result)
+		require.Equal(t, []string{http.MethodHead, http.MethodHead, http.MethodHead, http.MethodHead}, client.methods)
+	})
+
+	t.Run("Timeout is broken", func(t *testing.T) {
+		client := &fakeClient{statuses: map[string]int{"http://images.test/slow.png": http.StatusOK}, delay: time.Second}
+		checker := NewChecker(client, 10*time.Millisecond, 1, 0)
+
+		result := checker.Check(context.Background(), []string{"http://images.test/slow.png"})
+		require.Equal(t, map[string]bool{"http://images.test/slow.png": true}, result)
+	})
+
+	t.Run("Concurrency is bounded", func(t *testing.T) {
+		urls := make([]string, 0, 10)
+		statuses := make(map[string]int, 10)
+		for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+			url := "http://images.test/" + name + ".png"
+			urls = append(urls, url)
+			statuses[url] = http.StatusOK
+		}
+		client := &fakeClient{statuses: statuses, delay: 10 * time.Millisecond}
+		checker := NewChecker(client, time.Second, 3, 0)
+
+		result := checker.Check(context.Background(), urls)
+		require.Len(t, result, 10)
+		require.LessOrEqual(t, client.maxIn, 3)
+	})
+
+	t.Run("Rate limited", func(t *testing.T) {
+		client := &fakeClient{statuses: map[string]int{"http://images.test/ok.png": http.StatusOK}}
+		checker := NewChecker(client, time.Second, 4, 50)
+
+		start := time.Now()
+		checker.Check(context.Background(), []string{"http://images.test/ok.png", "http://images.test/ok.png", "http://images.test/ok.png"})
+		require.GreaterOrEqual(t, int64(time.Since(start)), int64(40*time.Millisecond))
+	})
+
+	t.Run("Cancelled urls are not reported", func(t *testing.T) {
+		client := &fakeClient{statuses: map[string]int{"http://images.test/ok.png": http.StatusOK}}
+		checker := NewChecker(client, time.Second, 1, 0)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		result := checker.Check(ctx, []string{"http://images.test/ok.png"})
+		require.Empty(t, result)
+	})
+}
@@ -0,0 +1,74 @@
+package localcache
+
+import (
+	"sync"
+	"time"
+)
+
+type entry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// In memory cache of one instance, entries expire after ttl. Keys are evicted across instances by Invalidator
+type Cache struct {
+	mu         sync.RWMutex
+	entries    map[string]entry
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+}
+
+// Local cache constructor, Set is skipped once maxEntries live entries are stored
+func NewCache(ttl time.Duration, maxEntries int) *Cache {
+	return &Cache{entries: make(map[string]entry), ttl: ttl, maxEntries: maxEntries, now: time.Now}
+}
+
+// Get value by key, expired entries are misses
+func (c *Cache) Get(key string) ([]byte, bool) {
+	c.mu.RLock()
+	e, ok := c.entries[key]
+	c.mu.RUnlock()
+	if !ok || !c.now().Before(e.expiresAt) {
+		return nil, false
+	}
+	return e.value, true
+}
+
+// Store value by key for cache ttl
+func (c *Cache) Set(key string, value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+		c.dropExpired()
+		if len(c.entries) >= c.maxEntries {
+			return
+		}
+	}
+	c.entries[key] = entry{value: value, expiresAt: c.now().Add(c.ttl)}
+}
+
+// Delete keys, returns number of keys which were present
+func (c *Cache) Delete(keys ...string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	deleted := 0
+	for _, key := range keys {
+		if _, ok := c.entries[key]; ok {
+			delete(c.entries, key)
+			deleted++
+		}
+	}
+	return deleted
+}
+
+func (c *Cache) dropExpired() {
+	now := c.now()
+	for key, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package localcache
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/config"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+)
+
+// Redis channel stand in delivering every published message to all subscribers
+type fanOutPublisher struct {
+	mu          sync.Mutex
+	subscribers []chan *redis.Message
+}
+
+func (p *fanOutPublisher) subscribe() chan *redis.Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	ch := make(chan *redis.Message, 10)
+	p.subscribers = append(p.subscribers, ch)
+	return ch
+}
+
+func (p *fanOutPublisher) Publish(ctx context.Context, channel string, message interface{}) *redis.IntCmd {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, ch := range p.subscribers {
+		ch <- &redis.Message{Channel: channel, Payload: string(message.([]byte))}
+	}
+	return redis.NewIntResult(int64(len(p.subscribers)), nil)
+}
+
+func testLogger() logger.Logger {
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+	return apiLogger
+}
+
+func TestCache_TTL(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	c := NewCache(time.Minute, 10)
+	c.now = func() time.Time { return now }
+
+	c.Set("key", []byte("value"))
+	value, ok := c.Get("key")
+	require.True(t, ok)
+	require.Equal(t, []byte("value"), value)
+
+	now = now.Add(time.Minute)
+	_, ok = c.Get("key")
+	require.False(t, ok)
+}
+
+func TestCache_MaxEntries(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	c := NewCache(time.Minute, 1)
+	c.now = func() time.Time { return now }
+
+	c.Set("first", []byte("1"))
+	c.Set("second", []byte("2"))
+	_, ok := c.Get("second")
+	require.False(t, ok)
+
+	now = now.Add(time.Minute)
+	c.Set("second", []byte("2"))
+	_, ok = c.Get("second")
+	require.True(t, ok)
+}
+
+func TestInvalidator_TwoInstances(t *testing.T) {
+	t.Parallel()
+
+	publisher := &fanOutPublisher{}
+	log := testLogger()
+
+	cacheA := NewCache(time.Minute, 10)
+	cacheB := NewCache(time.Minute, 10)
+	invalidatorA := NewInvalidator(cacheA, publisher, "", log)
+	invalidatorB := NewInvalidator(cacheB, publisher, "", log)
+	messagesA := publisher.subscribe()
+	messagesB := publisher.subscribe()
+
+	cacheA.Set("key", []byte("value"))
+	cacheB.Set("key", []byte("value"))
+
+	err := invalidatorA.Invalidate(context.Background(), "key")
+	require.NoError(t, err)
+	_, ok := cacheA.Get("key")
+	require.False(t, ok)
+
+	msg := <-messagesB
+	require.Equal(t, DefaultChannel, msg.Channel)
+	deleted, err := invalidatorB.handle(msg)
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+	_, ok = cacheB.Get("key")
+	require.False(t, ok)
+
+	// own event is skipped, value cached after Invalidate is kept
+	cacheA.Set("key", []byte("new value"))
+	deleted, err = invalidatorA.handle(<-messagesA)
+	require.NoError(t, err)
+	require.Equal(t, 0, deleted)
+	_, ok = cacheA.Get("key")
+	require.True(t, ok)
+}
+
+func TestInvalidator_Listen(t *testing.T) {
+	t.Parallel()
+
+	publisher := &fanOutPublisher{}
+	log := testLogger()
+
+	cacheA := NewCache(time.Minute, 10)
+	cacheB := NewCache(time.Minute, 10)
+	invalidatorA := NewInvalidator(cacheA, publisher, "", log)
+	invalidatorB := NewInvalidator(cacheB, publisher, "", log)
+	messagesB := publisher.subscribe()
+
+	cacheB.Set("key", []byte("value"))
+	require.NoError(t, invalidatorA.Invalidate(context.Background(), "key"))
+	close(messagesB)
+	invalidatorB.Listen(messagesB)
+
+	_, ok := cacheB.Get("key")
+	require.False(t, ok)
+}
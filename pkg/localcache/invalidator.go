@@ -0,0 +1,86 @@
+package localcache
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+)
+
+// Default redis channel of invalidation events
+const DefaultChannel = "api-cache:invalidate"
+
+// Publisher of invalidation events, *redis.Client implements it
+type Publisher interface {
+	Publish(ctx context.Context, channel string, message interface{}) *redis.IntCmd
+}
+
+type invalidation struct {
+	Origin string   `json:"origin"`
+	Keys   []string `json:"keys"`
+}
+
+// Keeps local caches of all instances consistent: keys invalidated on one instance are published
+// to redis channel and evicted by every other instance subscribed to it
+type Invalidator struct {
+	cache      *Cache
+	publisher  Publisher
+	channel    string
+	instanceID string
+	logger     logger.Logger
+}
+
+// Invalidator constructor, every invalidator gets own instance id to recognize own events
+func NewInvalidator(cache *Cache, publisher Publisher, channel string, logger logger.Logger) *Invalidator {
+	if channel == "" {
+		channel = DefaultChannel
+	}
+	return &Invalidator{cache: cache, publisher: publisher, channel: channel, instanceID: uuid.New().String(), logger: logger}
+}
+
+// Redis channel of invalidation events
+func (i *Invalidator) Channel() string {
+	return i.channel
+}
+
+// Evict keys locally and publish event for other instances
+func (i *Invalidator) Invalidate(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	i.cache.Delete(keys...)
+
+	payload, err := json.Marshal(&invalidation{Origin: i.instanceID, Keys: keys})
+	if err != nil {
+		return errors.Wrap(err, "Invalidator.Invalidate.json.Marshal")
+	}
+	if err = i.publisher.Publish(ctx, i.channel, payload).Err(); err != nil {
+		return errors.Wrap(err, "Invalidator.Invalidate.Publish")
+	}
+	return nil
+}
+
+// Evict keys of events received from subscription until messages channel is closed
+func (i *Invalidator) Listen(messages <-chan *redis.Message) {
+	for msg := range messages {
+		if _, err := i.handle(msg); err != nil {
+			i.logger.Errorf("Invalidator.Listen: %v", err)
+		}
+	}
+}
+
+// Evict keys of event, own events are skipped since keys were already evicted by Invalidate
+func (i *Invalidator) handle(msg *redis.Message) (int, error) {
+	var event invalidation
+	if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+		return 0, errors.Wrap(err, "Invalidator.handle.json.Unmarshal")
+	}
+	if event.Origin == i.instanceID {
+		return 0, nil
+	}
+	return i.cache.Delete(event.Keys...), nil
+}
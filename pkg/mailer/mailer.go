@@ -0,0 +1,64 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Plain text email
+type Message struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+// Delivers single message, *SMTPSender implements it
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// Sender delivering messages through SMTP server
+type SMTPSender struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// SMTP sender constructor, empty username sends without authentication
+func NewSMTPSender(host string, port int, username string, password string, from string) *SMTPSender {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &SMTPSender{addr: net.JoinHostPort(host, strconv.Itoa(port)), from: from, auth: auth}
+}
+
+// Send message, net/smtp has no context support so ctx is checked only before dialing
+func (s *SMTPSender) Send(ctx context.Context, msg *Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := smtp.SendMail(s.addr, s.auth, s.from, msg.To, s.build(msg)); err != nil {
+		return errors.Wrap(err, "SMTPSender.Send.SendMail")
+	}
+	return nil
+}
+
+func (s *SMTPSender) build(msg *Message) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", s.from)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(msg.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
@@ -0,0 +1,121 @@
+package mailer
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+)
+
+const (
+	defaultQueueSize    = 100
+	defaultRetryBackoff = time.Second
+	defaultSendTimeout  = 30 * time.Second
+)
+
+// Enqueue failed because queue has no free room or is closed
+var ErrQueueFull = errors.New("mail queue is full")
+
+// Asynchronous mail queue, workers send enqueued messages in background and retry transient failures
+type Queue struct {
+	sender     Sender
+	messages   chan *Message
+	maxRetries int
+	backoff    time.Duration
+	logger     logger.Logger
+	mu         sync.RWMutex
+	closed     bool
+	wg         sync.WaitGroup
+}
+
+// Mail queue constructor, starts workers right away. Zero size and backoff use defaults, workers below one start single worker
+func NewQueue(sender Sender, size int, workers int, maxRetries int, backoff time.Duration, logger logger.Logger) *Queue {
+	if size <= 0 {
+		size = defaultQueueSize
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	if backoff <= 0 {
+		backoff = defaultRetryBackoff
+	}
+
+	q := &Queue{sender: sender, messages: make(chan *Message, size), maxRetries: maxRetries, backoff: backoff, logger: logger}
+	q.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go q.work()
+	}
+	return q
+}
+
+// Put message into queue without waiting, fails with ErrQueueFull when queue is full or closed
+func (q *Queue) Enqueue(msg *Message) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.closed {
+		return ErrQueueFull
+	}
+
+	select {
+	case q.messages <- msg:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Stop accepting messages and wait until queued ones are sent
+func (q *Queue) Close() error {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return nil
+	}
+	q.closed = true
+	close(q.messages)
+	q.mu.Unlock()
+
+	q.wg.Wait()
+	return nil
+}
+
+func (q *Queue) work() {
+	defer q.wg.Done()
+	for msg := range q.messages {
+		if err := q.send(msg); err != nil {
+			q.logger.Errorf("mailer.Queue send, To: %v, Subject: %s, Error: %v", msg.To, msg.Subject, err)
+		}
+	}
+}
+
+// Send with exponential backoff between retries of transient errors
+func (q *Queue) send(msg *Message) error {
+	backoff := q.backoff
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), defaultSendTimeout)
+		err := q.sender.Send(ctx, msg)
+		cancel()
+		if err == nil || attempt >= q.maxRetries || !IsTransient(err) {
+			return err
+		}
+
+		q.logger.Warnf("mailer.Queue retry, To: %v, Attempt: %d, Error: %v", msg.To, attempt+1, err)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// Error worth retrying: network failure or 4xx SMTP reply, 5xx replies are permanent
+func IsTransient(err error) bool {
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) {
+		return protoErr.Code >= 400 && protoErr.Code < 500
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
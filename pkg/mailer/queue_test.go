@@ -0,0 +1,90 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"net/textproto"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/config"
+	"github.com/AleksK1NG/api-mc/pkg/logger"
+)
+
+type fakeSender struct {
+	mu    sync.Mutex
+	errs  []error
+	calls int
+	sent  []*Message
+}
+
+func (f *fakeSender) Send(ctx context.Context, msg *Message) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if len(f.errs) > 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+		return err
+	}
+	f.sent = append(f.sent, msg)
+	return nil
+}
+
+func TestQueue(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{Logger: config.Logger{Development: true}}
+	apiLogger := logger.NewApiLogger(cfg)
+	apiLogger.InitLogger()
+
+	msg := &Message{To: []string{"author@mail.com"}, Subject: "subject", Body: "body"}
+
+	t.Run("Retry transient errors", func(t *testing.T) {
+		sender := &fakeSender{errs: []error{&textproto.Error{Code: 421, Msg: "try later"}, &textproto.Error{Code: 451, Msg: "try later"}}}
+		queue := NewQueue(sender, 1, 1, 3, time.Millisecond, apiLogger)
+
+		require.NoError(t, queue.Enqueue(msg))
+		require.NoError(t, queue.Close())
+		require.Equal(t, 3, sender.calls)
+		require.Equal(t, []*Message{msg}, sender.sent)
+	})
+
+	t.Run("Permanent error is not retried", func(t *testing.T) {
+		sender := &fakeSender{errs: []error{&textproto.Error{Code: 550, Msg: "no such user"}}}
+		queue := NewQueue(sender, 1, 1, 3, time.Millisecond, apiLogger)
+
+		require.NoError(t, queue.Enqueue(msg))
+		require.NoError(t, queue.Close())
+		require.Equal(t, 1, sender.calls)
+		require.Empty(t, sender.sent)
+	})
+
+	t.Run("Give up after max retries", func(t *testing.T) {
+		transient := &textproto.Error{Code: 421, Msg: "try later"}
+		sender := &fakeSender{errs: []error{transient, transient, transient}}
+		queue := NewQueue(sender, 1, 1, 2, time.Millisecond, apiLogger)
+
+		require.NoError(t, queue.Enqueue(msg))
+		require.NoError(t, queue.Close())
+		require.Equal(t, 3, sender.calls)
+		require.Empty(t, sender.sent)
+	})
+
+	t.Run("Closed queue rejects messages", func(t *testing.T) {
+		queue := NewQueue(&fakeSender{}, 1, 1, 0, 0, apiLogger)
+		require.NoError(t, queue.Close())
+		require.Equal(t, ErrQueueFull, queue.Enqueue(msg))
+	})
+}
+
+func TestIsTransient(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, IsTransient(&textproto.Error{Code: 421}))
+	require.False(t, IsTransient(&textproto.Error{Code: 554}))
+	require.False(t, IsTransient(errors.New("invalid address")))
+}
@@ -13,6 +13,7 @@ import (
 type Metrics interface {
 	IncHits(status int, method, path string)
 	ObserveResponseTime(status int, method, path string, observeTime float64)
+	ObserveRequest(route, method string, status int, seconds float64)
 }
 
 // Prometheus Metrics struct
@@ -20,16 +21,43 @@ type PrometheusMetrics struct {
 	HitsTotal prometheus.Counter
 	Hits      *prometheus.CounterVec
 	Times     *prometheus.HistogramVec
+	// Handler latency by registered route pattern
+	RequestDuration *prometheus.HistogramVec
+	// Responses by status class, 2xx, 4xx and so on
+	Responses *prometheus.CounterVec
 }
 
-// Create metrics with address and name
+// Create metrics with address and name, registered in default registry and served on address
 func CreateMetrics(address string, name string) (Metrics, error) {
+	metr, err := NewPrometheusMetrics(name, prometheus.DefaultRegisterer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := prometheus.Register(prometheus.NewBuildInfoCollector()); err != nil {
+		return nil, err
+	}
+
+	go func() {
+		router := echo.New()
+		router.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
+		log.Printf("Metrics server is running on port: %s", address)
+		if err := router.Start(address); err != nil {
+			log.Fatal(err)
+		}
+	}()
+
+	return metr, nil
+}
+
+// Create app metrics and register them in registerer
+func NewPrometheusMetrics(name string, registerer prometheus.Registerer) (*PrometheusMetrics, error) {
 	var metr PrometheusMetrics
 	metr.HitsTotal = prometheus.NewCounter(prometheus.CounterOpts{
 		Name: name + "_hits_total",
 	})
 
-	if err := prometheus.Register(metr.HitsTotal); err != nil {
+	if err := registerer.Register(metr.HitsTotal); err != nil {
 		return nil, err
 	}
 
@@ -40,7 +68,7 @@ func CreateMetrics(address string, name string) (Metrics, error) {
 		[]string{"status", "method", "path"},
 	)
 
-	if err := prometheus.Register(metr.Hits); err != nil {
+	if err := registerer.Register(metr.Hits); err != nil {
 		return nil, err
 	}
 
@@ -51,22 +79,34 @@ func CreateMetrics(address string, name string) (Metrics, error) {
 		[]string{"status", "method", "path"},
 	)
 
-	if err := prometheus.Register(metr.Times); err != nil {
+	if err := registerer.Register(metr.Times); err != nil {
 		return nil, err
 	}
 
-	if err := prometheus.Register(prometheus.NewBuildInfoCollector()); err != nil {
+	metr.RequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "Duration of HTTP requests by route pattern",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"route", "method", "status"},
+	)
+
+	if err := registerer.Register(metr.RequestDuration); err != nil {
 		return nil, err
 	}
 
-	go func() {
-		router := echo.New()
-		router.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
-		log.Printf("Metrics server is running on port: %s", address)
-		if err := router.Start(address); err != nil {
-			log.Fatal(err)
-		}
-	}()
+	metr.Responses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "http_responses_total",
+			Help: "HTTP responses by status class",
+		},
+		[]string{"class"},
+	)
+
+	if err := registerer.Register(metr.Responses); err != nil {
+		return nil, err
+	}
 
 	return &metr, nil
 }
@@ -81,3 +121,17 @@ func (metr *PrometheusMetrics) IncHits(status int, method, path string) {
 func (metr *PrometheusMetrics) ObserveResponseTime(status int, method, path string, observeTime float64) {
 	metr.Times.WithLabelValues(strconv.Itoa(status), method, path).Observe(observeTime)
 }
+
+// Observe handler latency and count response by status class
+func (metr *PrometheusMetrics) ObserveRequest(route, method string, status int, seconds float64) {
+	metr.RequestDuration.WithLabelValues(route, method, strconv.Itoa(status)).Observe(seconds)
+	metr.Responses.WithLabelValues(StatusClass(status)).Inc()
+}
+
+// Status class label like 2xx, unknown for codes outside of 1xx-5xx
+func StatusClass(status int) string {
+	if status < 100 || status > 599 {
+		return "unknown"
+	}
+	return strconv.Itoa(status/100) + "xx"
+}
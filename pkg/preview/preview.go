@@ -0,0 +1,49 @@
+package preview
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
+)
+
+// Create preview token for news, token is "<unix expiry>.<hmac sha256 over news id and expiry>"
+func MakeToken(newsID uuid.UUID, expiresAt time.Time, secret string) string {
+	expiry := strconv.FormatInt(expiresAt.Unix(), 10)
+	return expiry + "." + sign(newsID, expiry, secret)
+}
+
+// Validate preview token signature and expiry for news
+func ValidateToken(token string, newsID uuid.UUID, secret string, now time.Time) error {
+	parts := strings.SplitN(token, ".", 2)
+	if len(parts) != 2 || secret == "" {
+		return httpErrors.InvalidPreviewToken
+	}
+
+	expected := sign(newsID, parts[0], secret)
+	if !hmac.Equal([]byte(parts[1]), []byte(expected)) {
+		return httpErrors.InvalidPreviewToken
+	}
+
+	expiry, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return httpErrors.InvalidPreviewToken
+	}
+	if !now.Before(time.Unix(expiry, 0)) {
+		return httpErrors.ExpiredPreviewToken
+	}
+
+	return nil
+}
+
+func sign(newsID uuid.UUID, expiry string, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(newsID.String() + ":" + expiry))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
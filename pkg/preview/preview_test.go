@@ -0,0 +1,52 @@
+package preview
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
+)
+
+func TestValidateToken(t *testing.T) {
+	t.Parallel()
+
+	secret := "preview-secret"
+	newsID := uuid.New()
+	now := time.Now()
+
+	t.Run("Valid", func(t *testing.T) {
+		token := MakeToken(newsID, now.Add(time.Hour), secret)
+		require.NoError(t, ValidateToken(token, newsID, secret, now))
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		token := MakeToken(newsID, now.Add(-time.Second), secret)
+		require.Equal(t, httpErrors.ExpiredPreviewToken, ValidateToken(token, newsID, secret, now))
+	})
+
+	t.Run("Tampered expiry", func(t *testing.T) {
+		token := MakeToken(newsID, now.Add(-time.Hour), secret)
+		signature := strings.SplitN(token, ".", 2)[1]
+		tampered := strconv.FormatInt(now.Add(time.Hour).Unix(), 10) + "." + signature
+		require.Equal(t, httpErrors.InvalidPreviewToken, ValidateToken(tampered, newsID, secret, now))
+	})
+
+	t.Run("Other news", func(t *testing.T) {
+		token := MakeToken(newsID, now.Add(time.Hour), secret)
+		require.Equal(t, httpErrors.InvalidPreviewToken, ValidateToken(token, uuid.New(), secret, now))
+	})
+
+	t.Run("Other secret", func(t *testing.T) {
+		token := MakeToken(newsID, now.Add(time.Hour), "other-secret")
+		require.Equal(t, httpErrors.InvalidPreviewToken, ValidateToken(token, newsID, secret, now))
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		require.Equal(t, httpErrors.InvalidPreviewToken, ValidateToken("not-a-token", newsID, secret, now))
+	})
+}
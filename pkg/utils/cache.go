@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"context"
+	"math/rand"
+	"sync/atomic"
+	"time"
+)
+
+const maxTTLJitterPercent = 50
+
+// Cache lookup outcomes reported in X-Cache debug header
+const (
+	CacheHit   = "HIT"
+	CacheMiss  = "MISS"
+	CacheStale = "STALE"
+)
+
+type cacheStatusCtxKey struct{}
+
+// Attach cache status recorder to context, usecases report lookup outcome into it
+func WithCacheStatus(ctx context.Context) (context.Context, *string) {
+	status := new(string)
+	return context.WithValue(ctx, cacheStatusCtxKey{}, status), status
+}
+
+// Report cache lookup outcome, does nothing when context has no recorder
+func SetCacheStatus(ctx context.Context, status string) {
+	if s, ok := ctx.Value(cacheStatusCtxKey{}).(*string); ok {
+		*s = status
+	}
+}
+
+// Randomly spread ttl by ±percent, percent is capped at 50 so ttl never drops to zero
+func JitterTTL(ttl time.Duration, percent int) time.Duration {
+	if percent <= 0 || ttl <= 0 {
+		return ttl
+	}
+	if percent > maxTTLJitterPercent {
+		percent = maxTTLJitterPercent
+	}
+
+	delta := int64(ttl) * int64(percent) / 100
+	if delta == 0 {
+		return ttl
+	}
+	return ttl + time.Duration(rand.Int63n(2*delta+1)-delta)
+}
+
+// Runtime switch of redis caching, safe for concurrent use
+type CacheSwitch struct {
+	enabled int32
+}
+
+// Cache switch constructor
+func NewCacheSwitch(enabled bool) *CacheSwitch {
+	s := &CacheSwitch{}
+	s.Set(enabled)
+	return s
+}
+
+// Is caching enabled
+func (s *CacheSwitch) Enabled() bool {
+	return atomic.LoadInt32(&s.enabled) == 1
+}
+
+// Enable or disable caching, returns previous state
+func (s *CacheSwitch) Set(enabled bool) bool {
+	var v int32
+	if enabled {
+		v = 1
+	}
+	return atomic.SwapInt32(&s.enabled, v) == 1
+}
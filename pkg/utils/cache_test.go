@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheSwitch(t *testing.T) {
+	t.Parallel()
+
+	s := NewCacheSwitch(true)
+	require.True(t, s.Enabled())
+
+	require.True(t, s.Set(false))
+	require.False(t, s.Enabled())
+	require.False(t, s.Set(false))
+
+	require.False(t, s.Set(true))
+	require.True(t, s.Enabled())
+
+	t.Run("Concurrent toggles", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(2)
+			go func(enabled bool) {
+				defer wg.Done()
+				s.Set(enabled)
+			}(i%2 == 0)
+			go func() {
+				defer wg.Done()
+				s.Enabled()
+			}()
+		}
+		wg.Wait()
+	})
+}
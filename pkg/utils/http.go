@@ -3,11 +3,13 @@ package utils
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"mime/multipart"
 	"net/http"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 
@@ -38,6 +40,26 @@ func GetRequestCtx(c echo.Context) context.Context {
 	return context.WithValue(c.Request().Context(), ReqIDCtxKey{}, GetRequestID(c))
 }
 
+// Parse uuid path param, returns bad request error with the param name if it is malformed
+func ParseUUIDParam(c echo.Context, name string) (uuid.UUID, error) {
+	id, err := uuid.Parse(c.Param(name))
+	if err != nil {
+		return uuid.Nil, httpErrors.NewRestError(http.StatusBadRequest, fmt.Sprintf("Invalid %s param", name), httpErrors.InvalidUUIDParam)
+	}
+	return id, nil
+}
+
+// TimezoneCtxKey is a key used for the response timezone in echo context
+const TimezoneCtxKey = "timezone"
+
+// Get response timezone resolved by timezone middleware, UTC if not set
+func GetTimezone(c echo.Context) *time.Location {
+	if loc, ok := c.Get(TimezoneCtxKey).(*time.Location); ok {
+		return loc
+	}
+	return time.UTC
+}
+
 // Get config path for local or docker
 func GetConfigPath(configPath string) string {
 	if configPath == "docker" {
@@ -99,6 +121,15 @@ func GetUserFromCtx(ctx context.Context) (*models.User, error) {
 	return user, nil
 }
 
+// TenantCtxKey is a key used for the tenant id of multi tenant request in the context
+type TenantCtxKey struct{}
+
+// Get tenant of request from context, false when request is not tenant scoped
+func GetTenantFromCtx(ctx context.Context) (uuid.UUID, bool) {
+	tenantID, ok := ctx.Value(TenantCtxKey{}).(uuid.UUID)
+	return tenantID, ok
+}
+
 // Get user ip address
 func GetIPAddress(c echo.Context) string {
 	return c.Request().RemoteAddr
@@ -106,18 +137,17 @@ func GetIPAddress(c echo.Context) string {
 
 // Error response with logging error for echo context
 func ErrResponseWithLog(ctx echo.Context, logger logger.Logger, err error) error {
-	logger.Errorf(
-		"ErrResponseWithLog, RequestID: %s, IPAddress: %s, Error: %s",
-		GetRequestID(ctx),
-		GetIPAddress(ctx),
-		err,
-	)
+	LogResponseError(ctx, logger, err)
 	return ctx.JSON(httpErrors.ErrorResponse(err))
 }
 
-// Error response with logging error for echo context
+// Error response with logging error for echo context, cancelled and timed out requests are not errors of service and logged as warnings
 func LogResponseError(ctx echo.Context, logger logger.Logger, err error) {
-	logger.Errorf(
+	logf := logger.Errorf
+	if httpErrors.IsContextError(err) {
+		logf = logger.Warnf
+	}
+	logf(
 		"ErrResponseWithLog, RequestID: %s, IPAddress: %s, Error: %s",
 		GetRequestID(ctx),
 		GetIPAddress(ctx),
@@ -184,7 +214,7 @@ func CheckImageFileContentType(fileContent []byte) (string, error) {
 
 	extension, ok := allowedImagesContentTypes[contentType]
 	if !ok {
-		return "", errors.New("this content type is not allowed")
+		return "", errors.Wrapf(httpErrors.NotAllowedImageHeader, "content type %q is not allowed", contentType)
 	}
 
 	return extension, nil
@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/text/language"
+)
+
+// Parse BCP 47 locale like "pt-BR" into canonical form, so "pt-br" and "pt_BR" are the same locale
+func ParseLocale(raw string) (string, error) {
+	tag, err := language.Parse(raw)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid locale %q", raw)
+	}
+	if tag == language.Und {
+		return "", errors.Errorf("invalid locale %q", raw)
+	}
+	return tag.String(), nil
+}
+
+// Wildcard "*" of Accept-Language is parsed as multiple languages tag
+var anyLanguage = language.Make("mul")
+
+// Locale most preferred by Accept-Language header, empty when header is missing, malformed or accepts any
+func PreferredLocale(acceptLanguage string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return ""
+	}
+	for _, tag := range tags {
+		if tag != language.Und && tag != anyLanguage {
+			return tag.String()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLocale(t *testing.T) {
+	t.Parallel()
+
+	for raw, expected := range map[string]string{"en": "en", "pt-br": "pt-BR", "pt_BR": "pt-BR", "zh-Hant-TW": "zh-Hant-TW"} {
+		locale, err := ParseLocale(raw)
+		require.NoError(t, err)
+		require.Equal(t, expected, locale)
+	}
+
+	for _, raw := range []string{"", "not a locale", "en--US", "und", "english!"} {
+		_, err := ParseLocale(raw)
+		require.Error(t, err, raw)
+	}
+}
+
+func TestPreferredLocale(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "de-AT", PreferredLocale("en;q=0.5, de-AT, fr;q=0.8"))
+	require.Equal(t, "fr", PreferredLocale("fr"))
+	require.Equal(t, "", PreferredLocale(""))
+	require.Equal(t, "", PreferredLocale("*"))
+	require.Equal(t, "", PreferredLocale("en;q=x;;;"))
+}
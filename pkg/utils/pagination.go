@@ -4,25 +4,32 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/api-mc/config"
+	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
 )
 
+// Fallbacks used when pagination config leaves a value empty
 const (
-	defaultSize = 10
+	defaultSize      = 10
+	defaultDirection = "asc"
 )
 
 // Pagination query params
 type PaginationQuery struct {
-	Size    int    `json:"size,omitempty"`
-	Page    int    `json:"page,omitempty"`
-	OrderBy string `json:"orderBy,omitempty"`
+	Size      int    `json:"size,omitempty"`
+	Page      int    `json:"page,omitempty"`
+	OrderBy   string `json:"orderBy,omitempty"`
+	Direction string `json:"direction,omitempty"`
 }
 
-// Set page size
+// Set page size, empty size is left for Resolve to fill from config
 func (q *PaginationQuery) SetSize(sizeQuery string) error {
 	if sizeQuery == "" {
-		q.Size = defaultSize
 		return nil
 	}
 	n, err := strconv.Atoi(sizeQuery)
@@ -54,6 +61,40 @@ func (q *PaginationQuery) SetOrderBy(orderByQuery string) {
 	q.OrderBy = orderByQuery
 }
 
+// Set order direction, only asc and desc are accepted
+func (q *PaginationQuery) SetDirection(directionQuery string) error {
+	direction := strings.ToLower(directionQuery)
+	if direction != "" && direction != "asc" && direction != "desc" {
+		return httpErrors.NewBadRequestError(errors.New("direction must be asc or desc"))
+	}
+	q.Direction = direction
+	return nil
+}
+
+// Fill params missing from request with configured defaults and cap size at configured max.
+// OrderBy is left empty when composed DefaultSort is configured, so list spec can apply it
+func (q *PaginationQuery) Resolve(cfg config.PaginationConfig) *PaginationQuery {
+	if q.Size <= 0 {
+		q.Size = cfg.DefaultSize
+		if q.Size <= 0 {
+			q.Size = defaultSize
+		}
+	}
+	if cfg.MaxSize > 0 && q.Size > cfg.MaxSize {
+		q.Size = cfg.MaxSize
+	}
+	if q.OrderBy == "" && cfg.DefaultSort == "" {
+		q.OrderBy = cfg.DefaultOrderBy
+	}
+	if q.Direction == "" {
+		q.Direction = strings.ToLower(cfg.DefaultDirection)
+		if q.Direction == "" {
+			q.Direction = defaultDirection
+		}
+	}
+	return q
+}
+
 // Get offset
 func (q *PaginationQuery) GetOffset() int {
 	if q.Page == 0 {
@@ -72,6 +113,11 @@ func (q *PaginationQuery) GetOrderBy() string {
 	return q.OrderBy
 }
 
+// Get Direction
+func (q *PaginationQuery) GetDirection() string {
+	return q.Direction
+}
+
 // Get OrderBy
 func (q *PaginationQuery) GetPage() int {
 	return q.Page
@@ -82,6 +128,17 @@ func (q *PaginationQuery) GetSize() int {
 	return q.Size
 }
 
+// Check offset and limit fit into max result window, zero max result window disables the check
+func (q *PaginationQuery) CheckResultWindow(maxResultWindow int) error {
+	if maxResultWindow <= 0 {
+		return nil
+	}
+	if q.GetOffset()+q.GetLimit() > maxResultWindow {
+		return httpErrors.ErrDeepPagination
+	}
+	return nil
+}
+
 func (q *PaginationQuery) GetQueryString() string {
 	return fmt.Sprintf("page=%v&size=%v&orderBy=%s", q.GetPage(), q.GetSize(), q.GetOrderBy())
 }
@@ -96,6 +153,9 @@ func GetPaginationFromCtx(c echo.Context) (*PaginationQuery, error) {
 		return nil, err
 	}
 	q.SetOrderBy(c.QueryParam("orderBy"))
+	if err := q.SetDirection(c.QueryParam("direction")); err != nil {
+		return nil, err
+	}
 
 	return q, nil
 }
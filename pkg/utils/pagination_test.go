@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/config"
+	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
+)
+
+func TestPaginationQuery_CheckResultWindow(t *testing.T) {
+	t.Parallel()
+
+	t.Run("At boundary", func(t *testing.T) {
+		pq := &PaginationQuery{Size: 100, Page: 100}
+		require.NoError(t, pq.CheckResultWindow(10000))
+	})
+
+	t.Run("Beyond boundary", func(t *testing.T) {
+		pq := &PaginationQuery{Size: 100, Page: 101}
+		require.Equal(t, httpErrors.ErrDeepPagination, pq.CheckResultWindow(10000))
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		pq := &PaginationQuery{Size: 100, Page: 1000000}
+		require.NoError(t, pq.CheckResultWindow(0))
+	})
+}
+
+func TestPaginationQuery_Resolve(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.PaginationConfig{DefaultSize: 25, MaxSize: 50, DefaultOrderBy: "created_at", DefaultDirection: "DESC"}
+
+	t.Run("Defaults from config", func(t *testing.T) {
+		pq := (&PaginationQuery{Page: 2}).Resolve(cfg)
+		require.Equal(t, &PaginationQuery{Page: 2, Size: 25, OrderBy: "created_at", Direction: "desc"}, pq)
+		require.Equal(t, 25, pq.GetOffset())
+	})
+
+	t.Run("Size capped at max", func(t *testing.T) {
+		pq := (&PaginationQuery{Page: 1, Size: 500}).Resolve(cfg)
+		require.Equal(t, 50, pq.GetLimit())
+	})
+
+	t.Run("Request values kept", func(t *testing.T) {
+		pq := (&PaginationQuery{Page: 1, Size: 5, OrderBy: "title", Direction: "asc"}).Resolve(cfg)
+		require.Equal(t, &PaginationQuery{Page: 1, Size: 5, OrderBy: "title", Direction: "asc"}, pq)
+	})
+
+	t.Run("Composed default sort leaves order to spec", func(t *testing.T) {
+		withSort := cfg
+		withSort.DefaultSort = "-created_at"
+		pq := (&PaginationQuery{Page: 1}).Resolve(withSort)
+		require.Empty(t, pq.GetOrderBy())
+
+		pq = (&PaginationQuery{Page: 1, OrderBy: "title"}).Resolve(withSort)
+		require.Equal(t, "title", pq.GetOrderBy())
+	})
+
+	t.Run("Empty config falls back", func(t *testing.T) {
+		pq := (&PaginationQuery{}).Resolve(config.PaginationConfig{})
+		require.Equal(t, defaultSize, pq.GetSize())
+		require.Equal(t, defaultDirection, pq.GetDirection())
+	})
+}
+
+func TestPaginationQuery_SetDirection(t *testing.T) {
+	t.Parallel()
+
+	pq := &PaginationQuery{}
+	require.NoError(t, pq.SetDirection("DESC"))
+	require.Equal(t, "desc", pq.GetDirection())
+
+	err := pq.SetDirection("sideways")
+	require.Error(t, err)
+	require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+}
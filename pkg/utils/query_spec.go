@@ -0,0 +1,271 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
+)
+
+// Kind of value accepted by query spec filter
+type FieldType string
+
+// Query spec filter value types
+const (
+	FieldString FieldType = "string"
+	FieldUUID   FieldType = "uuid"
+	FieldTime   FieldType = "time"
+	FieldInt    FieldType = "int"
+)
+
+// Params always owned by pagination, accepted by every query spec
+var paginationParams = []string{"page", "size", "orderBy", "direction"}
+
+// Filter param allowed in list query
+type FilterField struct {
+	Column string
+	Type   FieldType
+	// SQL comparison operator, "=" when empty
+	Operator string
+	// Optional check of parsed value, called for every value of repeatable param
+	Validate func(value interface{}) error
+	// Param may be repeated up to MaxValues times and matches any of given values, only string and uuid fields
+	MaxValues int
+}
+
+// Declarative allowlist of filter and sort params of list endpoint, anything else is rejected
+type QuerySpec struct {
+	Filters map[string]FilterField
+	// orderBy param value to column
+	Sort map[string]string
+	// orderBy value used when request has none
+	DefaultSort string
+	// Column appended to every order so pages are stable
+	TieBreaker string
+	// Params handled elsewhere, e.g. by middleware
+	Ignore []string
+}
+
+// Single parsed filter condition
+type ListCondition struct {
+	Column   string
+	Operator string
+	Value    interface{}
+	// Postgres element type when Value is array literal matched with = ANY
+	ArrayType string
+}
+
+// Single term of composed order
+type SortTerm struct {
+	Column    string
+	Direction string
+}
+
+// Filters and order parsed by QuerySpec, columns and operators come only from spec so clauses are safe to build into SQL
+type ListQuery struct {
+	Conditions []ListCondition
+	OrderBy    string
+	Direction  string
+	TieBreaker string
+	// Composed order used instead of OrderBy and Direction when set
+	Sorts []SortTerm
+	// Rows fetched past the end of page in the same query, returned apart as preview of next page
+	Prefetch int
+	// Total count estimated from table statistics instead of counted, only honored without conditions
+	EstimateCount bool
+}
+
+// Parse and validate request params against spec, pagination should be resolved before
+func (s *QuerySpec) Parse(params url.Values, pq *PaginationQuery) (*ListQuery, error) {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lq := &ListQuery{TieBreaker: s.TieBreaker, Conditions: make([]ListCondition, 0)}
+	for _, name := range names {
+		if s.isReserved(name) {
+			continue
+		}
+
+		field, ok := s.Filters[name]
+		if !ok {
+			return nil, httpErrors.NewBadRequestError(errors.Errorf("unknown query param %q", name))
+		}
+		if field.MaxValues > 0 {
+			condition, err := parseAnyCondition(name, field, params[name])
+			if err != nil {
+				return nil, err
+			}
+			lq.Conditions = append(lq.Conditions, condition)
+			continue
+		}
+		if len(params[name]) > 1 {
+			return nil, httpErrors.NewBadRequestError(errors.Errorf("query param %q given more than once", name))
+		}
+
+		value, err := parseFieldValue(field.Type, params.Get(name))
+		if err != nil {
+			return nil, httpErrors.NewBadRequestError(errors.Wrapf(err, "invalid query param %q", name))
+		}
+		if field.Validate != nil {
+			if err = field.Validate(value); err != nil {
+				return nil, httpErrors.NewBadRequestError(errors.Wrapf(err, "invalid query param %q", name))
+			}
+		}
+
+		operator := field.Operator
+		if operator == "" {
+			operator = "="
+		}
+		lq.Conditions = append(lq.Conditions, ListCondition{Column: field.Column, Operator: operator, Value: value})
+	}
+
+	orderBy := pq.GetOrderBy()
+	if orderBy == "" {
+		orderBy = s.DefaultSort
+	}
+	column, ok := s.Sort[orderBy]
+	if !ok {
+		return nil, httpErrors.NewBadRequestError(errors.Errorf("orderBy %q is not allowed", orderBy))
+	}
+	lq.OrderBy = column
+
+	lq.Direction = "ASC"
+	if pq.GetDirection() == "desc" {
+		lq.Direction = "DESC"
+	}
+
+	return lq, nil
+}
+
+// Parse composed order like "-featured,-created_at", keys must be spec sort keys, "-" prefix means descending
+func (s *QuerySpec) ParseSort(order string) ([]SortTerm, error) {
+	keys := strings.Split(order, ",")
+	terms := make([]SortTerm, 0, len(keys))
+	for _, key := range keys {
+		key = strings.TrimSpace(key)
+		direction := "ASC"
+		if strings.HasPrefix(key, "-") {
+			key = key[1:]
+			direction = "DESC"
+		}
+		column, ok := s.Sort[key]
+		if !ok {
+			return nil, errors.Errorf("sort key %q is not allowed", key)
+		}
+		terms = append(terms, SortTerm{Column: column, Direction: direction})
+	}
+	return terms, nil
+}
+
+func (s *QuerySpec) isReserved(name string) bool {
+	for _, p := range paginationParams {
+		if p == name {
+			return true
+		}
+	}
+	for _, p := range s.Ignore {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
+func parseAnyCondition(name string, field FilterField, raw []string) (ListCondition, error) {
+	if len(raw) > field.MaxValues {
+		return ListCondition{}, httpErrors.NewBadRequestError(errors.Errorf("query param %q given more than %d times", name, field.MaxValues))
+	}
+
+	values := make([]string, 0, len(raw))
+	for _, r := range raw {
+		value, err := parseFieldValue(field.Type, r)
+		if err != nil {
+			return ListCondition{}, httpErrors.NewBadRequestError(errors.Wrapf(err, "invalid query param %q", name))
+		}
+		if field.Validate != nil {
+			if err = field.Validate(value); err != nil {
+				return ListCondition{}, httpErrors.NewBadRequestError(errors.Wrapf(err, "invalid query param %q", name))
+			}
+		}
+		values = append(values, fmt.Sprint(value))
+	}
+
+	switch field.Type {
+	case FieldString:
+		return ListCondition{Column: field.Column, Operator: "=", Value: TextArray(values), ArrayType: "text"}, nil
+	case FieldUUID:
+		return ListCondition{Column: field.Column, Operator: "=", Value: "{" + strings.Join(values, ",") + "}", ArrayType: "uuid"}, nil
+	default:
+		return ListCondition{}, errors.Errorf("repeatable field type %q is not supported", field.Type)
+	}
+}
+
+func parseFieldValue(fieldType FieldType, raw string) (interface{}, error) {
+	switch fieldType {
+	case FieldString:
+		return raw, nil
+	case FieldUUID:
+		return uuid.Parse(raw)
+	case FieldTime:
+		return time.Parse(time.RFC3339, raw)
+	case FieldInt:
+		return strconv.Atoi(raw)
+	default:
+		return nil, errors.Errorf("unsupported field type %q", fieldType)
+	}
+}
+
+// Build WHERE conditions joined to base condition, placeholders start from $1
+func (q *ListQuery) Where(base string) (string, []interface{}) {
+	clauses := make([]string, 0, len(q.Conditions)+1)
+	if base != "" {
+		clauses = append(clauses, base)
+	}
+	args := make([]interface{}, 0, len(q.Conditions))
+	for _, c := range q.Conditions {
+		args = append(args, c.Value)
+		if c.ArrayType != "" {
+			clauses = append(clauses, fmt.Sprintf("%s %s ANY($%d::%s[])", c.Column, c.Operator, len(args), c.ArrayType))
+			continue
+		}
+		clauses = append(clauses, fmt.Sprintf("%s %s $%d", c.Column, c.Operator, len(args)))
+	}
+	if len(clauses) == 0 {
+		return "TRUE", args
+	}
+	return strings.Join(clauses, " AND "), args
+}
+
+// Build ORDER BY expression, tie breaker follows the main order direction or direction of last composed term
+func (q *ListQuery) Order() string {
+	if len(q.Sorts) > 0 {
+		return q.composedOrder()
+	}
+	if q.TieBreaker == "" || q.TieBreaker == q.OrderBy {
+		return fmt.Sprintf("%s %s", q.OrderBy, q.Direction)
+	}
+	return fmt.Sprintf("%s %s, %s %s", q.OrderBy, q.Direction, q.TieBreaker, q.Direction)
+}
+
+func (q *ListQuery) composedOrder() string {
+	terms := make([]string, 0, len(q.Sorts)+1)
+	hasTieBreaker := false
+	for _, term := range q.Sorts {
+		terms = append(terms, fmt.Sprintf("%s %s", term.Column, term.Direction))
+		hasTieBreaker = hasTieBreaker || term.Column == q.TieBreaker
+	}
+	if q.TieBreaker != "" && !hasTieBreaker {
+		terms = append(terms, fmt.Sprintf("%s %s", q.TieBreaker, q.Sorts[len(q.Sorts)-1].Direction))
+	}
+	return strings.Join(terms, ", ")
+}
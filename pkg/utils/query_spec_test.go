@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
+)
+
+var testSpec = &QuerySpec{
+	Filters: map[string]FilterField{
+		"category":      {Column: "category", Type: FieldString},
+		"author_id":     {Column: "author_id", Type: FieldUUID},
+		"categories":    {Column: "category", Type: FieldString, MaxValues: 2},
+		"authors":       {Column: "author_id", Type: FieldUUID, MaxValues: 2},
+		"created_after": {Column: "created_at", Type: FieldTime, Operator: ">="},
+		"min_likes": {Column: "likes", Type: FieldInt, Operator: ">=", Validate: func(v interface{}) error {
+			if v.(int) < 0 {
+				return errors.New("must not be negative")
+			}
+			return nil
+		}},
+	},
+	Sort:        map[string]string{"created_at": "created_at", "title": "title"},
+	DefaultSort: "created_at",
+	TieBreaker:  "news_id",
+	Ignore:      []string{"tz"},
+}
+
+func TestQuerySpec_Parse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Valid combined query", func(t *testing.T) {
+		authorID := uuid.New()
+		params := url.Values{
+			"category":      {"golang"},
+			"author_id":     {authorID.String()},
+			"created_after": {"2021-01-02T03:04:05Z"},
+			"page":          {"2"},
+			"size":          {"10"},
+			"orderBy":       {"title"},
+			"direction":     {"desc"},
+			"tz":            {"Europe/Moscow"},
+		}
+		pq := &PaginationQuery{Page: 2, Size: 10, OrderBy: "title", Direction: "desc"}
+
+		lq, err := testSpec.Parse(params, pq)
+		require.NoError(t, err)
+
+		where, args := lq.Where("status = 'published'")
+		require.Equal(t, "status = 'published' AND author_id = $1 AND category = $2 AND created_at >= $3", where)
+		require.Equal(t, []interface{}{authorID, "golang", time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)}, args)
+		require.Equal(t, "title DESC, news_id DESC", lq.Order())
+	})
+
+	t.Run("Repeatable params", func(t *testing.T) {
+		authorID := uuid.New()
+		params := url.Values{
+			"categories":    {"golang", `say "hi"`},
+			"authors":       {authorID.String()},
+			"created_after": {"2021-01-02T03:04:05Z"},
+		}
+
+		lq, err := testSpec.Parse(params, &PaginationQuery{})
+		require.NoError(t, err)
+
+		where, args := lq.Where("")
+		require.Equal(t, "author_id = ANY($1::uuid[]) AND category = ANY($2::text[]) AND created_at >= $3", where)
+		require.Equal(t, []interface{}{"{" + authorID.String() + "}", `{"golang","say \"hi\""}`, time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)}, args)
+	})
+
+	t.Run("Defaults", func(t *testing.T) {
+		lq, err := testSpec.Parse(url.Values{}, &PaginationQuery{})
+		require.NoError(t, err)
+
+		where, args := lq.Where("")
+		require.Equal(t, "TRUE", where)
+		require.Empty(t, args)
+		require.Equal(t, "created_at ASC, news_id ASC", lq.Order())
+	})
+
+	rejected := map[string]struct {
+		params url.Values
+		pq     *PaginationQuery
+	}{
+		"Unknown param":      {url.Values{"status": {"draft"}}, &PaginationQuery{}},
+		"Invalid uuid":       {url.Values{"author_id": {"not-uuid"}}, &PaginationQuery{}},
+		"Invalid time":       {url.Values{"created_after": {"yesterday"}}, &PaginationQuery{}},
+		"Invalid int":        {url.Values{"min_likes": {"many"}}, &PaginationQuery{}},
+		"Validator rejects":  {url.Values{"min_likes": {"-1"}}, &PaginationQuery{}},
+		"Repeated param":     {url.Values{"category": {"a", "b"}}, &PaginationQuery{}},
+		"Too many values":    {url.Values{"categories": {"a", "b", "c"}}, &PaginationQuery{}},
+		"Invalid list value": {url.Values{"authors": {uuid.New().String(), "not-uuid"}}, &PaginationQuery{}},
+		"Disallowed orderBy": {url.Values{}, &PaginationQuery{OrderBy: "password"}},
+	}
+	for name, tc := range rejected {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			_, err := testSpec.Parse(tc.params, tc.pq)
+			require.Error(t, err)
+			require.Equal(t, http.StatusBadRequest, httpErrors.ParseErrors(err).Status())
+		})
+	}
+}
+
+func TestQuerySpec_ParseSort(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Composed order", func(t *testing.T) {
+		sorts, err := testSpec.ParseSort("-title, created_at")
+		require.NoError(t, err)
+		require.Equal(t, []SortTerm{{Column: "title", Direction: "DESC"}, {Column: "created_at", Direction: "ASC"}}, sorts)
+
+		lq := &ListQuery{OrderBy: "created_at", Direction: "ASC", TieBreaker: "news_id", Sorts: sorts}
+		require.Equal(t, "title DESC, created_at ASC, news_id ASC", lq.Order())
+	})
+
+	t.Run("Tie breaker not repeated", func(t *testing.T) {
+		lq := &ListQuery{TieBreaker: "news_id", Sorts: []SortTerm{{Column: "news_id", Direction: "DESC"}}}
+		require.Equal(t, "news_id DESC", lq.Order())
+	})
+
+	t.Run("Unknown key", func(t *testing.T) {
+		_, err := testSpec.ParseSort("-created_at,likes")
+		require.Error(t, err)
+	})
+
+	t.Run("Empty key", func(t *testing.T) {
+		_, err := testSpec.ParseSort("")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,19 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Runs of anything except letters and digits become single dash in slug
+var slugSeparators = regexp.MustCompile(`[^\p{L}\p{N}]+`)
+
+// Make url friendly slug, "Hello, World!" becomes "hello-world"
+func Slugify(s string) string {
+	s = norm.NFKC.String(s)
+	s = strings.ToLower(s)
+	s = slugSeparators.ReplaceAllString(s, "-")
+	return strings.Trim(s, "-")
+}
@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlugify(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "hello-world", Slugify("Hello, World!"))
+	require.Equal(t, "clean-rest-api", Slugify("  clean   REST api  "))
+	require.Equal(t, "go-1-16-released", Slugify("Go 1.16 released"))
+	require.Equal(t, "новости-дня", Slugify("Новости дня"))
+	require.Equal(t, "", Slugify(" -- "))
+}
@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Build postgres array literal from uuids, use it with $1::uuid[] placeholder
+func UUIDArray(ids []uuid.UUID) string {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
+	return "{" + strings.Join(values, ",") + "}"
+}
+
+// Build postgres array literal from strings, use it with $1::text[] placeholder
+func TextArray(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		v = strings.ReplaceAll(v, `\`, `\\`)
+		v = strings.ReplaceAll(v, `"`, `\"`)
+		quoted = append(quoted, `"`+v+`"`)
+	}
+	return "{" + strings.Join(quoted, ",") + "}"
+}
@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUUIDArray(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	require.Equal(t, "{}", UUIDArray(nil))
+	require.Equal(t, "{"+id.String()+"}", UUIDArray([]uuid.UUID{id}))
+}
+
+func TestTextArray(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "{}", TextArray(nil))
+	require.Equal(t, `{"go","clean architecture"}`, TextArray([]string{"go", "clean architecture"}))
+	require.Equal(t, `{"a,b","say \"hi\"","back\\slash"}`, TextArray([]string{"a,b", `say "hi"`, `back\slash`}))
+}
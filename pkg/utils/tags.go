@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Everything except letters, digits, whitespace and - + # is stripped from tags
+var disallowedTagChars = regexp.MustCompile(`[^\p{L}\p{N}\s+#-]+`)
+
+// Normalize tag so "Go", "go" and " go " are the same tag
+func NormalizeTag(tag string) string {
+	tag = norm.NFKC.String(tag)
+	tag = strings.ToLower(tag)
+	tag = disallowedTagChars.ReplaceAllString(tag, "")
+	return strings.Join(strings.Fields(tag), " ")
+}
+
+// Normalize tags, drop empty ones and duplicates keeping first occurrence order
+func NormalizeTags(tags []string) []string {
+	seen := make(map[string]struct{}, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = NormalizeTag(tag)
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Casing", func(t *testing.T) {
+		require.Equal(t, "go", NormalizeTag("Go"))
+		require.Equal(t, "go", NormalizeTag("GO"))
+		require.Equal(t, "golang news", NormalizeTag("GoLang News"))
+	})
+
+	t.Run("Whitespace", func(t *testing.T) {
+		require.Equal(t, "go", NormalizeTag(" go "))
+		require.Equal(t, "clean architecture", NormalizeTag("  clean \t  architecture\n"))
+		require.Equal(t, "", NormalizeTag("   "))
+	})
+
+	t.Run("Disallowed chars", func(t *testing.T) {
+		require.Equal(t, "go", NormalizeTag("go!"))
+		require.Equal(t, "c++", NormalizeTag("C++"))
+		require.Equal(t, "c#", NormalizeTag("C#"))
+		require.Equal(t, "rest-api", NormalizeTag("<rest-api>"))
+	})
+
+	t.Run("Unicode", func(t *testing.T) {
+		// Full width letters and decomposed accents fold to their canonical form
+		require.Equal(t, "go", NormalizeTag("Ｇｏ"))
+		require.Equal(t, "caf\u00e9", NormalizeTag("Cafe\u0301"))
+		require.Equal(t, NormalizeTag("café"), NormalizeTag("CAFÉ"))
+		require.Equal(t, "новости", NormalizeTag("Новости"))
+	})
+}
+
+func TestNormalizeTags(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Deduplicate", func(t *testing.T) {
+		tags := NormalizeTags([]string{"Go", "go", " go ", "Redis", "", "  ", "redis!"})
+		require.Equal(t, []string{"go", "redis"}, tags)
+	})
+}
@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Trim text and collapse inner runs of whitespace to single space
+func NormalizeSpaces(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// Cut at most length characters of text starting from offset, negative length means up to the end.
+// Range is clamped to text end, returned end is exclusive, ok is false when offset is past the end
+func SliceRunes(s string, offset int, length int) (part string, start int, end int, total int, ok bool) {
+	runes := []rune(s)
+	total = len(runes)
+	if offset < 0 || offset >= total {
+		return "", 0, 0, total, false
+	}
+
+	end = total
+	if length >= 0 && length < total-offset {
+		end = offset + length
+	}
+	return string(runes[offset:end]), offset, end, total, true
+}
+
+// Stable hex sha256 of text, unicode forms and whitespace differences give the same hash
+func ContentHash(content string) string {
+	sum := sha256.Sum256([]byte(NormalizeSpaces(norm.NFKC.String(content))))
+	return hex.EncodeToString(sum[:])
+}
+
+// Text of html fragment with tags removed and spaces normalized, script and style contents are dropped.
+// Plain text is returned as is
+func StripHTML(s string) string {
+	var sb strings.Builder
+	z := html.NewTokenizer(strings.NewReader(s))
+	skip := 0
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return NormalizeSpaces(sb.String())
+		case html.TextToken:
+			if skip == 0 {
+				sb.Write(z.Text())
+			}
+		case html.StartTagToken:
+			if name, _ := z.TagName(); isSkippedTag(name) {
+				skip++
+			}
+			sb.WriteByte(' ')
+		case html.EndTagToken:
+			if name, _ := z.TagName(); isSkippedTag(name) && skip > 0 {
+				skip--
+			}
+			sb.WriteByte(' ')
+		case html.SelfClosingTagToken:
+			sb.WriteByte(' ')
+		}
+	}
+}
+
+func isSkippedTag(name []byte) bool {
+	a := atom.Lookup(name)
+	return a == atom.Script || a == atom.Style
+}
+
+// Plain text beginning of html fragment of at most maxRunes characters, cut at word boundary and ended with ellipsis when shortened
+func Excerpt(s string, maxRunes int) string {
+	text := StripHTML(s)
+	runes := []rune(text)
+	if maxRunes <= 0 || len(runes) <= maxRunes {
+		return text
+	}
+
+	cut := string(runes[:maxRunes])
+	if next := runes[maxRunes]; next != ' ' {
+		if i := strings.LastIndex(cut, " "); i > 0 {
+			cut = cut[:i]
+		}
+	}
+	return strings.TrimRight(cut, " ,.;:") + "…"
+}
+
+// Number of whitespace separated words in text
+func WordCount(text string) int {
+	return len(strings.Fields(text))
+}
+
+// Minutes needed to read text at wordsPerMinute rounded up, at least one minute for text with any words
+func ReadingTimeMinutes(text string, wordsPerMinute int) int {
+	words := WordCount(text)
+	if words == 0 || wordsPerMinute <= 0 {
+		return 0
+	}
+	return (words + wordsPerMinute - 1) / wordsPerMinute
+}
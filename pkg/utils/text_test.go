@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeSpaces(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"Clean title":                 "Clean title",
+		"  Leading and trailing  ":    "Leading and trailing",
+		"Doubled  inner   spaces":     "Doubled inner spaces",
+		"\tTabs\tand\nnew\r\nlines\n": "Tabs and new lines",
+		"No-break\u00a0space\u2003em": "No-break space em",
+		"   ":                         "",
+		"":                            "",
+	}
+	for in, want := range cases {
+		require.Equal(t, want, NormalizeSpaces(in), in)
+	}
+}
+
+func TestSliceRunes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Inside text", func(t *testing.T) {
+		part, start, end, total, ok := SliceRunes("Привет, world", 3, 5)
+		require.True(t, ok)
+		require.Equal(t, "вет, ", part)
+		require.Equal(t, []int{3, 8, 13}, []int{start, end, total})
+	})
+
+	t.Run("Clamped to end", func(t *testing.T) {
+		part, start, end, total, ok := SliceRunes("content", 4, 100)
+		require.True(t, ok)
+		require.Equal(t, "ent", part)
+		require.Equal(t, []int{4, 7, 7}, []int{start, end, total})
+	})
+
+	t.Run("Huge length", func(t *testing.T) {
+		part, start, end, total, ok := SliceRunes("content", 1, math.MaxInt64)
+		require.True(t, ok)
+		require.Equal(t, "ontent", part)
+		require.Equal(t, []int{1, 7, 7}, []int{start, end, total})
+	})
+
+	t.Run("Up to end", func(t *testing.T) {
+		part, _, end, _, ok := SliceRunes("content", 2, -1)
+		require.True(t, ok)
+		require.Equal(t, "ntent", part)
+		require.Equal(t, 7, end)
+	})
+
+	t.Run("Offset past end", func(t *testing.T) {
+		_, _, _, total, ok := SliceRunes("content", 7, 1)
+		require.False(t, ok)
+		require.Equal(t, 7, total)
+	})
+}
+
+func TestContentHash(t *testing.T) {
+	t.Parallel()
+
+	hash := ContentHash("Clean architecture in Go")
+	require.Len(t, hash, 64)
+
+	t.Run("Same normalized content", func(t *testing.T) {
+		require.Equal(t, hash, ContentHash("  Clean architecture\n\tin  Go "))
+		require.Equal(t, hash, ContentHash("Clean\u00a0architecture in Go"))
+	})
+
+	t.Run("Trivially different content", func(t *testing.T) {
+		require.NotEqual(t, hash, ContentHash("Clean architecture in Go!"))
+		require.NotEqual(t, hash, ContentHash("clean architecture in Go"))
+		require.NotEqual(t, hash, ContentHash("Clean architecture in Go 2"))
+	})
+}
+
+func TestStripHTML(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"Plain text stays":                                 "Plain text stays",
+		"<p>First</p><p>second</p>":                        "First second",
+		"<p>Bold <b>word</b> and<br/>break</p>":            "Bold word and break",
+		"<div>Fish &amp; chips</div>":                      "Fish & chips",
+		"<p>Text</p><script>var a = 'hidden';</script>":    "Text",
+		"<style>p { color: red; }</style><h1>Heading</h1>": "Heading",
+		"<img src=\"a.png\" alt=\"not counted\">Caption":   "Caption",
+		"": "",
+	}
+	for in, want := range cases {
+		require.Equal(t, want, StripHTML(in), in)
+	}
+}
+
+func TestExcerpt(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "Short text", Excerpt("<p>Short text</p>", 100))
+	require.Equal(t, "First words of…", Excerpt("<p>First words of longer text</p>", 16))
+	require.Equal(t, "First words…", Excerpt("First words, of longer text", 12))
+	require.Equal(t, "Unbreakab…", Excerpt("Unbreakableword", 9))
+	require.Equal(t, "Привет…", Excerpt("Привет мир", 8))
+	require.Equal(t, "No limit keeps all", Excerpt("No limit keeps all", 0))
+	require.Equal(t, "", Excerpt("<script>hidden()</script>", 10))
+}
+
+func TestWordCount(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 0, WordCount(""))
+	require.Equal(t, 0, WordCount(" \n\t "))
+	require.Equal(t, 3, WordCount("  three\twords\nhere "))
+}
+
+func TestReadingTimeMinutes(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 0, ReadingTimeMinutes("", 200))
+	require.Equal(t, 1, ReadingTimeMinutes("Just a few words", 200))
+	require.Equal(t, 1, ReadingTimeMinutes(strings.Repeat("word ", 200), 200))
+	require.Equal(t, 2, ReadingTimeMinutes(strings.Repeat("word ", 201), 200))
+	require.Equal(t, 5, ReadingTimeMinutes(strings.Repeat("word ", 1000), 200))
+	require.Equal(t, 0, ReadingTimeMinutes("words", 0))
+}
@@ -0,0 +1,12 @@
+package utils
+
+import (
+	"math"
+	"time"
+)
+
+// Hacker News style trending score views / (age_hours + 2) ^ gravity, same formula as trending news query orders by
+func TrendingScore(views int64, age time.Duration, gravity float64) float64 {
+	hours := math.Max(age.Hours(), 0)
+	return float64(views) / math.Pow(hours+2, gravity)
+}
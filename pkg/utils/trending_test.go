@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrendingScore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Newer high view item outranks older one", func(t *testing.T) {
+		newer := TrendingScore(500, 2*time.Hour, 1.8)
+		older := TrendingScore(2000, 48*time.Hour, 1.8)
+		require.Greater(t, newer, older)
+	})
+
+	t.Run("Zero views", func(t *testing.T) {
+		require.Equal(t, float64(0), TrendingScore(0, time.Hour, 1.8))
+		require.Equal(t, float64(0), TrendingScore(0, 0, 1.8))
+	})
+
+	t.Run("Future created at counts as new", func(t *testing.T) {
+		require.Equal(t, TrendingScore(10, 0, 1.8), TrendingScore(10, -time.Hour, 1.8))
+	})
+
+	t.Run("Higher gravity decays faster", func(t *testing.T) {
+		require.Less(t, TrendingScore(100, 24*time.Hour, 2.5), TrendingScore(100, 24*time.Hour, 1.2))
+	})
+}
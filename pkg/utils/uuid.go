@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
+)
+
+// Reasons uuid field of request body is rejected
+const (
+	uuidNil       = "nil uuid"
+	uuidMalformed = "malformed uuid"
+)
+
+// Request body field holding uuid, Name is json name reported back to client
+type UUIDField struct {
+	Name  string
+	Value uuid.UUID
+}
+
+// Uuid fields of request body named with index, e.g. ids[0]
+func UUIDFields(name string, ids []uuid.UUID) []UUIDField {
+	fields := make([]UUIDField, 0, len(ids))
+	for i, id := range ids {
+		fields = append(fields, UUIDField{Name: fmt.Sprintf("%s[%d]", name, i), Value: id})
+	}
+	return fields
+}
+
+// Check uuids of request body, nil uuid and uuid of other than RFC 4122 variant are rejected.
+// Returns 400 listing every bad field, causes map field name to reason
+func ValidateUUIDs(fields ...UUIDField) error {
+	causes := make(map[string]string)
+	names := make([]string, 0)
+	for _, f := range fields {
+		var reason string
+		switch {
+		case f.Value == uuid.Nil:
+			reason = uuidNil
+		case f.Value.Variant() != uuid.RFC4122:
+			reason = uuidMalformed
+		default:
+			continue
+		}
+		causes[f.Name] = reason
+		names = append(names, fmt.Sprintf("%s (%s)", f.Name, reason))
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	message := fmt.Sprintf("%s: %s", httpErrors.InvalidUUIDField.Error(), strings.Join(names, ", "))
+	return httpErrors.NewRestError(http.StatusBadRequest, message, causes)
+}
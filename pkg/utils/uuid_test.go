@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AleksK1NG/api-mc/pkg/httpErrors"
+)
+
+func TestValidateUUIDs(t *testing.T) {
+	t.Parallel()
+
+	valid := uuid.New()
+	malformed := uuid.MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff")
+
+	require.NoError(t, ValidateUUIDs(UUIDField{Name: "author_id", Value: valid}))
+	require.NoError(t, ValidateUUIDs(UUIDFields("ids", []uuid.UUID{valid, uuid.New()})...))
+
+	err := ValidateUUIDs(UUIDField{Name: "author_id", Value: uuid.Nil})
+	restErr, ok := err.(httpErrors.RestErr)
+	require.True(t, ok)
+	require.Equal(t, http.StatusBadRequest, restErr.Status())
+	require.Equal(t, map[string]string{"author_id": "nil uuid"}, restErr.Causes())
+
+	err = ValidateUUIDs(UUIDFields("ids", []uuid.UUID{valid, malformed, uuid.Nil})...)
+	restErr, ok = err.(httpErrors.RestErr)
+	require.True(t, ok)
+	require.Equal(t, map[string]string{"ids[1]": "malformed uuid", "ids[2]": "nil uuid"}, restErr.Causes())
+	require.Contains(t, restErr.Error(), "ids[1] (malformed uuid), ids[2] (nil uuid)")
+}
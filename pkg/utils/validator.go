@@ -2,6 +2,8 @@ package utils
 
 import (
 	"context"
+	"reflect"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -11,9 +13,17 @@ var validate *validator.Validate
 
 func init() {
 	validate = validator.New()
+	// Report fields by json name, so validation details match request body
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "-" || name == "" {
+			return field.Name
+		}
+		return name
+	})
 }
 
-// Validate struct fields
+// Validate struct fields, returned validator.ValidationErrors holds every failed field, not only the first one
 func ValidateStruct(ctx context.Context, s interface{}) error {
 	return validate.StructCtx(ctx, s)
 }